@@ -408,8 +408,9 @@ func (a *T) GetTopicMetadata(topic string, withPartitions, withConfig bool) (Top
 				pm.Leader = -1
 			} else if err != nil {
 				return TopicMetadata{}, errors.Wrap(err, "failed to get leader")
+			} else {
+				pm.Leader = leader.ID()
 			}
-			pm.Leader = leader.ID()
 
 			isr, err := kafkaClt.InSyncReplicas(topic, partition)
 			if err != nil {
@@ -442,3 +443,226 @@ func (a *T) GetTopicMetadata(topic string, withPartitions, withConfig bool) (Top
 	}
 	return tm, nil
 }
+
+// CreateTopic creates a topic with the specified number of partitions,
+// replication factor and configuration overrides. If `validateOnly` is true,
+// then Kafka only checks whether the topic could be created.
+func (a *T) CreateTopic(topic string, partitions int32, replicationFactor int16, config map[string]string, validateOnly bool) error {
+	if topic == "" {
+		return errors.Wrap(sarama.ErrInvalidTopic, "topic must not be empty")
+	}
+	if partitions <= 0 {
+		return errors.Wrapf(sarama.ErrInvalidPartitions, "bad partition count %d", partitions)
+	}
+	if replicationFactor <= 0 {
+		return errors.Wrapf(sarama.ErrInvalidReplicationFactor, "bad replication factor %d", replicationFactor)
+	}
+	configEntries := make(map[string]*string, len(config))
+	for name, value := range config {
+		value := value
+		configEntries[name] = &value
+	}
+	req := sarama.CreateTopicsRequest{
+		TopicDetails: map[string]*sarama.TopicDetail{topic: {
+			NumPartitions:     partitions,
+			ReplicationFactor: replicationFactor,
+			ConfigEntries:     configEntries,
+		}},
+		Timeout:      a.cfg.Admin.Timeout,
+		ValidateOnly: validateOnly,
+	}
+	if a.cfg.Kafka.Version.IsAtLeast(sarama.V0_11_0_0) {
+		req.Version = 1
+	}
+	controller, err := a.controller()
+	if err != nil {
+		return err
+	}
+	res, err := controller.CreateTopics(&req)
+	if err != nil {
+		a.ResetKafkaClt()
+		return errors.Wrap(err, "failed to create topic")
+	}
+	topicErr := res.TopicErrors[topic]
+	if topicErr == nil {
+		return errors.New("no result in response")
+	}
+	if topicErr.Err != sarama.ErrNoError {
+		if topicErr.ErrMsg != nil && *topicErr.ErrMsg != "" {
+			return errors.Wrap(topicErr.Err, *topicErr.ErrMsg)
+		}
+		return topicErr.Err
+	}
+	return nil
+}
+
+// DeleteTopic deletes a topic. It requires `delete.topic.enable` to be set in
+// the Kafka broker configuration.
+func (a *T) DeleteTopic(topic string) error {
+	if topic == "" {
+		return errors.Wrap(sarama.ErrInvalidTopic, "topic must not be empty")
+	}
+	req := sarama.DeleteTopicsRequest{
+		Topics:  []string{topic},
+		Timeout: a.cfg.Admin.Timeout,
+	}
+	if a.cfg.Kafka.Version.IsAtLeast(sarama.V0_11_0_0) {
+		req.Version = 1
+	}
+	controller, err := a.controller()
+	if err != nil {
+		return err
+	}
+	res, err := controller.DeleteTopics(&req)
+	if err != nil {
+		a.ResetKafkaClt()
+		return errors.Wrap(err, "failed to delete topic")
+	}
+	kerr, ok := res.TopicErrorCodes[topic]
+	if !ok {
+		return errors.New("no result in response")
+	}
+	if kerr != sarama.ErrNoError {
+		return kerr
+	}
+	return nil
+}
+
+// AlterTopicConfig changes configuration overrides of a topic. An override is
+// removed if its value in `config` is nil. Overrides that are not mentioned in
+// `config` are left intact. If `validateOnly` is true, then Kafka only checks
+// whether the configuration could be changed.
+func (a *T) AlterTopicConfig(topic string, config map[string]*string, validateOnly bool) error {
+	if topic == "" {
+		return errors.Wrap(sarama.ErrInvalidTopic, "topic must not be empty")
+	}
+	if len(config) == 0 {
+		return errors.Wrap(sarama.ErrInvalidConfig, "config must not be empty")
+	}
+	controller, err := a.controller()
+	if err != nil {
+		return err
+	}
+	// AlterConfigs replaces all overrides of a topic, so the current ones are
+	// fetched to be merged with the changes.
+	describeRes, err := controller.DescribeConfigs(&sarama.DescribeConfigsRequest{
+		Resources: []*sarama.ConfigResource{{Type: sarama.TopicResource, Name: topic}},
+	})
+	if err != nil {
+		a.ResetKafkaClt()
+		return errors.Wrap(err, "failed to describe topic config")
+	}
+	if len(describeRes.Resources) != 1 {
+		return errors.New("no result in response")
+	}
+	resourceRes := describeRes.Resources[0]
+	if err := resourceError(resourceRes.ErrorCode, resourceRes.ErrorMsg); err != nil {
+		return err
+	}
+	configEntries := make(map[string]*string)
+	for _, entry := range resourceRes.Configs {
+		if !entry.Default && !entry.ReadOnly {
+			value := entry.Value
+			configEntries[entry.Name] = &value
+		}
+	}
+	for name, value := range config {
+		if value == nil {
+			delete(configEntries, name)
+			continue
+		}
+		configEntries[name] = value
+	}
+	alterRes, err := controller.AlterConfigs(&sarama.AlterConfigsRequest{
+		Resources: []*sarama.AlterConfigsResource{{
+			Type:          sarama.TopicResource,
+			Name:          topic,
+			ConfigEntries: configEntries,
+		}},
+		ValidateOnly: validateOnly,
+	})
+	if err != nil {
+		a.ResetKafkaClt()
+		return errors.Wrap(err, "failed to alter topic config")
+	}
+	if len(alterRes.Resources) != 1 {
+		return errors.New("no result in response")
+	}
+	return resourceError(alterRes.Resources[0].ErrorCode, alterRes.Resources[0].ErrorMsg)
+}
+
+// AddPartitions increases the number of partitions of a topic to `count`.
+// Kafka does not allow decreasing the number of partitions. If `validateOnly`
+// is true, then Kafka only checks whether partitions could be added.
+func (a *T) AddPartitions(topic string, count int32, validateOnly bool) error {
+	if topic == "" {
+		return errors.Wrap(sarama.ErrInvalidTopic, "topic must not be empty")
+	}
+	if count <= 0 {
+		return errors.Wrapf(sarama.ErrInvalidPartitions, "bad partition count %d", count)
+	}
+	controller, err := a.controller()
+	if err != nil {
+		return err
+	}
+	res, err := controller.CreatePartitions(&sarama.CreatePartitionsRequest{
+		TopicPartitions: map[string]*sarama.TopicPartition{topic: {Count: count}},
+		Timeout:         a.cfg.Admin.Timeout,
+		ValidateOnly:    validateOnly,
+	})
+	if err != nil {
+		a.ResetKafkaClt()
+		return errors.Wrap(err, "failed to add partitions")
+	}
+	topicErr := res.TopicPartitionErrors[topic]
+	if topicErr == nil {
+		return errors.New("no result in response")
+	}
+	if topicErr.Err != sarama.ErrNoError {
+		if topicErr.ErrMsg != nil && *topicErr.ErrMsg != "" {
+			return errors.Wrap(topicErr.Err, *topicErr.ErrMsg)
+		}
+		return topicErr.Err
+	}
+	return nil
+}
+
+// RefreshMetadata refreshes metadata of a topic cached by the admin Kafka
+// client, if it has been created already.
+func (a *T) RefreshMetadata(topic string) error {
+	a.mtx.Lock()
+	kafkaClt := a.kafkaClt
+	a.mtx.Unlock()
+	if kafkaClt == nil {
+		return nil
+	}
+	return kafkaClt.RefreshMetadata(topic)
+}
+
+// controller returns the controller broker of the Kafka cluster, that topic
+// management requests should be sent to.
+func (a *T) controller() (*sarama.Broker, error) {
+	kafkaClt, err := a.lazyKafkaClt()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to Kafka")
+	}
+	controller, err := kafkaClt.Controller()
+	if err != nil {
+		a.ResetKafkaClt()
+		return nil, errors.Wrap(err, "failed to get controller")
+	}
+	return controller, nil
+}
+
+// resourceError converts an error code and message of a config resource
+// response into an error.
+func resourceError(errorCode int16, errorMsg string) error {
+	kerr := sarama.KError(errorCode)
+	if kerr == sarama.ErrNoError {
+		return nil
+	}
+	if errorMsg != "" {
+		return errors.Wrap(kerr, errorMsg)
+	}
+	return kerr
+}
@@ -0,0 +1,158 @@
+package admin
+
+import (
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/mailgun/kafka-pixy/consumer"
+	"github.com/pkg/errors"
+)
+
+// MessagePage is a range of messages read from a partition.
+type MessagePage struct {
+	Messages []consumer.Message
+	// Offset that the next page starts with.
+	NextOffset int64
+	// Offset range of the partition at the time the page was read.
+	Begin int64
+	End   int64
+}
+
+// FetchMessages reads up to `count` messages of a partition starting with
+// offset `from`, that can also be `sarama.OffsetOldest` or
+// `sarama.OffsetNewest`. Messages are read with plain fetch requests to the
+// partition leader, so neither consumer group offsets nor group membership
+// are affected. Reading stops when the end of the partition is reached, keys
+// and values of read messages exceed `maxBytes` in total, or `timeout`
+// elapses. The first message is returned regardless of its size. If `from` is
+// outside of the partition offset range, then an error wrapping
+// `sarama.ErrOffsetOutOfRange` is returned.
+func (a *T) FetchMessages(topic string, partition int32, from int64, count, maxBytes int, timeout time.Duration) (MessagePage, error) {
+	kafkaClt, err := a.lazyKafkaClt()
+	if err != nil {
+		return MessagePage{}, errors.Wrap(err, "failed to connect to Kafka")
+	}
+	if err := checkPartition(kafkaClt, topic, partition); err != nil {
+		return MessagePage{}, err
+	}
+	page := MessagePage{}
+	if page.Begin, err = kafkaClt.GetOffset(topic, partition, sarama.OffsetOldest); err != nil {
+		return MessagePage{}, errors.Wrap(err, "failed to get oldest offset")
+	}
+	if page.End, err = kafkaClt.GetOffset(topic, partition, sarama.OffsetNewest); err != nil {
+		return MessagePage{}, errors.Wrap(err, "failed to get newest offset")
+	}
+	switch from {
+	case sarama.OffsetOldest:
+		from = page.Begin
+	case sarama.OffsetNewest:
+		from = page.End
+	}
+	if from < page.Begin || from > page.End {
+		return MessagePage{}, errors.Wrapf(sarama.ErrOffsetOutOfRange,
+			"offset %d is not in range [%d, %d]", from, page.Begin, page.End)
+	}
+	page.NextOffset = from
+	deadline := time.Now().Add(timeout)
+	byteCount := 0
+	for len(page.Messages) < count && page.NextOffset < page.End && byteCount < maxBytes {
+		if time.Now().After(deadline) {
+			if len(page.Messages) == 0 {
+				return MessagePage{}, errors.Errorf("timeout fetching messages from offset %d", page.NextOffset)
+			}
+			break
+		}
+		fetched, fetchedEnd, err := a.fetch(kafkaClt, topic, partition, page.NextOffset)
+		if err != nil {
+			return MessagePage{}, err
+		}
+		if len(fetched) == 0 {
+			// Offsets taken by transaction markers are skipped.
+			if fetchedEnd > page.NextOffset {
+				page.NextOffset = fetchedEnd
+				continue
+			}
+			break
+		}
+		for _, msg := range fetched {
+			msgSize := len(msg.Key) + len(msg.Value)
+			if len(page.Messages) == count || msg.Offset >= page.End ||
+				(len(page.Messages) > 0 && byteCount+msgSize > maxBytes) {
+				return page, nil
+			}
+			page.Messages = append(page.Messages, msg)
+			page.NextOffset = msg.Offset + 1
+			byteCount += msgSize
+		}
+	}
+	return page, nil
+}
+
+// fetch sends a fetch request to the leader of a partition and returns
+// messages it responded with, along with the offset that follows the last
+// fetched record, be it a message or a transaction marker.
+func (a *T) fetch(kafkaClt sarama.Client, topic string, partition int32, offset int64) ([]consumer.Message, int64, error) {
+	broker, err := kafkaClt.Leader(topic, partition)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "failed to get partition leader, partition=%d", partition)
+	}
+	req := sarama.FetchRequest{MinBytes: 1}
+	if a.cfg.Kafka.Version.IsAtLeast(sarama.V0_10_0_0) {
+		req.Version = 2
+	}
+	if a.cfg.Kafka.Version.IsAtLeast(sarama.V0_10_1_0) {
+		req.Version = 3
+		req.MaxBytes = int32(a.cfg.Consumer.FetchMaxBytes)
+	}
+	if a.cfg.Kafka.Version.IsAtLeast(sarama.V0_11_0_0) {
+		req.Version = 4
+		req.Isolation = sarama.ReadUncommitted
+	}
+	req.AddBlock(topic, partition, offset, int32(a.cfg.Consumer.FetchMaxBytes))
+	res, err := broker.Fetch(&req)
+	if err != nil {
+		a.ResetKafkaClt()
+		return nil, 0, errors.Wrap(err, "failed to fetch messages")
+	}
+	block := res.GetBlock(topic, partition)
+	if block == nil {
+		return nil, 0, errors.New("incomplete fetch response")
+	}
+	if block.Err != sarama.ErrNoError {
+		return nil, 0, errors.Wrapf(block.Err, "failed to fetch messages, offset=%d", offset)
+	}
+	var messages []consumer.Message
+	fetchedEnd := offset
+	for _, records := range block.RecordsSet {
+		if batch := records.RecordBatch; batch != nil {
+			messages = append(messages, consumer.ParseRecordBatch(batch, topic, partition, offset)...)
+			if len(batch.Records) > 0 || batch.Control {
+				if batchEnd := batch.FirstOffset + int64(batch.LastOffsetDelta) + 1; batchEnd > fetchedEnd {
+					fetchedEnd = batchEnd
+				}
+			}
+			continue
+		}
+		if records.MsgSet != nil {
+			messages = append(messages, consumer.ParseMessageSet(records.MsgSet, topic, partition, offset)...)
+		}
+	}
+	if len(messages) == 0 && isPartialTrailing(block) {
+		return nil, 0, errors.Errorf("message at offset %d is larger than consumer.fetch_max_bytes", offset)
+	}
+	return messages, fetchedEnd, nil
+}
+
+// isPartialTrailing tells whether a fetch response block ends with a message
+// that did not fit into the fetch size.
+func isPartialTrailing(block *sarama.FetchResponseBlock) bool {
+	for _, records := range block.RecordsSet {
+		if records.RecordBatch != nil && records.RecordBatch.PartialTrailingRecord {
+			return true
+		}
+		if records.MsgSet != nil && records.MsgSet.PartialTrailingMessage {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package admin
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/Shopify/sarama"
+	"github.com/pkg/errors"
+)
+
+// BrokerMetadata describes a broker of a Kafka cluster along with its
+// connectivity as seen by this Kafka-Pixy instance.
+type BrokerMetadata struct {
+	ID   int32
+	Addr string
+	Rack string
+	// Whether a connection to the broker could be established.
+	Connected bool
+	// An error that the last connection attempt failed with.
+	ConnErr error
+}
+
+// ClusterMetadata describes a Kafka cluster.
+type ClusterMetadata struct {
+	// ID of the controller broker, or -1 if it is not known, e.g. because
+	// Kafka is older than v0.10.
+	ControllerID int32
+	Brokers      []BrokerMetadata
+	// Number of partitions that have fewer in-sync replicas than replicas.
+	UnderReplicatedPartitions int
+	// Number of partitions that have no leader.
+	OfflinePartitions int
+}
+
+// DescribeCluster returns brokers of the Kafka cluster, and summary of the
+// partition replication state. Brokers that have not been connected to yet
+// are connected to, in order to check their connectivity.
+func (a *T) DescribeCluster() (ClusterMetadata, error) {
+	kafkaClt, err := a.lazyKafkaClt()
+	if err != nil {
+		return ClusterMetadata{}, errors.Wrap(err, "failed to connect to Kafka")
+	}
+	// Refresh metadata to get the current list of brokers.
+	if err := kafkaClt.RefreshMetadata(); err != nil {
+		a.ResetKafkaClt()
+		return ClusterMetadata{}, errors.Wrap(err, "failed to refresh metadata")
+	}
+	brokers := kafkaClt.Brokers()
+	cm := ClusterMetadata{
+		ControllerID: -1,
+		Brokers:      make([]BrokerMetadata, len(brokers)),
+	}
+	brokersByID := make(map[int32]*sarama.Broker, len(brokers))
+	var wg sync.WaitGroup
+	for i, broker := range brokers {
+		brokersByID[broker.ID()] = broker
+		cm.Brokers[i] = BrokerMetadata{ID: broker.ID(), Addr: broker.Addr(), Rack: broker.Rack()}
+		wg.Add(1)
+		go func(bm *BrokerMetadata, broker *sarama.Broker) {
+			defer wg.Done()
+			bm.Connected, bm.ConnErr = checkConnectivity(broker, kafkaClt.Config())
+		}(&cm.Brokers[i], broker)
+	}
+	wg.Wait()
+	sort.Slice(cm.Brokers, func(i, j int) bool { return cm.Brokers[i].ID < cm.Brokers[j].ID })
+
+	// Metadata of all partitions is requested directly from a broker, for
+	// the client triggers a metadata refresh on every partition without a
+	// leader.
+	var metadataBroker *sarama.Broker
+	for _, bm := range cm.Brokers {
+		if bm.Connected {
+			metadataBroker = brokersByID[bm.ID]
+			break
+		}
+	}
+	if metadataBroker == nil {
+		return ClusterMetadata{}, errors.New("no broker is reachable")
+	}
+	req := sarama.MetadataRequest{}
+	if a.cfg.Kafka.Version.IsAtLeast(sarama.V0_10_0_0) {
+		req.Version = 1
+	}
+	res, err := metadataBroker.GetMetadata(&req)
+	if err != nil {
+		return ClusterMetadata{}, errors.Wrap(err, "failed to get metadata")
+	}
+	if req.Version >= 1 {
+		cm.ControllerID = res.ControllerID
+	}
+	for _, tm := range res.Topics {
+		for _, pm := range tm.Partitions {
+			if pm.Leader < 0 {
+				cm.OfflinePartitions++
+			}
+			if len(pm.Isr) < len(pm.Replicas) {
+				cm.UnderReplicatedPartitions++
+			}
+		}
+	}
+	return cm, nil
+}
+
+// checkConnectivity tells whether a broker is connected, connecting to it if
+// it has not been connected to yet.
+func checkConnectivity(broker *sarama.Broker, saramaCfg *sarama.Config) (bool, error) {
+	if connected, _ := broker.Connected(); connected {
+		return true, nil
+	}
+	if err := broker.Open(saramaCfg); err != nil && err != sarama.ErrAlreadyConnected {
+		return false, err
+	}
+	// Blocks until the connection attempt initiated by `Open` completes.
+	return broker.Connected()
+}
@@ -0,0 +1,170 @@
+package admin
+
+import (
+	"sort"
+
+	"github.com/Shopify/sarama"
+	"github.com/pkg/errors"
+)
+
+const (
+	// Protocol type of consumer groups, as opposed to e.g. Kafka Connect
+	// worker groups that are coordinated by Kafka as well.
+	consumerProtocolType = "consumer"
+
+	// State that Kafka reports for groups that do not exist.
+	groupStateDead = "Dead"
+)
+
+// NativeGroup describes a consumer group coordinated by Kafka, as opposed to
+// Kafka-Pixy consumer groups that are coordinated via ZooKeeper.
+type NativeGroup struct {
+	// Group state, e.g. Stable, PreparingRebalance, CompletingRebalance.
+	State string
+	// Partition assignment strategy, e.g. range, roundrobin.
+	Protocol string
+	// Group members by member ID.
+	Members map[string]NativeGroupMember
+}
+
+// NativeGroupMember describes a member of a consumer group coordinated by
+// Kafka.
+type NativeGroupMember struct {
+	ClientID   string
+	ClientHost string
+	// Partitions of a topic assigned to the member.
+	Partitions []int32
+}
+
+// GetNativeTopicConsumers returns group -> description mapping for consumer
+// groups coordinated by Kafka, that have members subscribed to a particular
+// topic. Only members subscribed to the topic are included. If `group` is not
+// empty, then only the group with that name is checked, otherwise all groups
+// known to the Kafka cluster are scanned. Groups that cannot be described,
+// e.g. because their coordinators are not reachable, are logged and skipped,
+// so that a single failing broker does not hide all other groups.
+func (a *T) GetNativeTopicConsumers(group, topic string) (map[string]NativeGroup, error) {
+	kafkaClt, err := a.lazyKafkaClt()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to Kafka")
+	}
+	groups := []string{group}
+	if group == "" {
+		groups = a.listNativeGroups(kafkaClt)
+	}
+	// Groups have to be described by their coordinators.
+	coordinatorGroups := make(map[*sarama.Broker][]string)
+	for _, group := range groups {
+		coordinator, err := kafkaClt.Coordinator(group)
+		if err != nil {
+			a.parentActDesc.Log().WithError(err).Warnf("Skipping group without coordinator: group=%s", group)
+			continue
+		}
+		coordinatorGroups[coordinator] = append(coordinatorGroups[coordinator], group)
+	}
+	nativeGroups := make(map[string]NativeGroup)
+	for coordinator, groups := range coordinatorGroups {
+		res, err := coordinator.DescribeGroups(&sarama.DescribeGroupsRequest{Groups: groups})
+		if err != nil {
+			// Closed brokers are reconnected on the next request.
+			coordinator.Close()
+			a.parentActDesc.Log().WithError(err).Warnf("Skipping groups of unavailable coordinator: broker=%d, groups=%v",
+				coordinator.ID(), groups)
+			continue
+		}
+		for _, gd := range res.Groups {
+			if gd.Err != sarama.ErrNoError {
+				a.parentActDesc.Log().WithError(gd.Err).Warnf("Skipping group that failed to describe: group=%s", gd.GroupId)
+				continue
+			}
+			if gd.State == groupStateDead || gd.ProtocolType != consumerProtocolType {
+				continue
+			}
+			nativeGroup, err := describeNativeGroup(gd, topic)
+			if err != nil {
+				a.parentActDesc.Log().WithError(err).Warnf("Skipping group with bad description: group=%s", gd.GroupId)
+				continue
+			}
+			if len(nativeGroup.Members) > 0 {
+				nativeGroups[gd.GroupId] = nativeGroup
+			}
+		}
+	}
+	return nativeGroups, nil
+}
+
+// listNativeGroups returns names of all consumer groups coordinated by Kafka.
+// Every broker only knows about groups that it coordinates, so all of them
+// are asked. Brokers that cannot be reached or fail to list groups are
+// logged and skipped.
+func (a *T) listNativeGroups(kafkaClt sarama.Client) []string {
+	var groups []string
+	for _, broker := range kafkaClt.Brokers() {
+		if connected, err := checkConnectivity(broker, kafkaClt.Config()); !connected {
+			a.parentActDesc.Log().WithError(err).Warnf("Skipping unreachable broker: broker=%d", broker.ID())
+			continue
+		}
+		res, err := broker.ListGroups(&sarama.ListGroupsRequest{})
+		if err != nil {
+			// Closed brokers are reconnected on the next request.
+			broker.Close()
+			a.parentActDesc.Log().WithError(err).Warnf("Skipping broker that failed to list groups: broker=%d", broker.ID())
+			continue
+		}
+		if res.Err != sarama.ErrNoError {
+			a.parentActDesc.Log().WithError(res.Err).Warnf("Skipping broker that failed to list groups: broker=%d", broker.ID())
+			continue
+		}
+		for group, protocolType := range res.Groups {
+			if protocolType == consumerProtocolType {
+				groups = append(groups, group)
+			}
+		}
+	}
+	sort.Strings(groups)
+	return groups
+}
+
+// describeNativeGroup converts a group description returned by Kafka to a
+// `NativeGroup` including only members subscribed to a topic.
+func describeNativeGroup(gd *sarama.GroupDescription, topic string) (NativeGroup, error) {
+	nativeGroup := NativeGroup{
+		State:    gd.State,
+		Protocol: gd.Protocol,
+		Members:  make(map[string]NativeGroupMember),
+	}
+	for memberID, gmd := range gd.Members {
+		subscribed := false
+		// Metadata and assignment are empty while a group is rebalancing.
+		if len(gmd.MemberMetadata) > 0 {
+			metadata, err := gmd.GetMemberMetadata()
+			if err != nil {
+				return NativeGroup{}, errors.Wrapf(err, "bad metadata, member=%s", memberID)
+			}
+			for _, subscribedTopic := range metadata.Topics {
+				if subscribedTopic == topic {
+					subscribed = true
+					break
+				}
+			}
+		}
+		var partitions []int32
+		if len(gmd.MemberAssignment) > 0 {
+			assignment, err := gmd.GetMemberAssignment()
+			if err != nil {
+				return NativeGroup{}, errors.Wrapf(err, "bad assignment, member=%s", memberID)
+			}
+			partitions = assignment.Topics[topic]
+		}
+		if !subscribed && len(partitions) == 0 {
+			continue
+		}
+		sort.Slice(partitions, func(i, j int) bool { return partitions[i] < partitions[j] })
+		nativeGroup.Members[memberID] = NativeGroupMember{
+			ClientID:   gmd.ClientId,
+			ClientHost: gmd.ClientHost,
+			Partitions: partitions,
+		}
+	}
+	return nativeGroup, nil
+}
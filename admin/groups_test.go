@@ -0,0 +1,142 @@
+package admin
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/Shopify/sarama"
+	"github.com/mailgun/kafka-pixy/actor"
+	. "gopkg.in/check.v1"
+)
+
+type GroupsSuite struct{}
+
+var _ = Suite(&GroupsSuite{})
+
+// Only members subscribed to the topic are included in a group description.
+func (s *GroupsSuite) TestDescribeNativeGroup(c *C) {
+	gd := sarama.GroupDescription{
+		GroupId:      "g1",
+		State:        "Stable",
+		ProtocolType: "consumer",
+		Protocol:     "range",
+		Members: map[string]*sarama.GroupMemberDescription{
+			"m1": {
+				ClientId:         "c1",
+				ClientHost:       "/10.0.0.1",
+				MemberMetadata:   encodeMemberMetadata("foo", "bar"),
+				MemberAssignment: encodeMemberAssignment(map[string][]int32{"foo": {3, 1}, "bar": {0}}),
+			},
+			"m2": {
+				ClientId:         "c2",
+				ClientHost:       "/10.0.0.2",
+				MemberMetadata:   encodeMemberMetadata("foo"),
+				MemberAssignment: encodeMemberAssignment(map[string][]int32{}),
+			},
+			"m3": {
+				ClientId:         "c3",
+				ClientHost:       "/10.0.0.3",
+				MemberMetadata:   encodeMemberMetadata("bar"),
+				MemberAssignment: encodeMemberAssignment(map[string][]int32{"bar": {1}}),
+			},
+		},
+	}
+
+	// When
+	nativeGroup, err := describeNativeGroup(&gd, "foo")
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Check(nativeGroup, DeepEquals, NativeGroup{
+		State:    "Stable",
+		Protocol: "range",
+		Members: map[string]NativeGroupMember{
+			"m1": {ClientID: "c1", ClientHost: "/10.0.0.1", Partitions: []int32{1, 3}},
+			"m2": {ClientID: "c2", ClientHost: "/10.0.0.2"},
+		},
+	})
+}
+
+// Members of a rebalancing group have neither metadata nor assignments.
+func (s *GroupsSuite) TestDescribeNativeGroupRebalancing(c *C) {
+	gd := sarama.GroupDescription{
+		GroupId:      "g1",
+		State:        "PreparingRebalance",
+		ProtocolType: "consumer",
+		Members: map[string]*sarama.GroupMemberDescription{
+			"m1": {ClientId: "c1", ClientHost: "/10.0.0.1"},
+		},
+	}
+
+	// When
+	nativeGroup, err := describeNativeGroup(&gd, "foo")
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Check(nativeGroup.State, Equals, "PreparingRebalance")
+	c.Check(nativeGroup.Members, DeepEquals, map[string]NativeGroupMember{})
+}
+
+// Groups of reachable brokers are listed even if another broker is down.
+func (s *GroupsSuite) TestListNativeGroupsBrokerDown(c *C) {
+	broker0 := sarama.NewMockBroker(c, 0)
+	defer broker0.Close()
+	broker1 := sarama.NewMockBroker(c, 1)
+	broker1.Close()
+	broker0.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(c).
+			SetBroker(broker0.Addr(), broker0.BrokerID()).
+			SetBroker(broker1.Addr(), broker1.BrokerID()),
+		"ListGroupsRequest": sarama.NewMockListGroupsResponse(c).
+			AddGroup("g2", "consumer").
+			AddGroup("g1", "consumer").
+			AddGroup("connect", "connect"),
+	})
+	saramaCfg := sarama.NewConfig()
+	saramaCfg.Version = sarama.V0_10_0_0
+	kafkaClt, err := sarama.NewClient([]string{broker0.Addr()}, saramaCfg)
+	c.Assert(err, IsNil)
+	defer kafkaClt.Close()
+	a := T{parentActDesc: actor.Root().NewChild("test")}
+
+	// When
+	groups := a.listNativeGroups(kafkaClt)
+
+	// Then
+	c.Check(groups, DeepEquals, []string{"g1", "g2"})
+}
+
+// encodeMemberMetadata encodes consumer group member metadata the way Kafka
+// consumers do.
+func encodeMemberMetadata(topics ...string) []byte {
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.BigEndian, int16(0))
+	binary.Write(&buf, binary.BigEndian, int32(len(topics)))
+	for _, topic := range topics {
+		writeString(&buf, topic)
+	}
+	binary.Write(&buf, binary.BigEndian, int32(-1))
+	return buf.Bytes()
+}
+
+// encodeMemberAssignment encodes consumer group member assignment the way
+// Kafka consumers do.
+func encodeMemberAssignment(topics map[string][]int32) []byte {
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.BigEndian, int16(0))
+	binary.Write(&buf, binary.BigEndian, int32(len(topics)))
+	for topic, partitions := range topics {
+		writeString(&buf, topic)
+		binary.Write(&buf, binary.BigEndian, int32(len(partitions)))
+		for _, partition := range partitions {
+			binary.Write(&buf, binary.BigEndian, partition)
+		}
+	}
+	binary.Write(&buf, binary.BigEndian, int32(-1))
+	return buf.Bytes()
+}
+
+func writeString(buf *bytes.Buffer, s string) {
+	binary.Write(buf, binary.BigEndian, int16(len(s)))
+	buf.WriteString(s)
+}
@@ -0,0 +1,318 @@
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/Shopify/sarama"
+	"github.com/pkg/errors"
+	"github.com/samuel/go-zookeeper/zk"
+)
+
+const (
+	// Kafka controller watches these ZooKeeper nodes for preferred leader
+	// election and partition reassignment requests, and deletes them when
+	// the requests are completed.
+	preferredReplicaElectionPath = "%s/admin/preferred_replica_election"
+	reassignPartitionsPath       = "%s/admin/reassign_partitions"
+)
+
+// ErrElectionInProgress is returned if a preferred leader election is
+// requested while a previously requested one has not been completed yet.
+var ErrElectionInProgress = errors.New("preferred leader election is in progress")
+
+// TopicPartition identifies a partition of a topic.
+type TopicPartition struct {
+	Topic     string
+	Partition int32
+}
+
+// PartitionReassignment defines replicas that a partition should be moved to.
+// The first replica is the preferred leader.
+type PartitionReassignment struct {
+	Topic     string
+	Partition int32
+	Replicas  []int32
+}
+
+// PartitionProgress describes how far a partition is from the state that a
+// preferred leader election or a partition reassignment is to bring it to.
+type PartitionProgress struct {
+	Topic     string
+	Partition int32
+	// Replicas the partition should end up with. The first replica is the
+	// preferred leader.
+	Target   []int32
+	Leader   int32
+	Replicas []int32
+	ISR      []int32
+	// Whether the partition has reached the target state.
+	Done bool
+}
+
+// adminPartitions is the format of data stored in preferred leader election
+// and partition reassignment ZooKeeper nodes.
+type adminPartitions struct {
+	Version    int              `json:"version"`
+	Partitions []adminPartition `json:"partitions"`
+}
+
+type adminPartition struct {
+	Topic     string  `json:"topic"`
+	Partition int32   `json:"partition"`
+	Replicas  []int32 `json:"replicas,omitempty"`
+}
+
+// ElectPreferredLeaders makes Kafka move leadership of the specified
+// partitions to their preferred replicas, that is the first replicas in the
+// partition replica lists. The election is performed by the Kafka controller
+// asynchronously, and the returned progress reflects the state of the
+// partitions right after it has been requested. If a previously requested
+// election is still in progress, then `ErrElectionInProgress` is returned.
+func (a *T) ElectPreferredLeaders(partitions []TopicPartition) ([]PartitionProgress, error) {
+	if len(partitions) == 0 {
+		return nil, errors.Wrap(sarama.ErrInvalidRequest, "partitions must not be empty")
+	}
+	kafkaClt, err := a.lazyKafkaClt()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to Kafka")
+	}
+	election := adminPartitions{Version: ProtocolVer1}
+	seen := make(map[TopicPartition]bool, len(partitions))
+	for _, tp := range partitions {
+		if seen[tp] {
+			return nil, errors.Wrapf(sarama.ErrInvalidRequest, "duplicate partition %s/%d", tp.Topic, tp.Partition)
+		}
+		seen[tp] = true
+		if err := checkPartition(kafkaClt, tp.Topic, tp.Partition); err != nil {
+			return nil, err
+		}
+		election.Partitions = append(election.Partitions, adminPartition{Topic: tp.Topic, Partition: tp.Partition})
+	}
+	electionPath := fmt.Sprintf(preferredReplicaElectionPath, a.cfg.ZooKeeper.Chroot)
+	if err := a.createAdminNode(electionPath, &election); err != nil {
+		if errors.Cause(err) == zk.ErrNodeExists {
+			return nil, ErrElectionInProgress
+		}
+		return nil, err
+	}
+	return a.getProgress(election.Partitions)
+}
+
+// ReassignPartitions makes Kafka move the specified partitions to new
+// replicas. The reassignment is performed by the Kafka controller
+// asynchronously, and can be monitored with `GetReassignments`. If a
+// previously requested reassignment is still in progress, then
+// `sarama.ErrReassignmentInProgress` is returned.
+func (a *T) ReassignPartitions(reassignments []PartitionReassignment) ([]PartitionProgress, error) {
+	if len(reassignments) == 0 {
+		return nil, errors.Wrap(sarama.ErrInvalidRequest, "reassignments must not be empty")
+	}
+	kafkaClt, err := a.lazyKafkaClt()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to Kafka")
+	}
+	brokers := make(map[int32]bool)
+	for _, broker := range kafkaClt.Brokers() {
+		brokers[broker.ID()] = true
+	}
+	reassignment := adminPartitions{Version: ProtocolVer1}
+	seen := make(map[TopicPartition]bool, len(reassignments))
+	for _, pr := range reassignments {
+		tp := TopicPartition{pr.Topic, pr.Partition}
+		if seen[tp] {
+			return nil, errors.Wrapf(sarama.ErrInvalidRequest, "duplicate partition %s/%d", tp.Topic, tp.Partition)
+		}
+		seen[tp] = true
+		if err := checkReplicas(pr.Replicas, brokers); err != nil {
+			return nil, errors.Wrapf(err, "partition %s/%d", tp.Topic, tp.Partition)
+		}
+		if err := checkPartition(kafkaClt, tp.Topic, tp.Partition); err != nil {
+			return nil, err
+		}
+		reassignment.Partitions = append(reassignment.Partitions, adminPartition{
+			Topic:     pr.Topic,
+			Partition: pr.Partition,
+			Replicas:  pr.Replicas,
+		})
+	}
+	reassignPath := fmt.Sprintf(reassignPartitionsPath, a.cfg.ZooKeeper.Chroot)
+	if err := a.createAdminNode(reassignPath, &reassignment); err != nil {
+		if errors.Cause(err) == zk.ErrNodeExists {
+			return nil, sarama.ErrReassignmentInProgress
+		}
+		return nil, err
+	}
+	return a.getProgress(reassignment.Partitions)
+}
+
+// GetReassignments returns progress of partition reassignments that are in
+// progress. Kafka forgets about reassignments as soon as they are completed,
+// so an empty list is returned if there are none.
+func (a *T) GetReassignments() ([]PartitionProgress, error) {
+	zkConn, err := a.lazyZKConn()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to zookeeper")
+	}
+	reassignPath := fmt.Sprintf(reassignPartitionsPath, a.cfg.ZooKeeper.Chroot)
+	data, _, err := zkConn.Get(reassignPath)
+	if err != nil {
+		if err == zk.ErrNoNode {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to fetch reassignments")
+	}
+	var reassignment adminPartitions
+	if err := json.Unmarshal(data, &reassignment); err != nil {
+		return nil, errors.Wrap(err, "bad reassignments")
+	}
+	return a.getProgress(reassignment.Partitions)
+}
+
+// createAdminNode creates a ZooKeeper node that the Kafka controller watches
+// for administrative requests.
+func (a *T) createAdminNode(path string, data *adminPartitions) error {
+	zkConn, err := a.lazyZKConn()
+	if err != nil {
+		return errors.Wrap(err, "failed to connect to zookeeper")
+	}
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode request")
+	}
+	if _, err := zkConn.Create(path, encoded, 0, zk.WorldACL(zk.PermAll)); err != nil {
+		return errors.Wrapf(err, "failed to create %s", path)
+	}
+	return nil
+}
+
+// getProgress returns progress of partitions towards a target state. The
+// partitions that are given replicas are being reassigned to them, the others
+// are having their preferred leaders elected.
+func (a *T) getProgress(partitions []adminPartition) ([]PartitionProgress, error) {
+	kafkaClt, err := a.lazyKafkaClt()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to Kafka")
+	}
+	var topics []string
+	seen := make(map[string]bool)
+	for _, p := range partitions {
+		if !seen[p.Topic] {
+			seen[p.Topic] = true
+			topics = append(topics, p.Topic)
+		}
+	}
+	// Refresh metadata as the state of partitions is changing.
+	if err := kafkaClt.RefreshMetadata(topics...); err != nil {
+		return nil, errors.Wrap(err, "failed to refresh metadata")
+	}
+	partitionsMetadata := make(map[TopicPartition]PartitionMetadata)
+	for _, topic := range topics {
+		tm, err := a.GetTopicMetadata(topic, true, false)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get %s topic metadata", topic)
+		}
+		for _, pm := range tm.Partitions {
+			partitionsMetadata[TopicPartition{topic, pm.ID}] = pm
+		}
+	}
+	progress := make([]PartitionProgress, len(partitions))
+	for i, p := range partitions {
+		pm, ok := partitionsMetadata[TopicPartition{p.Topic, p.Partition}]
+		if !ok {
+			return nil, errors.Wrapf(sarama.ErrUnknownTopicOrPartition, "partition %s/%d", p.Topic, p.Partition)
+		}
+		pp := &progress[i]
+		pp.Topic = p.Topic
+		pp.Partition = p.Partition
+		pp.Leader = pm.Leader
+		pp.Replicas = pm.Replicas
+		pp.ISR = pm.ISR
+		if p.Replicas != nil {
+			pp.Target = p.Replicas
+			pp.Done = isReassigned(pp)
+		} else {
+			pp.Target = pm.Replicas
+			pp.Done = isPreferredLeaderElected(pp)
+		}
+	}
+	sort.Slice(progress, func(i, j int) bool {
+		if progress[i].Topic != progress[j].Topic {
+			return progress[i].Topic < progress[j].Topic
+		}
+		return progress[i].Partition < progress[j].Partition
+	})
+	return progress, nil
+}
+
+// isPreferredLeaderElected tells whether a partition is led by its preferred
+// replica.
+func isPreferredLeaderElected(pp *PartitionProgress) bool {
+	return len(pp.Target) > 0 && pp.Leader == pp.Target[0]
+}
+
+// isReassigned tells whether a partition has been moved to the target
+// replicas, that is the partition has exactly the target replicas, all of them
+// are in sync, and one of them leads the partition.
+func isReassigned(pp *PartitionProgress) bool {
+	return sameReplicas(pp.Target, pp.Replicas) && containsReplicas(pp.ISR, pp.Target) &&
+		containsReplicas(pp.Target, []int32{pp.Leader})
+}
+
+// checkPartition makes sure that a topic partition exists.
+func checkPartition(kafkaClt sarama.Client, topic string, partition int32) error {
+	partitions, err := kafkaClt.Partitions(topic)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get partitions, topic=%s", topic)
+	}
+	for _, p := range partitions {
+		if p == partition {
+			return nil
+		}
+	}
+	return errors.Wrapf(sarama.ErrUnknownTopicOrPartition, "partition %s/%d", topic, partition)
+}
+
+// checkReplicas makes sure that a replica list is not empty, does not contain
+// duplicates and refers to known brokers only.
+func checkReplicas(replicas []int32, brokers map[int32]bool) error {
+	if len(replicas) == 0 {
+		return errors.Wrap(sarama.ErrInvalidReplicaAssignment, "replicas must not be empty")
+	}
+	seen := make(map[int32]bool, len(replicas))
+	for _, replica := range replicas {
+		if seen[replica] {
+			return errors.Wrapf(sarama.ErrInvalidReplicaAssignment, "duplicate replica %d", replica)
+		}
+		seen[replica] = true
+		if !brokers[replica] {
+			return errors.Wrapf(sarama.ErrInvalidReplicaAssignment, "unknown broker %d", replica)
+		}
+	}
+	return nil
+}
+
+// sameReplicas tells whether two replica lists contain the same replicas
+// regardless of the order.
+func sameReplicas(lhs, rhs []int32) bool {
+	return len(lhs) == len(rhs) && containsReplicas(lhs, rhs)
+}
+
+// containsReplicas tells whether a replica list contains all replicas of
+// another one.
+func containsReplicas(replicas, subset []int32) bool {
+	for _, replica := range subset {
+		found := false
+		for _, r := range replicas {
+			if r == replica {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
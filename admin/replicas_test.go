@@ -0,0 +1,85 @@
+package admin
+
+import (
+	"encoding/json"
+
+	"github.com/Shopify/sarama"
+	"github.com/pkg/errors"
+	. "gopkg.in/check.v1"
+)
+
+type ReplicasSuite struct{}
+
+var _ = Suite(&ReplicasSuite{})
+
+// A partition is done with preferred leader election when it is led by the
+// first replica.
+func (s *ReplicasSuite) TestIsPreferredLeaderElected(c *C) {
+	for i, tc := range []struct {
+		pp   PartitionProgress
+		done bool
+	}{{
+		pp:   PartitionProgress{Target: []int32{1, 2, 3}, Leader: 1},
+		done: true,
+	}, {
+		pp:   PartitionProgress{Target: []int32{1, 2, 3}, Leader: 2},
+		done: false,
+	}, {
+		pp:   PartitionProgress{Target: []int32{1, 2, 3}, Leader: -1},
+		done: false,
+	}, {
+		pp:   PartitionProgress{Leader: -1},
+		done: false,
+	}} {
+		c.Check(isPreferredLeaderElected(&tc.pp), Equals, tc.done, Commentf("case #%d", i))
+	}
+}
+
+// A partition is done with reassignment when it has exactly the target
+// replicas, all of them are in sync, and one of them is the leader.
+func (s *ReplicasSuite) TestIsReassigned(c *C) {
+	for i, tc := range []struct {
+		pp   PartitionProgress
+		done bool
+	}{{
+		pp:   PartitionProgress{Target: []int32{4, 5}, Replicas: []int32{5, 4}, ISR: []int32{4, 5}, Leader: 5},
+		done: true,
+	}, {
+		// The old replicas are still there while the new ones catch up.
+		pp:   PartitionProgress{Target: []int32{4, 5}, Replicas: []int32{1, 2, 4, 5}, ISR: []int32{1, 2}, Leader: 1},
+		done: false,
+	}, {
+		// A new replica is not in sync yet.
+		pp:   PartitionProgress{Target: []int32{4, 5}, Replicas: []int32{4, 5}, ISR: []int32{4}, Leader: 4},
+		done: false,
+	}, {
+		pp:   PartitionProgress{Target: []int32{4, 5}, Replicas: []int32{4, 5}, ISR: []int32{4, 5}, Leader: -1},
+		done: false,
+	}} {
+		c.Check(isReassigned(&tc.pp), Equals, tc.done, Commentf("case #%d", i))
+	}
+}
+
+// Replica lists that are empty, have duplicates or refer to unknown brokers
+// are rejected.
+func (s *ReplicasSuite) TestCheckReplicas(c *C) {
+	brokers := map[int32]bool{1: true, 2: true, 3: true}
+	c.Check(checkReplicas([]int32{3, 1}, brokers), IsNil)
+	for i, replicas := range [][]int32{nil, {1, 1}, {1, 4}} {
+		err := checkReplicas(replicas, brokers)
+		c.Check(errors.Cause(err), Equals, sarama.ErrInvalidReplicaAssignment, Commentf("case #%d", i))
+	}
+}
+
+// Requests are stored in ZooKeeper in the format expected by Kafka.
+func (s *ReplicasSuite) TestAdminPartitionsFormat(c *C) {
+	election := adminPartitions{Version: ProtocolVer1, Partitions: []adminPartition{{Topic: "foo", Partition: 1}}}
+	encoded, err := json.Marshal(&election)
+	c.Assert(err, IsNil)
+	c.Check(string(encoded), Equals, `{"version":1,"partitions":[{"topic":"foo","partition":1}]}`)
+
+	reassignment := adminPartitions{Version: ProtocolVer1, Partitions: []adminPartition{{Topic: "foo", Partition: 1, Replicas: []int32{2, 3}}}}
+	encoded, err = json.Marshal(&reassignment)
+	c.Assert(err, IsNil)
+	c.Check(string(encoded), Equals, `{"version":1,"partitions":[{"topic":"foo","partition":1,"replicas":[2,3]}]}`)
+}
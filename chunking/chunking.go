@@ -0,0 +1,204 @@
+// Package chunking implements splitting of messages that are too large to be
+// written to Kafka as is into chunks, and reassembly of the original messages
+// from consumed chunks. All chunks of a message are written to the same
+// partition and carry headers that identify the message they belong to and
+// their position in it.
+package chunking
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/mailgun/kafka-pixy/consumer"
+	"github.com/pkg/errors"
+)
+
+const (
+	// HeaderID is a header that holds an ID of the message a chunk belongs to.
+	HeaderID = "kafka-pixy-chunk-id"
+
+	// HeaderIndex is a header that holds a zero based index of a chunk.
+	HeaderIndex = "kafka-pixy-chunk-index"
+
+	// HeaderCount is a header that holds the total number of chunks of the
+	// message a chunk belongs to.
+	HeaderCount = "kafka-pixy-chunk-count"
+)
+
+// Info describes a chunk.
+type Info struct {
+	ID    string
+	Index int
+	Count int
+}
+
+// NewID returns a random ID to be used for chunks of a message.
+func NewID() string {
+	var id [16]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		panic(errors.Wrap(err, "failed to generate chunk id"))
+	}
+	return hex.EncodeToString(id[:])
+}
+
+// Split splits a message value into chunks of at most chunkSize bytes. The
+// returned chunks share memory with the value.
+func Split(value []byte, chunkSize int) [][]byte {
+	chunks := make([][]byte, 0, (len(value)+chunkSize-1)/chunkSize)
+	for len(value) > chunkSize {
+		chunks = append(chunks, value[:chunkSize])
+		value = value[chunkSize:]
+	}
+	return append(chunks, value)
+}
+
+// Headers returns message headers extended with headers describing a chunk.
+// The original headers are not modified.
+func Headers(headers []sarama.RecordHeader, info Info) []sarama.RecordHeader {
+	chunkHeaders := make([]sarama.RecordHeader, len(headers), len(headers)+3)
+	copy(chunkHeaders, headers)
+	return append(chunkHeaders,
+		sarama.RecordHeader{Key: []byte(HeaderID), Value: []byte(info.ID)},
+		sarama.RecordHeader{Key: []byte(HeaderIndex), Value: []byte(strconv.Itoa(info.Index))},
+		sarama.RecordHeader{Key: []byte(HeaderCount), Value: []byte(strconv.Itoa(info.Count))})
+}
+
+// Parse extracts chunk information from headers of a consumed message. The
+// returned flag is false if the message is not a chunk.
+func Parse(headers []*sarama.RecordHeader) (Info, bool, error) {
+	var info Info
+	var hasID, hasIndex, hasCount bool
+	var err error
+	for _, h := range headers {
+		switch string(h.Key) {
+		case HeaderID:
+			info.ID, hasID = string(h.Value), true
+		case HeaderIndex:
+			if info.Index, err = strconv.Atoi(string(h.Value)); err != nil {
+				return Info{}, false, errors.Errorf("bad %s header: %q", HeaderIndex, h.Value)
+			}
+			hasIndex = true
+		case HeaderCount:
+			if info.Count, err = strconv.Atoi(string(h.Value)); err != nil {
+				return Info{}, false, errors.Errorf("bad %s header: %q", HeaderCount, h.Value)
+			}
+			hasCount = true
+		}
+	}
+	if !hasID && !hasIndex && !hasCount {
+		return Info{}, false, nil
+	}
+	switch {
+	case !hasID || !hasIndex || !hasCount:
+		return Info{}, false, errors.New("incomplete chunk headers")
+	case info.Count <= 0 || info.Index < 0 || info.Index >= info.Count:
+		return Info{}, false, errors.Errorf("bad chunk index: %d/%d", info.Index, info.Count)
+	}
+	return info, true, nil
+}
+
+// Assembler collects chunks consumed from a partition and reassembles the
+// original messages. It is not safe for concurrent use.
+type Assembler struct {
+	timeout time.Duration
+	sets    map[string]*chunkSet
+}
+
+// chunkSet holds chunks of a message consumed so far.
+type chunkSet struct {
+	count         int
+	values        [][]byte
+	received      int
+	last          consumer.Message
+	offsets       []int64
+	lastTimestamp time.Time
+}
+
+// NewAssembler creates an assembler that discards incomplete messages if no
+// chunks of them are consumed for timeout.
+func NewAssembler(timeout time.Duration) *Assembler {
+	return &Assembler{
+		timeout: timeout,
+		sets:    make(map[string]*chunkSet),
+	}
+}
+
+// Add adds a consumed chunk. If the chunk completes a message, then the
+// reassembled message is returned along with offsets of all its chunks but
+// the last one, that is the offset of the reassembled message. Chunks that
+// duplicate already added ones are ignored, but their offsets are returned
+// with the reassembled message too.
+func (a *Assembler) Add(msg consumer.Message, info Info) (consumer.Message, []int64, bool, error) {
+	set := a.sets[info.ID]
+	if set == nil {
+		set = &chunkSet{count: info.Count, values: make([][]byte, info.Count)}
+		a.sets[info.ID] = set
+	}
+	if info.Count != set.count {
+		return consumer.Message{}, nil, false, errors.Errorf(
+			"chunk count mismatch: id=%s, want=%d, got=%d", info.ID, set.count, info.Count)
+	}
+	if set.values[info.Index] == nil {
+		// A nil slice stands for a chunk that is not received yet.
+		set.values[info.Index] = append([]byte{}, msg.Value...)
+		set.received++
+	}
+	if len(set.offsets) == 0 || msg.Offset > set.last.Offset {
+		set.last = msg
+	}
+	set.offsets = append(set.offsets, msg.Offset)
+	if msg.Timestamp.After(set.lastTimestamp) {
+		set.lastTimestamp = msg.Timestamp
+	}
+	if set.received < set.count {
+		return consumer.Message{}, nil, false, nil
+	}
+	delete(a.sets, info.ID)
+
+	assembled := set.last
+	assembled.Value = bytes.Join(set.values, nil)
+	assembled.Headers = nil
+	for _, h := range set.last.Headers {
+		switch string(h.Key) {
+		case HeaderID, HeaderIndex, HeaderCount:
+			continue
+		}
+		assembled.Headers = append(assembled.Headers, h)
+	}
+	chunkOffsets := make([]int64, 0, len(set.offsets)-1)
+	for _, offset := range set.offsets {
+		if offset != assembled.Offset {
+			chunkOffsets = append(chunkOffsets, offset)
+		}
+	}
+	return assembled, chunkOffsets, true, nil
+}
+
+// Expire discards incomplete messages, which last chunk timestamp is more
+// than the timeout before the given timestamp of a consumed message. Offsets
+// of chunks of discarded messages are returned by message ID. Messages
+// without timestamps never expire.
+func (a *Assembler) Expire(timestamp time.Time) map[string][]int64 {
+	var expired map[string][]int64
+	for id, set := range a.sets {
+		if set.lastTimestamp.IsZero() || !timestamp.After(set.lastTimestamp.Add(a.timeout)) {
+			continue
+		}
+		if expired == nil {
+			expired = make(map[string][]int64)
+		}
+		expired[id] = set.offsets
+		delete(a.sets, id)
+	}
+	return expired
+}
+
+// Reset discards all incomplete messages. It should be called when chunks
+// are going to be consumed again from an earlier offset.
+func (a *Assembler) Reset() {
+	a.sets = make(map[string]*chunkSet)
+}
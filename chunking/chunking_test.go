@@ -0,0 +1,186 @@
+package chunking
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/mailgun/kafka-pixy/consumer"
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+type ChunkingSuite struct{}
+
+var _ = Suite(&ChunkingSuite{})
+
+func (s *ChunkingSuite) TestSplit(c *C) {
+	for i, tc := range []struct {
+		value  string
+		chunks []string
+	}{
+		{value: "", chunks: []string{""}},
+		{value: "ab", chunks: []string{"ab"}},
+		{value: "abc", chunks: []string{"abc"}},
+		{value: "abcd", chunks: []string{"abc", "d"}},
+		{value: "abcdef", chunks: []string{"abc", "def"}},
+	} {
+		var chunks []string
+		for _, chunk := range Split([]byte(tc.value), 3) {
+			chunks = append(chunks, string(chunk))
+		}
+		c.Assert(chunks, DeepEquals, tc.chunks, Commentf("case #%d", i))
+	}
+}
+
+// Chunk headers are appended to the original message headers, and can be
+// parsed back.
+func (s *ChunkingSuite) TestHeaders(c *C) {
+	headers := []sarama.RecordHeader{{Key: []byte("foo"), Value: []byte("bar")}}
+
+	// When
+	chunkHeaders := Headers(headers, Info{ID: "id1", Index: 1, Count: 3})
+
+	// Then
+	c.Assert(headers, HasLen, 1)
+	c.Assert(chunkHeaders, HasLen, 4)
+	info, ok, err := Parse(toConsumed(chunkHeaders))
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, true)
+	c.Assert(info, Equals, Info{ID: "id1", Index: 1, Count: 3})
+}
+
+func (s *ChunkingSuite) TestParseNotChunk(c *C) {
+	_, ok, err := Parse(toConsumed([]sarama.RecordHeader{{Key: []byte("foo"), Value: []byte("bar")}}))
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, false)
+
+	_, ok, err = Parse(nil)
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, false)
+}
+
+func (s *ChunkingSuite) TestParseInvalid(c *C) {
+	for i, tc := range []struct {
+		headers []sarama.RecordHeader
+		error   string
+	}{{
+		headers: []sarama.RecordHeader{{Key: []byte(HeaderID), Value: []byte("id1")}},
+		error:   "incomplete chunk headers",
+	}, {
+		headers: Headers(nil, Info{ID: "id1", Index: 3, Count: 3}),
+		error:   "bad chunk index: 3/3",
+	}, {
+		headers: append(Headers(nil, Info{ID: "id1", Index: 0, Count: 3}),
+			sarama.RecordHeader{Key: []byte(HeaderCount), Value: []byte("x")}),
+		error: `bad kafka-pixy-chunk-count header: "x"`,
+	}} {
+		_, _, err := Parse(toConsumed(tc.headers))
+		c.Assert(err, ErrorMatches, tc.error, Commentf("case #%d", i))
+	}
+}
+
+// Chunks can be added in any order and can be interleaved with chunks of
+// other messages. Duplicate chunks are ignored.
+func (s *ChunkingSuite) TestAssemble(c *C) {
+	a := NewAssembler(time.Minute)
+	headers := []sarama.RecordHeader{{Key: []byte("foo"), Value: []byte("bar")}}
+
+	for i, tc := range []struct {
+		msg       consumer.Message
+		assembled string
+		offsets   []int64
+	}{
+		{msg: chunkMsg(10, "abc", headers, Info{ID: "A", Index: 0, Count: 3})},
+		{msg: chunkMsg(11, "12", headers, Info{ID: "B", Index: 1, Count: 2})},
+		{msg: chunkMsg(12, "ghi", headers, Info{ID: "A", Index: 2, Count: 3})},
+		{msg: chunkMsg(13, "abc", headers, Info{ID: "A", Index: 0, Count: 3})},
+		{msg: chunkMsg(14, "def", headers, Info{ID: "A", Index: 1, Count: 3}), assembled: "abcdefghi", offsets: []int64{10, 12, 13}},
+		{msg: chunkMsg(15, "0", headers, Info{ID: "B", Index: 0, Count: 2}), assembled: "012", offsets: []int64{11}},
+	} {
+		comment := Commentf("case #%d", i)
+		assembled, offsets, ok, err := a.Add(tc.msg, mustParse(tc.msg))
+		c.Assert(err, IsNil, comment)
+		c.Assert(ok, Equals, tc.assembled != "", comment)
+		if !ok {
+			continue
+		}
+		c.Assert(string(assembled.Value), Equals, tc.assembled, comment)
+		c.Assert(assembled.Offset, Equals, tc.msg.Offset, comment)
+		c.Assert(assembled.Key, DeepEquals, []byte("key"), comment)
+		c.Assert(assembled.Headers, DeepEquals, toConsumed(headers), comment)
+		c.Assert(offsets, DeepEquals, tc.offsets, comment)
+	}
+}
+
+func (s *ChunkingSuite) TestAssembleCountMismatch(c *C) {
+	a := NewAssembler(time.Minute)
+	msg := chunkMsg(10, "abc", nil, Info{ID: "A", Index: 0, Count: 3})
+	_, _, _, err := a.Add(msg, mustParse(msg))
+	c.Assert(err, IsNil)
+
+	// When
+	msg = chunkMsg(11, "def", nil, Info{ID: "A", Index: 1, Count: 2})
+	_, _, ok, err := a.Add(msg, mustParse(msg))
+
+	// Then
+	c.Assert(err, ErrorMatches, "chunk count mismatch: id=A, want=3, got=2")
+	c.Assert(ok, Equals, false)
+}
+
+// Incomplete messages are discarded if no chunks of them were produced within
+// the timeout before a consumed message.
+func (s *ChunkingSuite) TestExpire(c *C) {
+	a := NewAssembler(time.Minute)
+	now := time.Now()
+	for _, msg := range []consumer.Message{
+		chunkMsg(10, "a", nil, Info{ID: "A", Index: 0, Count: 3}),
+		chunkMsg(11, "b", nil, Info{ID: "B", Index: 0, Count: 2}),
+		chunkMsg(12, "a", nil, Info{ID: "A", Index: 1, Count: 3}),
+	} {
+		msg.Timestamp = now.Add(time.Duration(msg.Offset) * time.Second)
+		_, _, _, err := a.Add(msg, mustParse(msg))
+		c.Assert(err, IsNil)
+	}
+	noTimestamp := chunkMsg(13, "c", nil, Info{ID: "C", Index: 0, Count: 2})
+	_, _, _, err := a.Add(noTimestamp, mustParse(noTimestamp))
+	c.Assert(err, IsNil)
+
+	c.Assert(a.Expire(now.Add(71*time.Second)), IsNil)
+
+	// When
+	expired := a.Expire(now.Add(71*time.Second + time.Millisecond))
+
+	// Then
+	c.Assert(expired, DeepEquals, map[string][]int64{"B": {11}})
+	c.Assert(a.Expire(now.Add(73*time.Second)), DeepEquals, map[string][]int64{"A": {10, 12}})
+	c.Assert(a.Expire(now.Add(time.Hour)), IsNil)
+}
+
+func chunkMsg(offset int64, value string, headers []sarama.RecordHeader, info Info) consumer.Message {
+	var msg consumer.Message
+	msg.Offset = offset
+	msg.Key = []byte("key")
+	msg.Value = []byte(value)
+	msg.Headers = toConsumed(Headers(headers, info))
+	return msg
+}
+
+func mustParse(msg consumer.Message) Info {
+	info, ok, err := Parse(msg.Headers)
+	if err != nil || !ok {
+		panic("not a chunk")
+	}
+	return info
+}
+
+func toConsumed(headers []sarama.RecordHeader) []*sarama.RecordHeader {
+	consumed := make([]*sarama.RecordHeader, len(headers))
+	for i := range headers {
+		consumed[i] = &headers[i]
+	}
+	return consumed
+}
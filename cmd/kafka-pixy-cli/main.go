@@ -6,6 +6,7 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"strconv"
 	"time"
 
 	pb "github.com/mailgun/kafka-pixy/gen/golang"
@@ -14,6 +15,7 @@ import (
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 )
 
 var Version = "dev-build"
@@ -43,6 +45,30 @@ func main() {
 	   Set partition offsets
 	   $ echo -n "[{"partition": 1, "offset": 1}]" | kafka-pixy-cli offsets my-topic -g my-group
 
+	   Create a topic
+	   $ kafka-pixy-cli create-topic my-topic -p 8 -r 3
+
+	   Delete a topic
+	   $ kafka-pixy-cli delete-topic my-topic
+
+	   Change topic configuration
+	   $ kafka-pixy-cli alter-topic-config my-topic --set "retention.ms=86400000"
+
+	   Increase the number of topic partitions
+	   $ kafka-pixy-cli add-partitions my-topic 16
+
+	   Move leadership of topic partitions to preferred replicas
+	   $ kafka-pixy-cli elect-leaders my-topic
+
+	   Move a partition to other brokers
+	   $ echo -n '[{"topic": "my-topic", "partition": 0, "replicas": [2, 3]}]' | kafka-pixy-cli reassign-partitions
+
+	   Get progress of partition reassignments
+	   $ kafka-pixy-cli reassign-partitions
+
+	   Look at messages of a partition without consuming them
+	   $ kafka-pixy-cli browse my-topic 0 --from 1000
+
 	 Help:
 	   For detailed help on produce
 	   $ kafka-pixy-cli produce -h
@@ -57,6 +83,15 @@ func main() {
 		Default("localhost:19091").
 		Help("kafka-pixy endpoint - http// and unix:// are accepted")
 
+	parser.AddOption("--client-id").
+		Env("CLIENT_ID").
+		Help("identity to present to kafka-pixy, e.g. to be allowed to manage topics")
+
+	parser.AddOption("--client-id-header").
+		Env("CLIENT_ID_HEADER").
+		Default("X-Client-Id").
+		Help("name of the gRPC metadata key to pass the client identity in")
+
 	parser.AddOption("--verbose").
 		Alias("-v").
 		IsTrue().
@@ -68,13 +103,20 @@ func main() {
 	parser.AddCommand("list-topics", ListTopics)
 	parser.AddCommand("list-consumers", ListConsumers)
 	parser.AddCommand("topic", Topic)
+	parser.AddCommand("create-topic", CreateTopic)
+	parser.AddCommand("delete-topic", DeleteTopic)
+	parser.AddCommand("alter-topic-config", AlterTopicConfig)
+	parser.AddCommand("add-partitions", AddPartitions)
+	parser.AddCommand("elect-leaders", ElectLeaders)
+	parser.AddCommand("reassign-partitions", ReassignPartitions)
+	parser.AddCommand("browse", BrowseMessages)
 	parser.AddCommand("version", func(_ *args.ArgParser, _ interface{}) (int, error) {
 		fmt.Fprintf(os.Stdout, "Version: %s\n", Version)
 		return 1, nil
 	})
 
 	opts := parser.ParseOrExit(nil)
-	client, err := DialKafkaPixy(opts.String("endpoint"))
+	client, err := DialKafkaPixy(opts.String("endpoint"), opts.String("client-id-header"), opts.String("client-id"))
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "-- while connecting to '%s' - %s", opts.String("endpoint"), err)
 		os.Exit(1)
@@ -331,7 +373,7 @@ func sendEvents(client pb.KafkaPixyClient, opts *args.Options, source io.Reader)
 func ListConsumers(parser *args.ArgParser, cast interface{}) (int, error) {
 	client := cast.(pb.KafkaPixyClient)
 
-	parser.SetDesc(`List all the consumers of a topic`)
+	parser.SetDesc(`List all the consumers of a topic, including consumer groups coordinated by Kafka`)
 	parser.AddArgument("topic").
 		Required().
 		Env("TOPIC").
@@ -434,6 +476,354 @@ func Topic(parser *args.ArgParser, cast interface{}) (int, error) {
 	return 0, nil
 }
 
+func CreateTopic(parser *args.ArgParser, cast interface{}) (int, error) {
+	client := cast.(pb.KafkaPixyClient)
+
+	desc := args.Dedent(`Create a topic
+
+	Examples:
+	   Create a topic with 8 partitions replicated to 3 brokers
+	   $ kafka-pixy-cli create-topic my-topic -p 8 -r 3 -C "retention.ms=86400000"
+
+	   Check whether a topic could be created
+	   $ kafka-pixy-cli create-topic my-topic -p 8 -r 3 --validate-only`)
+
+	parser.SetDesc(desc)
+	parser.AddArgument("topic").
+		Required().
+		Env("TOPIC").
+		Help("topic to create")
+	parser.AddOption("--partitions").
+		IsInt().
+		Alias("-p").
+		Default("1").
+		Help("number of partitions")
+	parser.AddOption("--replication-factor").
+		IsInt().
+		Alias("-r").
+		Default("1").
+		Help("number of replicas of every partition")
+	parser.AddOption("--config").
+		IsStringMap().
+		Alias("-C").
+		Help(`map of topic config overrides in the form "key=value1,key2=value2"`)
+	parser.AddOption("--validate-only").
+		IsTrue().
+		Help("only check whether the topic could be created")
+
+	opts := parser.ParseSimple(nil)
+	if opts == nil {
+		return 1, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	_, err := client.CreateTopic(ctx, &pb.CreateTopicRq{
+		Topic:             opts.String("topic"),
+		Partitions:        int32(opts.Int("partitions")),
+		ReplicationFactor: int32(opts.Int("replication-factor")),
+		Config:            opts.StringMap("config"),
+		ValidateOnly:      opts.Bool("validate-only"),
+	})
+	cancel()
+	if err != nil {
+		return 1, errors.Wrap(err, "while creating topic")
+	}
+	return 0, nil
+}
+
+func DeleteTopic(parser *args.ArgParser, cast interface{}) (int, error) {
+	client := cast.(pb.KafkaPixyClient)
+
+	desc := args.Dedent(`Delete a topic
+
+	Examples:
+	   $ kafka-pixy-cli delete-topic my-topic`)
+
+	parser.SetDesc(desc)
+	parser.AddArgument("topic").
+		Required().
+		Env("TOPIC").
+		Help("topic to delete")
+
+	opts := parser.ParseSimple(nil)
+	if opts == nil {
+		return 1, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	_, err := client.DeleteTopic(ctx, &pb.DeleteTopicRq{Topic: opts.String("topic")})
+	cancel()
+	if err != nil {
+		return 1, errors.Wrap(err, "while deleting topic")
+	}
+	return 0, nil
+}
+
+func AlterTopicConfig(parser *args.ArgParser, cast interface{}) (int, error) {
+	client := cast.(pb.KafkaPixyClient)
+
+	desc := args.Dedent(`Change configuration overrides of a topic. Overrides that are not
+	mentioned are left intact.
+
+	Examples:
+	   Set retention and remove the cleanup policy override
+	   $ kafka-pixy-cli alter-topic-config my-topic --set "retention.ms=86400000" --delete cleanup.policy`)
+
+	parser.SetDesc(desc)
+	parser.AddArgument("topic").
+		Required().
+		Env("TOPIC").
+		Help("topic to change configuration of")
+	parser.AddOption("--set").
+		IsStringMap().
+		Alias("-s").
+		Help(`map of config overrides to set in the form "key=value1,key2=value2"`)
+	parser.AddOption("--delete").
+		IsStringSlice().
+		Alias("-d").
+		Help("comma separated list of config overrides to remove")
+	parser.AddOption("--validate-only").
+		IsTrue().
+		Help("only check whether the configuration could be changed")
+
+	opts := parser.ParseSimple(nil)
+	if opts == nil {
+		return 1, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	_, err := client.AlterTopicConfig(ctx, &pb.AlterTopicConfigRq{
+		Topic:        opts.String("topic"),
+		Set:          opts.StringMap("set"),
+		Delete:       opts.StringSlice("delete"),
+		ValidateOnly: opts.Bool("validate-only"),
+	})
+	cancel()
+	if err != nil {
+		return 1, errors.Wrap(err, "while altering topic config")
+	}
+	return 0, nil
+}
+
+func AddPartitions(parser *args.ArgParser, cast interface{}) (int, error) {
+	client := cast.(pb.KafkaPixyClient)
+
+	desc := args.Dedent(`Increase the number of partitions of a topic. The number of partitions
+	cannot be decreased.
+
+	Examples:
+	   $ kafka-pixy-cli add-partitions my-topic 16`)
+
+	parser.SetDesc(desc)
+	parser.AddArgument("topic").
+		Required().
+		Env("TOPIC").
+		Help("topic to add partitions to")
+	parser.AddArgument("count").
+		IsInt().
+		Required().
+		Help("total number of partitions the topic should have")
+	parser.AddOption("--validate-only").
+		IsTrue().
+		Help("only check whether partitions could be added")
+
+	opts := parser.ParseSimple(nil)
+	if opts == nil {
+		return 1, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	_, err := client.AddPartitions(ctx, &pb.AddPartitionsRq{
+		Topic:        opts.String("topic"),
+		Count:        int32(opts.Int("count")),
+		ValidateOnly: opts.Bool("validate-only"),
+	})
+	cancel()
+	if err != nil {
+		return 1, errors.Wrap(err, "while adding partitions")
+	}
+	return 0, nil
+}
+
+func ElectLeaders(parser *args.ArgParser, cast interface{}) (int, error) {
+	client := cast.(pb.KafkaPixyClient)
+
+	desc := args.Dedent(`Move leadership of topic partitions to their preferred replicas, that
+	are the first replicas in the partition replica lists.
+
+	Examples:
+	   Elect preferred leaders of all topic partitions
+	   $ kafka-pixy-cli elect-leaders my-topic
+
+	   Elect preferred leaders of particular partitions
+	   $ kafka-pixy-cli elect-leaders my-topic -p 0,3`)
+
+	parser.SetDesc(desc)
+	parser.AddArgument("topic").
+		Required().
+		Env("TOPIC").
+		Help("topic to elect preferred leaders of")
+	parser.AddOption("--partitions").
+		IsStringSlice().
+		Alias("-p").
+		Help("comma separated list of partitions, all topic partitions by default")
+
+	opts := parser.ParseSimple(nil)
+	if opts == nil {
+		return 1, nil
+	}
+
+	topic := opts.String("topic")
+	var partitions []*pb.TopicPartition
+	for _, partition := range opts.StringSlice("partitions") {
+		p, err := strconv.ParseInt(partition, 10, 32)
+		if err != nil {
+			return 1, errors.Wrapf(err, "invalid partition %s", partition)
+		}
+		partitions = append(partitions, &pb.TopicPartition{Topic: topic, Partition: int32(p)})
+	}
+	if len(partitions) == 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		resp, err := client.GetTopicMetadata(ctx, &pb.GetTopicMetadataRq{Topic: topic, WithPartitions: true})
+		cancel()
+		if err != nil {
+			return 1, errors.Wrap(err, "while getting topic metadata")
+		}
+		for _, pm := range resp.Partitions {
+			partitions = append(partitions, &pb.TopicPartition{Topic: topic, Partition: pm.Partition})
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	resp, err := client.ElectPreferredLeaders(ctx, &pb.ElectPreferredLeadersRq{Partitions: partitions})
+	cancel()
+	if err != nil {
+		return 1, errors.Wrap(err, "while electing preferred leaders")
+	}
+
+	data, err := json.MarshalIndent(resp.Partitions, "", "    ")
+	if err != nil {
+		return 1, errors.Wrap(err, "during JSON marshal")
+	}
+	fmt.Println(string(data))
+	return 0, nil
+}
+
+func ReassignPartitions(parser *args.ArgParser, cast interface{}) (int, error) {
+	client := cast.(pb.KafkaPixyClient)
+
+	desc := args.Dedent(`Move partitions to other replicas, or get progress of partition
+	reassignments that are in progress. Reassignments are read from stdin as a JSON
+	list, the first replica of a partition is its preferred leader.
+
+	Examples:
+	   Move a partition to brokers 2 and 3
+	   $ echo -n '[{"topic": "my-topic", "partition": 0, "replicas": [2, 3]}]' | kafka-pixy-cli reassign-partitions
+
+	   Get progress of partition reassignments
+	   $ kafka-pixy-cli reassign-partitions`)
+
+	parser.SetDesc(desc)
+
+	opts := parser.ParseSimple(nil)
+	if opts == nil {
+		return 1, nil
+	}
+
+	var partitions []*pb.PartitionProgress
+	// if stdin has an open pipe, then assume we want to reassign partitions
+	if args.IsCharDevice(os.Stdin) {
+		raw, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return 1, errors.Wrap(err, "while reading from stdin")
+		}
+		var reassignments []*pb.PartitionReassignment
+		if err := json.Unmarshal(raw, &reassignments); err != nil {
+			return 1, errors.Wrap(err, "while marshalling partition reassignments from stdin")
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		resp, err := client.ReassignPartitions(ctx, &pb.ReassignPartitionsRq{Reassignments: reassignments})
+		cancel()
+		if err != nil {
+			return 1, errors.Wrap(err, "while reassigning partitions")
+		}
+		partitions = resp.Partitions
+	} else {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		resp, err := client.GetReassignments(ctx, &pb.GetReassignmentsRq{})
+		cancel()
+		if err != nil {
+			return 1, errors.Wrap(err, "while getting reassignments")
+		}
+		partitions = resp.Partitions
+	}
+
+	data, err := json.MarshalIndent(partitions, "", "    ")
+	if err != nil {
+		return 1, errors.Wrap(err, "during JSON marshal")
+	}
+	fmt.Println(string(data))
+	return 0, nil
+}
+
+func BrowseMessages(parser *args.ArgParser, cast interface{}) (int, error) {
+	client := cast.(pb.KafkaPixyClient)
+
+	desc := args.Dedent(`Read a page of messages from a topic partition without joining a
+	consumer group. Offsets committed by consumer groups are not affected.
+
+	Examples:
+	   Read the oldest messages of a partition
+	   $ kafka-pixy-cli browse my-topic 0
+
+	   Read 20 messages starting with a particular offset
+	   $ kafka-pixy-cli browse my-topic 0 --from 1000 --count 20`)
+
+	parser.SetDesc(desc)
+	parser.AddArgument("topic").
+		Required().
+		Env("TOPIC").
+		Help("topic to read messages from")
+	parser.AddArgument("partition").
+		IsInt().
+		Required().
+		Help("partition to read messages from")
+	parser.AddOption("--from").
+		IsInt().
+		Alias("-f").
+		Default("-1").
+		Help("offset of the first message to read, the oldest one by default")
+	parser.AddOption("--count").
+		IsInt().
+		Alias("-c").
+		Default("10").
+		Help("maximum number of messages to read")
+
+	opts := parser.ParseSimple(nil)
+	if opts == nil {
+		return 1, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	resp, err := client.BrowseMessages(ctx, &pb.BrowseMessagesRq{
+		Topic:     opts.String("topic"),
+		Partition: int32(opts.Int("partition")),
+		From:      int64(opts.Int("from")),
+		Count:     int32(opts.Int("count")),
+	})
+	cancel()
+	if err != nil {
+		return 1, errors.Wrap(err, "while browsing messages")
+	}
+
+	data, err := json.MarshalIndent(resp, "", "    ")
+	if err != nil {
+		return 1, errors.Wrap(err, "during JSON marshal")
+	}
+	fmt.Println(string(data))
+	return 0, nil
+}
+
 func ConsumeEvents(parser *args.ArgParser, cast interface{}) (int, error) {
 	client := cast.(pb.KafkaPixyClient)
 
@@ -459,6 +849,14 @@ func ConsumeEvents(parser *args.ArgParser, cast interface{}) (int, error) {
        kit=kat
        foo=bar
        ---
+	   message-two
+	   ...
+
+	   # Watch for events with their timestamps
+	   $ kafka-pixy-cli consume my-topic -T
+	   timestamp=2021-03-04T05:06:07.008Z (create_time)
+	   message-one
+	   timestamp=2021-03-04T05:06:08.012Z (create_time)
 	   message-two
 	   ...`)
 
@@ -486,6 +884,11 @@ func ConsumeEvents(parser *args.ArgParser, cast interface{}) (int, error) {
 		Alias("-H").
 		Help("display any header data attached to the consumed message")
 
+	parser.AddOption("--include-timestamp").
+		IsTrue().
+		Alias("-T").
+		Help("display the timestamp of the consumed message and its type")
+
 	opts := parser.ParseSimple(nil)
 	if opts == nil {
 		return 1, nil
@@ -511,6 +914,10 @@ func ConsumeEvents(parser *args.ArgParser, cast interface{}) (int, error) {
 		}
 		req.AckPartition = res.Partition
 		req.AckOffset = res.Offset
+		if res.TimestampType != "" && opts.Bool("include-timestamp") {
+			timestamp := time.Unix(0, res.Timestamp*int64(time.Millisecond)).UTC()
+			fmt.Printf("timestamp=%s (%s)\n", timestamp.Format("2006-01-02T15:04:05.000Z07:00"), res.TimestampType)
+		}
 		if len(res.Headers) != 0 && opts.Bool("include-headers") {
 			fmt.Printf("---\n")
 			for _, kv := range res.Headers {
@@ -522,8 +929,22 @@ func ConsumeEvents(parser *args.ArgParser, cast interface{}) (int, error) {
 	}
 }
 
-func DialKafkaPixy(endpoint string) (pb.KafkaPixyClient, error) {
-	conn, err := grpc.Dial(endpoint, grpc.WithInsecure())
+// DialKafkaPixy connects to a kafka-pixy gRPC endpoint. If `clientID` is not
+// empty, then it is passed with every call in the `clientIDHeader` metadata key.
+func DialKafkaPixy(endpoint, clientIDHeader, clientID string) (pb.KafkaPixyClient, error) {
+	dialOpts := []grpc.DialOption{grpc.WithInsecure()}
+	if clientID != "" {
+		dialOpts = append(dialOpts,
+			grpc.WithUnaryInterceptor(func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+				ctx = metadata.AppendToOutgoingContext(ctx, clientIDHeader, clientID)
+				return invoker(ctx, method, req, reply, cc, opts...)
+			}),
+			grpc.WithStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+				ctx = metadata.AppendToOutgoingContext(ctx, clientIDHeader, clientID)
+				return streamer(ctx, desc, cc, method, opts...)
+			}))
+	}
+	conn, err := grpc.Dial(endpoint, dialOpts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "while dialing gRPC server")
 	}
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/Shopify/sarama"
+	"github.com/mailgun/kafka-pixy/partitioner"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
@@ -34,6 +35,14 @@ type App struct {
 	// Listening on a unix domain socket is disabled by default.
 	UnixAddr string `yaml:"unix_addr"`
 
+	// Name of an HTTP header or gRPC metadata key that identifies a client
+	// for the purpose of rate limiting. It is advisory, since any client can
+	// set it to anything, so it is only used if the client did not
+	// authenticate with a TLS certificate verified against
+	// `tls.client_ca_path`. Tenants, topic managers and delivery report
+	// subscribers are identified by verified certificates only.
+	ClientIDHeader string `yaml:"client_id_header"`
+
 	// An arbitrary number of proxies to different Kafka/ZooKeeper clusters can
 	// be configured. Each proxy configuration is identified by a cluster name.
 	Proxies map[string]*Proxy `yaml:"proxies"`
@@ -164,12 +173,21 @@ type Proxy struct {
 		// Size of all buffered channels created by the producer module.
 		ChannelBufferSize int `yaml:"channel_buffer_size"`
 
+		// How long a produce request waits for room in the producer buffer
+		// if it is full, e.g. when Kafka is not available. When the time is
+		// up the request is rejected.
+		BufferFullTimeout time.Duration `yaml:"buffer_full_timeout"`
+
 		// Size of maximum message in bytes
 		MaxMessageBytes int `yaml:"max_message_bytes"`
 
 		// The type of compression to use on messages.
 		Compression Compression `yaml:"compression"`
 
+		// The compression level, 0 stands for the codec default. Only gzip
+		// and zstd support levels.
+		CompressionLevel int `yaml:"compression_level"`
+
 		// The best-effort number of bytes needed to trigger a flush.
 		FlushBytes int `yaml:"flush_bytes"`
 
@@ -199,8 +217,201 @@ type Proxy struct {
 		// The broker will wait for replication to complete up to this duration
 		// before returning an error.
 		Timeout time.Duration `yaml:"timeout"`
+
+		// If true, then the producer ensures that exactly one copy of each
+		// message is written to Kafka, even if it has to retry. It requires
+		// Kafka version 0.11.0.0 or later and `required_acks: wait_for_all`.
+		// Only one request per broker connection can be in flight if enabled.
+		Idempotent bool `yaml:"idempotent"`
+
+		// Prefix of transactional IDs used to produce messages atomically.
+		// Each Kafka-Pixy instance derives a pool of transactional IDs from
+		// the prefix and its client ID. If empty, then transactional produce
+		// is disabled. Requires Kafka version 0.11.0.0 or later.
+		TransactionalIDPrefix string `yaml:"transactional_id_prefix"`
+
+		// The maximum amount of time a transaction may remain open before it
+		// is aborted by the transaction coordinator.
+		TransactionTimeout time.Duration `yaml:"transaction_timeout"`
+
+		// The maximum number of transactions that can be executed
+		// concurrently. It is the size of the transactional ID pool.
+		MaxConcurrentTxns int `yaml:"max_concurrent_txns"`
+
+		// The maximum number of messages that can be submitted in a single
+		// batch or atomic produce request.
+		MaxBatchMessages int `yaml:"max_batch_messages"`
+
+		// Write-ahead spool for messages produced in async mode. If enabled,
+		// async messages are persisted to local disk before they are
+		// acknowledged, and removed once Kafka confirms them. Messages left
+		// in the spool are produced again on startup, except for those whose
+		// confirmations were recorded in ack files. Delivery is at least
+		// once, since ack files are not synced to disk.
+		Spool struct {
+			// Directory to store spool segment files in. If empty, then the
+			// spool is disabled. Every cluster must have its own directory.
+			Dir string `yaml:"dir"`
+
+			// The maximum size of a spool segment file in bytes. A segment
+			// file is deleted when all messages in it are confirmed by Kafka.
+			SegmentSize int64 `yaml:"segment_size"`
+
+			// The maximum total size of all spool segment files in bytes.
+			// When it is reached async produce requests are rejected.
+			MaxSize int64 `yaml:"max_size"`
+
+			// When spool files should be synced to disk: `always` after
+			// every message, `interval` every `fsync_interval`, or `never`
+			// leaving it up to the operating system.
+			Fsync string `yaml:"fsync"`
+
+			// Period of time between spool file syncs if `fsync` is
+			// `interval`.
+			FsyncInterval time.Duration `yaml:"fsync_interval"`
+		} `yaml:"spool"`
+
+		// Handling of messages that the producer failed to write to Kafka.
+		// Messages can be written to a fallback topic, appended to a local
+		// NDJSON file, or both, in which case they are written to the topic
+		// and to the file independently. If neither is configured, then failed
+		// messages are only logged.
+		DeadLetter struct {
+			// Topic to write failed messages to. The failure reason and the
+			// original topic and partition are passed in message headers.
+			Topic string `yaml:"topic"`
+
+			// Path to a file that failed messages are appended to, one JSON
+			// object per line.
+			File string `yaml:"file"`
+		} `yaml:"dead_letter"`
+
+		// Splitting of messages larger than chunk_size into chunks. Chunks of
+		// a message are written to the same partition and carry headers that
+		// consumers use to reassemble the original message. Requires Kafka
+		// version 0.11.0.0 or later.
+		Chunking struct {
+			// If true, then oversized messages are split into chunks,
+			// otherwise they are rejected by Kafka.
+			Enabled bool `yaml:"enabled"`
+
+			// The maximum size of a chunk value in bytes. Messages that are
+			// larger are split. It must leave room for the key and headers
+			// within max_message_bytes.
+			ChunkSize int `yaml:"chunk_size"`
+
+			// The maximum size of a message in bytes that can be produced
+			// in chunks.
+			MaxMessageBytes int `yaml:"max_message_bytes"`
+		} `yaml:"chunking"`
+
+		// Producer parameters overridden for particular topics. Each topic
+		// listed here is produced by a dedicated producer with its own
+		// connections to Kafka brokers.
+		Topics map[string]TopicProducer `yaml:"topics"`
 	} `yaml:"producer"`
 
+	// Schema registry parameters. Messages produced to topics bound to a
+	// schema are validated and encoded into the schema registry wire format.
+	SchemaRegistry struct {
+		// URL of a Confluent compatible schema registry.
+		URL string `yaml:"url"`
+
+		// Directory of a file-backed registry, that is used if url is not
+		// set. Every file in the directory describes one schema version.
+		Dir string `yaml:"dir"`
+
+		// Timeout of requests to the schema registry.
+		Timeout time.Duration `yaml:"timeout"`
+
+		// How long the latest version of a subject is cached before it is
+		// requested from the registry again.
+		CacheTTL time.Duration `yaml:"cache_ttl"`
+
+		// Topics bound to schemas.
+		Topics map[string]TopicSchema `yaml:"topics"`
+	} `yaml:"schema_registry"`
+
+	// Produce rate limits. Produce requests that exceed a limit are rejected
+	// until enough tokens are accumulated in the respective token bucket.
+	ProduceLimits struct {
+		// Limits of individual topics.
+		Topics map[string]RateLimit `yaml:"topics"`
+
+		// Limits of individual clients.
+		Clients map[string]RateLimit `yaml:"clients"`
+
+		// Limits applied to each client that is not listed in clients,
+		// including anonymous ones.
+		DefaultClient RateLimit `yaml:"default_client"`
+	} `yaml:"produce_limits"`
+
+	// JSON Schema parameters. Messages produced to topics bound to a JSON
+	// Schema are validated against it and rejected if they do not match.
+	JSONSchema struct {
+		// How often schema files are checked for changes. Changed files are
+		// reloaded without restart.
+		ReloadInterval time.Duration `yaml:"reload_interval"`
+
+		// Topics bound to JSON Schema files.
+		Topics map[string]string `yaml:"topics"`
+	} `yaml:"json_schema"`
+
+	// Routes of messages produced to topics of this cluster to other
+	// clusters.
+	ProduceRoutes map[string]ProduceRoute `yaml:"produce_routes"`
+
+	// Translation of topic names that clients use into names of real Kafka
+	// topics, applied to produce, consume and ack requests.
+	TopicMapping struct {
+		// Aliases of topics by the names that clients use.
+		Aliases map[string]TopicAlias `yaml:"aliases"`
+
+		// Prefixes prepended to topic names by tenant. A tenant is
+		// identified by a verified TLS client certificate. If any are
+		// configured, then requests of other clients are rejected.
+		TenantPrefixes map[string]string `yaml:"tenant_prefixes"`
+	} `yaml:"topic_mapping"`
+
+	// Management of topics via the admin API, that is creating and deleting
+	// topics and altering their configuration.
+	Admin struct {
+		// Clients allowed to manage topics, identified by verified TLS
+		// client certificates. If empty, then topic management is disabled.
+		TopicManagers []string `yaml:"topic_managers"`
+
+		// How long Kafka is given to complete a topic management operation.
+		Timeout time.Duration `yaml:"timeout"`
+	} `yaml:"admin"`
+
+	// Browsing of messages, that allows looking at messages of a partition
+	// without joining a consumer group. Browsing never changes offsets
+	// committed by consumer groups.
+	Browse struct {
+		// Maximum number of messages returned by a browse request.
+		MaxCount int `yaml:"max_count"`
+
+		// Maximum total size of keys and values of messages returned by a
+		// browse request. The first message is returned regardless of its
+		// size.
+		MaxBytes int `yaml:"max_bytes"`
+
+		// Maximum length of message previews in bytes.
+		PreviewLength int `yaml:"preview_length"`
+
+		// How long a browse request can take to fetch messages.
+		Timeout time.Duration `yaml:"timeout"`
+
+		// Limits applied to each client separately, including anonymous
+		// ones. Messages are accounted before they are fetched, and bytes
+		// after, so a large page delays subsequent requests of the client.
+		ClientLimit RateLimit `yaml:"client_limit"`
+
+		// Limits applied to all clients of the proxy together, accounted
+		// the same way as client ones.
+		TotalLimit RateLimit `yaml:"total_limit"`
+	} `yaml:"browse"`
+
 	Consumer struct {
 		// If set, Kafka-Pixy will not configure a consumer, and any attempts to
 		// call the consumer APIs will return an error.
@@ -259,9 +470,172 @@ type Proxy struct {
 		// Period of time that Kafka-Pixy should keep subscription to
 		// a topic by a group in absence of requests from the consumer group.
 		SubscriptionTimeout time.Duration `yaml:"subscription_timeout"`
+
+		// Chunks of a message that is split by a producer are reassembled
+		// before the message is offered to a client. If no chunks of an
+		// incomplete message are fetched within this period of time, as
+		// measured by message timestamps, then the incomplete message is
+		// discarded.
+		ChunkTimeout time.Duration `yaml:"chunk_timeout"`
 	} `yaml:"consumer"`
 }
 
+// TopicAlias defines a real topic that an alias refers to.
+type TopicAlias struct {
+	// Name of the real topic.
+	Topic string `yaml:"topic"`
+
+	// Cluster the real topic is in. Defaults to the cluster the alias is
+	// defined for.
+	Cluster string `yaml:"cluster"`
+}
+
+func (ta TopicAlias) validate(a *App) error {
+	if ta.Topic == "" {
+		return errors.New("topic must not be empty")
+	}
+	if ta.Cluster != "" && a.Proxies[ta.Cluster] == nil {
+		return errors.Errorf("unknown cluster %s", ta.Cluster)
+	}
+	return nil
+}
+
+// ProduceRoute defines how messages produced to a topic are routed to other
+// clusters.
+type ProduceRoute struct {
+	// Clusters that messages are mirrored to asynchronously.
+	MirrorTo []string `yaml:"mirror_to"`
+
+	// Clusters that a message is produced to, in order, if this cluster
+	// fails to write it. Note that an error, e.g. a timeout, does not
+	// guarantee that the message was not written, so a failed over message
+	// can end up in both clusters.
+	FailoverTo []string `yaml:"failover_to"`
+
+	// The maximum number of messages that can be failed over within
+	// failover_window. When the budget is spent, produce errors are returned
+	// to clients.
+	FailoverBudget int `yaml:"failover_budget"`
+
+	// The period of time the failover budget is replenished over.
+	FailoverWindow time.Duration `yaml:"failover_window"`
+}
+
+func (pr ProduceRoute) validate(a *App, cluster string) error {
+	for _, clusters := range [][]string{pr.MirrorTo, pr.FailoverTo} {
+		for _, target := range clusters {
+			if target == cluster {
+				return errors.New("cannot route to itself")
+			}
+			if a.Proxies[target] == nil {
+				return errors.Errorf("unknown cluster %s", target)
+			}
+		}
+	}
+	if len(pr.FailoverTo) > 0 {
+		switch {
+		case pr.FailoverBudget <= 0:
+			return errors.New("failover_budget must be > 0")
+		case pr.FailoverWindow <= 0:
+			return errors.New("failover_window must be > 0")
+		}
+	}
+	return nil
+}
+
+// RateLimit defines token bucket limits of messages and bytes per second. A
+// zero rate means no limit. If a burst is not set, then it defaults to the
+// respective rate, that is to the number of tokens accumulated in a second.
+type RateLimit struct {
+	MessagesPerSecond float64 `yaml:"messages_per_second"`
+	MessagesBurst     int     `yaml:"messages_burst"`
+	BytesPerSecond    float64 `yaml:"bytes_per_second"`
+	BytesBurst        int     `yaml:"bytes_burst"`
+}
+
+// IsZero tells whether the limit does not limit anything.
+func (rl RateLimit) IsZero() bool {
+	return rl.MessagesPerSecond == 0 && rl.BytesPerSecond == 0
+}
+
+func (rl RateLimit) validate() error {
+	switch {
+	case rl.MessagesPerSecond < 0:
+		return errors.New("messages_per_second must be >= 0")
+	case rl.MessagesBurst < 0:
+		return errors.New("messages_burst must be >= 0")
+	case rl.BytesPerSecond < 0:
+		return errors.New("bytes_per_second must be >= 0")
+	case rl.BytesBurst < 0:
+		return errors.New("bytes_burst must be >= 0")
+	}
+	return nil
+}
+
+// TopicProducer defines producer parameters of a topic. Parameters that are
+// not set take values from the producer section, but the compression level
+// that defaults to the codec default if only the compression is overridden.
+type TopicProducer struct {
+	RequiredAcks     *RequiredAcks           `yaml:"required_acks"`
+	Compression      *Compression            `yaml:"compression"`
+	CompressionLevel *int                    `yaml:"compression_level"`
+	FlushBytes       *int                    `yaml:"flush_bytes"`
+	FlushFrequency   *time.Duration          `yaml:"flush_frequency"`
+	Partitioner      *PartitionerConstructor `yaml:"partitioner"`
+}
+
+// compression returns the compression codec and level of the topic. If the
+// codec is overridden, but the level is not, then the codec default level is
+// used.
+func (tp TopicProducer) compression(p *Proxy) (Compression, int) {
+	compression, level := p.Producer.Compression, p.Producer.CompressionLevel
+	if tp.Compression != nil {
+		compression, level = *tp.Compression, 0
+	}
+	if tp.CompressionLevel != nil {
+		level = *tp.CompressionLevel
+	}
+	return compression, level
+}
+
+func (tp TopicProducer) validate(p *Proxy) error {
+	if tp.RequiredAcks != nil && p.Producer.Idempotent && *tp.RequiredAcks != RequiredAcks(sarama.WaitForAll) {
+		return errors.New("required_acks must be wait_for_all if producer.idempotent")
+	}
+	compression, level := tp.compression(p)
+	if err := validateCompression("", p.Kafka.Version, compression, level); err != nil {
+		return err
+	}
+	if tp.FlushBytes != nil && *tp.FlushBytes < 0 {
+		return errors.New("flush_bytes must be >= 0")
+	}
+	if tp.FlushFrequency != nil && *tp.FlushFrequency < 0 {
+		return errors.New("flush_frequency must be >= 0")
+	}
+	if tp.Partitioner != nil {
+		if _, err := tp.Partitioner.ToPartitionerConstructor(); err != nil {
+			return fmt.Errorf("partitioner is invalid: %q", err)
+		}
+	}
+	return nil
+}
+
+// TopicSchema binds a topic to a schema registry subject.
+type TopicSchema struct {
+	// Schema registry subject. Defaults to `<topic>-value`.
+	Subject string `yaml:"subject"`
+
+	// Schema version to use. If 0, then the latest version is used.
+	Version int `yaml:"version"`
+
+	// Fully qualified name of a message type in a Protobuf schema. Defaults
+	// to the first message type defined in the schema.
+	MessageType string `yaml:"message_type"`
+
+	// If true, then messages consumed via HTTP API are decoded to JSON.
+	DecodeOnConsume bool `yaml:"decode_on_consume"`
+}
+
 type KafkaVersion struct {
 	v sarama.KafkaVersion
 }
@@ -319,6 +693,7 @@ func (c *Compression) UnmarshalText(text []byte) error {
 		"gzip":   sarama.CompressionGZIP,
 		"snappy": sarama.CompressionSnappy,
 		"lz4":    sarama.CompressionLZ4,
+		"zstd":   sarama.CompressionZSTD,
 	}[str]
 	if !ok {
 		return errors.Errorf("bad compression, %s", str)
@@ -327,6 +702,47 @@ func (c *Compression) UnmarshalText(text []byte) error {
 	return nil
 }
 
+// saramaCompressionLevel converts a configured compression level to the
+// respective sarama producer compression level.
+func saramaCompressionLevel(level int) int {
+	if level != 0 {
+		return level
+	}
+	return sarama.CompressionLevelDefault
+}
+
+// validateCompression checks that a compression codec is supported by the
+// Kafka version, and that the level is valid for the codec. The prefix is
+// prepended to parameter names in error messages.
+func validateCompression(prefix string, kafkaVersion KafkaVersion, compression Compression, level int) error {
+	switch sarama.CompressionCodec(compression) {
+	case sarama.CompressionLZ4:
+		if !kafkaVersion.IsAtLeast(sarama.V0_10_0_0) {
+			return errors.Errorf("%scompression lz4 requires kafka.version >= 0.10.0.0", prefix)
+		}
+	case sarama.CompressionZSTD:
+		if !kafkaVersion.IsAtLeast(sarama.V2_1_0_0) {
+			return errors.Errorf("%scompression zstd requires kafka.version >= 2.1.0", prefix)
+		}
+	}
+	if level == 0 {
+		return nil
+	}
+	switch sarama.CompressionCodec(compression) {
+	case sarama.CompressionGZIP:
+		if level < 1 || level > 9 {
+			return errors.Errorf("%scompression_level must be in [1, 9] for gzip", prefix)
+		}
+	case sarama.CompressionZSTD:
+		if level < 1 || level > 22 {
+			return errors.Errorf("%scompression_level must be in [1, 22] for zstd", prefix)
+		}
+	default:
+		return errors.Errorf("%scompression_level is only supported by gzip and zstd", prefix)
+	}
+	return nil
+}
+
 type RequiredAcks sarama.RequiredAcks
 
 func (ra *RequiredAcks) UnmarshalText(text []byte) error {
@@ -347,9 +763,11 @@ type PartitionerConstructor string
 
 func (pc PartitionerConstructor) ToPartitionerConstructor() (sarama.PartitionerConstructor, error) {
 	v, ok := map[string]sarama.PartitionerConstructor{
-		"hash":       sarama.NewHashPartitioner,
-		"random":     sarama.NewRandomPartitioner,
-		"roundrobin": sarama.NewRoundRobinPartitioner,
+		"hash":              sarama.NewHashPartitioner,
+		"random":            sarama.NewRandomPartitioner,
+		"roundrobin":        sarama.NewRoundRobinPartitioner,
+		"murmur2":           partitioner.NewMurmur2,
+		"consistent_random": partitioner.NewConsistentRandom,
 	}[string(pc)]
 	if !ok {
 		return nil, errors.Errorf("bad partitioner: %s", pc)
@@ -377,6 +795,7 @@ func (p *Proxy) SaramaProducerCfg() *sarama.Config {
 
 	saramaCfg.Producer.MaxMessageBytes = p.Producer.MaxMessageBytes
 	saramaCfg.Producer.Compression = sarama.CompressionCodec(p.Producer.Compression)
+	saramaCfg.Producer.CompressionLevel = saramaCompressionLevel(p.Producer.CompressionLevel)
 	saramaCfg.Producer.Flush.Frequency = p.Producer.FlushFrequency
 	saramaCfg.Producer.Flush.Bytes = p.Producer.FlushBytes
 	saramaCfg.Producer.Retry.Backoff = p.Producer.RetryBackoff
@@ -385,6 +804,14 @@ func (p *Proxy) SaramaProducerCfg() *sarama.Config {
 	saramaCfg.Producer.Partitioner, _ = p.Producer.Partitioner.ToPartitionerConstructor()
 	saramaCfg.Producer.Timeout = p.Producer.Timeout
 
+	if p.Producer.Idempotent {
+		// Producer ID is requested by sarama automatically on client creation
+		// when idempotence is enabled.
+		saramaCfg.Producer.Idempotent = true
+		saramaCfg.Producer.RequiredAcks = sarama.WaitForAll
+		saramaCfg.Net.MaxOpenRequests = 1
+	}
+
 	if p.Kafka.TLSEnabled {
 		saramaCfg.Net.TLS.Enable = true
 		tlsCfg, _ := p.newTLSConfig() // Ok to ignore err since we validated
@@ -394,6 +821,32 @@ func (p *Proxy) SaramaProducerCfg() *sarama.Config {
 	return saramaCfg
 }
 
+// SaramaTopicProducerCfg returns a config for sarama producer of a topic
+// with producer parameters overridden in the producer topics section.
+func (p *Proxy) SaramaTopicProducerCfg(topic string) *sarama.Config {
+	saramaCfg := p.SaramaProducerCfg()
+	topicProducer, ok := p.Producer.Topics[topic]
+	if !ok {
+		return saramaCfg
+	}
+	if topicProducer.RequiredAcks != nil {
+		saramaCfg.Producer.RequiredAcks = sarama.RequiredAcks(*topicProducer.RequiredAcks)
+	}
+	compression, level := topicProducer.compression(p)
+	saramaCfg.Producer.Compression = sarama.CompressionCodec(compression)
+	saramaCfg.Producer.CompressionLevel = saramaCompressionLevel(level)
+	if topicProducer.FlushBytes != nil {
+		saramaCfg.Producer.Flush.Bytes = *topicProducer.FlushBytes
+	}
+	if topicProducer.FlushFrequency != nil {
+		saramaCfg.Producer.Flush.Frequency = *topicProducer.FlushFrequency
+	}
+	if topicProducer.Partitioner != nil {
+		saramaCfg.Producer.Partitioner, _ = topicProducer.Partitioner.ToPartitionerConstructor()
+	}
+	return saramaCfg
+}
+
 func (p *Proxy) SaramaClientCfg() *sarama.Config {
 	saramaCfg := sarama.NewConfig()
 	saramaCfg.ChannelBufferSize = p.Consumer.ChannelBufferSize
@@ -536,10 +989,23 @@ func (a *App) validate() error {
 	if len(a.Proxies) == 0 {
 		return errors.New("at least on proxy must be configured")
 	}
+	if a.TLS.ClientCAPath != "" && (a.TLS.CertPath == "" || a.TLS.KeyPath == "") {
+		return errors.New("tls.client_ca_path requires tls.certificate_path and tls.key_path")
+	}
 	for cluster, proxyCfg := range a.Proxies {
 		if err := proxyCfg.validate(); err != nil {
 			return errors.Wrapf(err, "invalid config, cluster=%s", cluster)
 		}
+		for topic, route := range proxyCfg.ProduceRoutes {
+			if err := route.validate(a, cluster); err != nil {
+				return errors.Wrapf(err, "invalid config, cluster=%s: produce_routes.%s", cluster, topic)
+			}
+		}
+		for alias, topicAlias := range proxyCfg.TopicMapping.Aliases {
+			if err := topicAlias.validate(a); err != nil {
+				return errors.Wrapf(err, "invalid config, cluster=%s: topic_mapping.aliases.%s", cluster, alias)
+			}
+		}
 	}
 	return nil
 }
@@ -549,6 +1015,8 @@ func (p *Proxy) validate() error {
 	switch {
 	case p.Producer.ChannelBufferSize <= 0:
 		return errors.New("producer.channel_buffer_size must be > 0")
+	case p.Producer.BufferFullTimeout <= 0:
+		return errors.New("producer.buffer_full_timeout must be > 0")
 	case p.Producer.FlushBytes < 0:
 		return errors.New("producer.flush_bytes must be >= 0")
 	case p.Producer.FlushFrequency < 0:
@@ -565,6 +1033,125 @@ func (p *Proxy) validate() error {
 	if _, err := p.Producer.Partitioner.ToPartitionerConstructor(); err != nil {
 		return fmt.Errorf("producer.partitioner is invalid: %q", err)
 	}
+	if err := validateCompression("producer.", p.Kafka.Version, p.Producer.Compression, p.Producer.CompressionLevel); err != nil {
+		return err
+	}
+	if p.Producer.Idempotent {
+		switch {
+		case !p.Kafka.Version.IsAtLeast(sarama.V0_11_0_0):
+			return errors.New("producer.idempotent requires kafka.version >= 0.11.0.0")
+		case p.Producer.RequiredAcks != RequiredAcks(sarama.WaitForAll):
+			return errors.New("producer.idempotent requires producer.required_acks to be wait_for_all")
+		}
+	}
+	if p.Producer.MaxBatchMessages <= 0 {
+		return errors.New("producer.max_batch_messages must be > 0")
+	}
+	if p.Producer.TransactionalIDPrefix != "" {
+		switch {
+		case !p.Kafka.Version.IsAtLeast(sarama.V0_11_0_0):
+			return errors.New("producer.transactional_id_prefix requires kafka.version >= 0.11.0.0")
+		case p.Producer.TransactionTimeout <= 0:
+			return errors.New("producer.transaction_timeout must be > 0")
+		case p.Producer.MaxConcurrentTxns <= 0:
+			return errors.New("producer.max_concurrent_txns must be > 0")
+		}
+	}
+	if p.Producer.Spool.Dir != "" {
+		switch {
+		case p.Producer.Spool.SegmentSize <= 0:
+			return errors.New("producer.spool.segment_size must be > 0")
+		case p.Producer.Spool.MaxSize < p.Producer.Spool.SegmentSize:
+			return errors.New("producer.spool.max_size must be >= producer.spool.segment_size")
+		case p.Producer.Spool.FsyncInterval <= 0:
+			return errors.New("producer.spool.fsync_interval must be > 0")
+		}
+		switch p.Producer.Spool.Fsync {
+		case "always", "interval", "never":
+		default:
+			return errors.Errorf("producer.spool.fsync is invalid: %s", p.Producer.Spool.Fsync)
+		}
+	}
+	if p.Producer.DeadLetter.Topic != "" && !p.Kafka.Version.IsAtLeast(sarama.V0_11_0_0) {
+		return errors.New("producer.dead_letter.topic requires kafka.version >= 0.11.0.0")
+	}
+	for topic, topicProducer := range p.Producer.Topics {
+		if err := topicProducer.validate(p); err != nil {
+			return errors.Wrapf(err, "producer.topics.%s", topic)
+		}
+	}
+	if p.Producer.Chunking.Enabled {
+		switch {
+		case !p.Kafka.Version.IsAtLeast(sarama.V0_11_0_0):
+			return errors.New("producer.chunking requires kafka.version >= 0.11.0.0")
+		case p.Producer.Chunking.ChunkSize <= 0:
+			return errors.New("producer.chunking.chunk_size must be > 0")
+		case p.Producer.Chunking.ChunkSize >= p.Producer.MaxMessageBytes:
+			return errors.New("producer.chunking.chunk_size must be < producer.max_message_bytes")
+		case p.Producer.Chunking.MaxMessageBytes < p.Producer.Chunking.ChunkSize:
+			return errors.New("producer.chunking.max_message_bytes must be >= producer.chunking.chunk_size")
+		}
+	}
+	// Validate the SchemaRegistry parameters.
+	if len(p.SchemaRegistry.Topics) > 0 {
+		switch {
+		case p.SchemaRegistry.URL == "" && p.SchemaRegistry.Dir == "":
+			return errors.New("schema_registry.url or schema_registry.dir must be set")
+		case p.SchemaRegistry.Timeout <= 0:
+			return errors.New("schema_registry.timeout must be > 0")
+		case p.SchemaRegistry.CacheTTL <= 0:
+			return errors.New("schema_registry.cache_ttl must be > 0")
+		}
+		for topic, topicSchema := range p.SchemaRegistry.Topics {
+			if topicSchema.Version < 0 {
+				return errors.Errorf("schema_registry.topics.%s.version must be >= 0", topic)
+			}
+		}
+	}
+	// Validate the ProduceLimits parameters.
+	for topic, limit := range p.ProduceLimits.Topics {
+		if err := limit.validate(); err != nil {
+			return errors.Wrapf(err, "produce_limits.topics.%s", topic)
+		}
+	}
+	for client, limit := range p.ProduceLimits.Clients {
+		if err := limit.validate(); err != nil {
+			return errors.Wrapf(err, "produce_limits.clients.%s", client)
+		}
+	}
+	if err := p.ProduceLimits.DefaultClient.validate(); err != nil {
+		return errors.Wrap(err, "produce_limits.default_client")
+	}
+	// Validate the JSONSchema parameters.
+	if len(p.JSONSchema.Topics) > 0 && p.JSONSchema.ReloadInterval <= 0 {
+		return errors.New("json_schema.reload_interval must be > 0")
+	}
+	for topic, path := range p.JSONSchema.Topics {
+		if path == "" {
+			return errors.Errorf("json_schema.topics.%s must not be empty", topic)
+		}
+	}
+	// Validate the Admin parameters.
+	if p.Admin.Timeout <= 0 {
+		return errors.New("admin.timeout must be > 0")
+	}
+	// Validate the Browse parameters.
+	switch {
+	case p.Browse.MaxCount <= 0:
+		return errors.New("browse.max_count must be > 0")
+	case p.Browse.MaxBytes <= 0:
+		return errors.New("browse.max_bytes must be > 0")
+	case p.Browse.PreviewLength < 0:
+		return errors.New("browse.preview_length must be >= 0")
+	case p.Browse.Timeout <= 0:
+		return errors.New("browse.timeout must be > 0")
+	}
+	if err := p.Browse.ClientLimit.validate(); err != nil {
+		return errors.Wrap(err, "browse.client_limit")
+	}
+	if err := p.Browse.TotalLimit.validate(); err != nil {
+		return errors.Wrap(err, "browse.total_limit")
+	}
 	// Validate the Consumer parameters.
 	switch {
 	case p.Consumer.AckTimeout <= 0:
@@ -585,6 +1172,8 @@ func (p *Proxy) validate() error {
 		return errors.New("consumer.subscription_timeout must be > 0")
 	case p.Consumer.RetryBackoff <= 0:
 		return errors.New("consumer.retry_backoff must be > 0")
+	case p.Consumer.ChunkTimeout <= 0:
+		return errors.New("consumer.chunk_timeout must be > 0")
 	}
 
 	// Validate TLS configuration.
@@ -634,6 +1223,7 @@ func newApp() *App {
 	appCfg := &App{}
 	appCfg.GRPCAddr = "0.0.0.0:19091"
 	appCfg.TCPAddr = "0.0.0.0:19092"
+	appCfg.ClientIDHeader = "X-Client-Id"
 	appCfg.Proxies = make(map[string]*Proxy)
 	return appCfg
 }
@@ -660,6 +1250,7 @@ func defaultProxyWithClientID(clientID string) *Proxy {
 	c.Net.WriteTimeout = 30 * time.Second
 
 	c.Producer.ChannelBufferSize = 4096
+	c.Producer.BufferFullTimeout = 5 * time.Second
 	c.Producer.MaxMessageBytes = 1000000
 	c.Producer.Compression = Compression(sarama.CompressionSnappy)
 	c.Producer.FlushFrequency = 500 * time.Millisecond
@@ -670,6 +1261,31 @@ func defaultProxyWithClientID(clientID string) *Proxy {
 	c.Producer.ShutdownTimeout = 30 * time.Second
 	c.Producer.Partitioner = PartitionerConstructor("hash")
 	c.Producer.Timeout = 10 * time.Second
+	c.Producer.TransactionTimeout = 60 * time.Second
+	c.Producer.MaxConcurrentTxns = 8
+	c.Producer.MaxBatchMessages = 1000
+	c.Producer.Spool.SegmentSize = 64 * 1024 * 1024
+	c.Producer.Spool.MaxSize = 1024 * 1024 * 1024
+	c.Producer.Spool.Fsync = "interval"
+	c.Producer.Spool.FsyncInterval = time.Second
+	c.Producer.Chunking.ChunkSize = 900000
+	c.Producer.Chunking.MaxMessageBytes = 16 * 1024 * 1024
+
+	c.SchemaRegistry.Timeout = 5 * time.Second
+	c.SchemaRegistry.CacheTTL = 5 * time.Minute
+
+	c.JSONSchema.ReloadInterval = 10 * time.Second
+
+	c.Admin.Timeout = 30 * time.Second
+
+	c.Browse.MaxCount = 100
+	c.Browse.MaxBytes = 1024 * 1024
+	c.Browse.PreviewLength = 256
+	c.Browse.Timeout = 5 * time.Second
+	c.Browse.ClientLimit.MessagesPerSecond = 100
+	c.Browse.ClientLimit.BytesPerSecond = 1024 * 1024
+	c.Browse.TotalLimit.MessagesPerSecond = 1000
+	c.Browse.TotalLimit.BytesPerSecond = 10 * 1024 * 1024
 
 	c.Consumer.AckTimeout = 300 * time.Second
 	c.Consumer.ChannelBufferSize = 64
@@ -681,6 +1297,7 @@ func defaultProxyWithClientID(clientID string) *Proxy {
 	c.Consumer.OffsetsCommitInterval = 500 * time.Millisecond
 	c.Consumer.SubscriptionTimeout = 15 * time.Second
 	c.Consumer.RetryBackoff = 500 * time.Millisecond
+	c.Consumer.ChunkTimeout = time.Minute
 	return c
 }
 
@@ -735,28 +1352,67 @@ type proxyProb struct {
 type TLS struct {
 	CertPath string `yaml:"certificate_path"`
 	KeyPath  string `yaml:"key_path"`
+
+	// Path to a file with PEM encoded CA certificates that client
+	// certificates are verified against. If set, then clients can
+	// authenticate with certificates, and the common name, or the first DNS
+	// name if the common name is empty, of a verified certificate is the
+	// client identity.
+	ClientCAPath string `yaml:"client_ca_path"`
+}
+
+// MaxChunkedMessageBytes returns the maximum size of a message that can be
+// produced in chunks to any of the configured clusters. It is zero if
+// chunking is not enabled for any cluster.
+func (a *App) MaxChunkedMessageBytes() int {
+	maxBytes := 0
+	for _, proxyCfg := range a.Proxies {
+		if proxyCfg.Producer.Chunking.Enabled && proxyCfg.Producer.Chunking.MaxMessageBytes > maxBytes {
+			maxBytes = proxyCfg.Producer.Chunking.MaxMessageBytes
+		}
+	}
+	return maxBytes
 }
 
 // GRPCSecurityOpts returns an array (possibly empty) with gRPC security
 // configuration if properly configured
 func (a *App) GRPCSecurityOpts() ([]grpc.ServerOption, error) {
 	srvOpts := []grpc.ServerOption{}
-	// use security only if both cert and key paths are set
-	if a.TLS.CertPath != "" && a.TLS.KeyPath != "" {
-		cert, err := ioutil.ReadFile(a.TLS.CertPath)
-		if err != nil {
-			return nil, err
-		}
-		key, err := ioutil.ReadFile(a.TLS.KeyPath)
+	tlsCfg, err := a.ServerTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	if tlsCfg != nil {
+		srvOpts = append(srvOpts, grpc.Creds(credentials.NewTLS(tlsCfg)))
+	}
+	return srvOpts, nil
+}
+
+// ServerTLSConfig returns TLS configuration of API servers, or nil if TLS is
+// not configured, that is either the certificate or the key path is empty.
+// If `tls.client_ca_path` is set, then clients are asked for certificates,
+// and presented certificates are verified against the CA. Clients that do
+// not present a certificate are still accepted as anonymous.
+func (a *App) ServerTLSConfig() (*tls.Config, error) {
+	if a.TLS.CertPath == "" || a.TLS.KeyPath == "" {
+		return nil, nil
+	}
+	cert, err := tls.LoadX509KeyPair(a.TLS.CertPath, a.TLS.KeyPath)
+	if err != nil {
+		return nil, err
+	}
+	tlsCfg := &tls.Config{Certificates: []tls.Certificate{cert}}
+	if a.TLS.ClientCAPath != "" {
+		caCert, err := ioutil.ReadFile(a.TLS.ClientCAPath)
 		if err != nil {
 			return nil, err
 		}
-		signedCert, err := tls.X509KeyPair(cert, key)
-		if err != nil {
-			return nil, err
+		clientCAs := x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(caCert) {
+			return nil, errors.Errorf("no certificates found in %s", a.TLS.ClientCAPath)
 		}
-		sslCert := credentials.NewServerTLSFromCert(&signedCert)
-		srvOpts = append(srvOpts, grpc.Creds(sslCert))
+		tlsCfg.ClientCAs = clientCAs
+		tlsCfg.ClientAuth = tls.VerifyClientCertIfGiven
 	}
-	return srvOpts, nil
+	return tlsCfg, nil
 }
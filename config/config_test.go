@@ -170,3 +170,508 @@ func (s *ConfigSuite) TestClientIDFromNomad(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert("kp_nomad_b313e983_0", Equals, appCfg.Proxies["default"].ClientID)
 }
+
+func (s *ConfigSuite) TestIdempotentProducer(c *C) {
+	data := []byte("" +
+		"proxies:\n" +
+		"  default:\n" +
+		"    kafka:\n" +
+		"      version: 2.0.0\n" +
+		"    producer:\n" +
+		"      idempotent: true\n")
+
+	// When
+	appCfg, err := FromYAML(data)
+
+	// Then
+	c.Assert(err, IsNil)
+	saramaCfg := appCfg.Proxies["default"].SaramaProducerCfg()
+	c.Assert(saramaCfg.Producer.Idempotent, Equals, true)
+	c.Assert(saramaCfg.Producer.RequiredAcks, Equals, sarama.WaitForAll)
+	c.Assert(saramaCfg.Net.MaxOpenRequests, Equals, 1)
+	c.Assert(saramaCfg.Validate(), IsNil)
+}
+
+func (s *ConfigSuite) TestIdempotentProducerOldKafka(c *C) {
+	data := []byte("" +
+		"proxies:\n" +
+		"  default:\n" +
+		"    kafka:\n" +
+		"      version: 0.10.2.1\n" +
+		"    producer:\n" +
+		"      idempotent: true\n")
+
+	// When
+	_, err := FromYAML(data)
+
+	// Then
+	c.Assert(err.Error(), Equals, "invalid config parameter: "+
+		"invalid config, cluster=default: "+
+		"producer.idempotent requires kafka.version >= 0.11.0.0")
+}
+
+func (s *ConfigSuite) TestPartitioners(c *C) {
+	for _, name := range []string{"hash", "random", "roundrobin", "murmur2", "consistent_random"} {
+		data := []byte("" +
+			"proxies:\n" +
+			"  default:\n" +
+			"    producer:\n" +
+			"      partitioner: " + name + "\n")
+
+		// When
+		appCfg, err := FromYAML(data)
+
+		// Then
+		c.Assert(err, IsNil, Commentf("partitioner=%s", name))
+		c.Check(appCfg.Proxies["default"].Producer.Partitioner, Equals, PartitionerConstructor(name))
+		c.Check(appCfg.Proxies["default"].SaramaProducerCfg().Producer.Partitioner, NotNil)
+	}
+}
+
+func (s *ConfigSuite) TestPartitionerInvalid(c *C) {
+	data := []byte("" +
+		"proxies:\n" +
+		"  default:\n" +
+		"    producer:\n" +
+		"      partitioner: murmur3\n")
+
+	// When
+	_, err := FromYAML(data)
+
+	// Then
+	c.Assert(err.Error(), Equals, "invalid config parameter: "+
+		"invalid config, cluster=default: "+
+		"producer.partitioner is invalid: \"bad partitioner: murmur3\"")
+}
+
+func (s *ConfigSuite) TestTransactionalProducerOldKafka(c *C) {
+	data := []byte("" +
+		"proxies:\n" +
+		"  default:\n" +
+		"    kafka:\n" +
+		"      version: 0.10.2.1\n" +
+		"    producer:\n" +
+		"      transactional_id_prefix: foo_\n")
+
+	// When
+	_, err := FromYAML(data)
+
+	// Then
+	c.Assert(err.Error(), Equals, "invalid config parameter: "+
+		"invalid config, cluster=default: "+
+		"producer.transactional_id_prefix requires kafka.version >= 0.11.0.0")
+}
+
+func (s *ConfigSuite) TestDeadLetterTopicOldKafka(c *C) {
+	data := []byte("" +
+		"proxies:\n" +
+		"  default:\n" +
+		"    kafka:\n" +
+		"      version: 0.10.2.1\n" +
+		"    producer:\n" +
+		"      dead_letter:\n" +
+		"        topic: dead\n")
+
+	// When
+	_, err := FromYAML(data)
+
+	// Then
+	c.Assert(err.Error(), Equals, "invalid config parameter: "+
+		"invalid config, cluster=default: "+
+		"producer.dead_letter.topic requires kafka.version >= 0.11.0.0")
+}
+
+func (s *ConfigSuite) TestIdempotentProducerAcksConflict(c *C) {
+	data := []byte("" +
+		"proxies:\n" +
+		"  default:\n" +
+		"    kafka:\n" +
+		"      version: 2.0.0\n" +
+		"    producer:\n" +
+		"      idempotent: true\n" +
+		"      required_acks: wait_for_local\n")
+
+	// When
+	_, err := FromYAML(data)
+
+	// Then
+	c.Assert(err.Error(), Equals, "invalid config parameter: "+
+		"invalid config, cluster=default: "+
+		"producer.idempotent requires producer.required_acks to be wait_for_all")
+}
+
+func (s *ConfigSuite) TestChunkSizeTooLarge(c *C) {
+	data := []byte("" +
+		"proxies:\n" +
+		"  default:\n" +
+		"    kafka:\n" +
+		"      version: 2.0.0\n" +
+		"    producer:\n" +
+		"      max_message_bytes: 1000\n" +
+		"      chunking:\n" +
+		"        enabled: true\n" +
+		"        chunk_size: 1000\n")
+
+	// When
+	_, err := FromYAML(data)
+
+	// Then
+	c.Assert(err.Error(), Equals, "invalid config parameter: "+
+		"invalid config, cluster=default: "+
+		"producer.chunking.chunk_size must be < producer.max_message_bytes")
+}
+
+// Producer parameters overridden for a topic apply to that topic only.
+func (s *ConfigSuite) TestTopicProducer(c *C) {
+	data := []byte("" +
+		"proxies:\n" +
+		"  default:\n" +
+		"    producer:\n" +
+		"      required_acks: wait_for_local\n" +
+		"      topics:\n" +
+		"        billing:\n" +
+		"          required_acks: wait_for_all\n" +
+		"          compression: gzip\n" +
+		"        metrics:\n" +
+		"          flush_frequency: 2s\n" +
+		"          flush_bytes: 0\n")
+
+	// When
+	appCfg, err := FromYAML(data)
+
+	// Then
+	c.Assert(err, IsNil)
+	proxyCfg := appCfg.Proxies["default"]
+
+	saramaCfg := proxyCfg.SaramaTopicProducerCfg("billing")
+	c.Check(saramaCfg.Producer.RequiredAcks, Equals, sarama.WaitForAll)
+	c.Check(saramaCfg.Producer.Compression, Equals, sarama.CompressionGZIP)
+	c.Check(saramaCfg.Producer.Flush.Frequency, Equals, 500*time.Millisecond)
+
+	saramaCfg = proxyCfg.SaramaTopicProducerCfg("metrics")
+	c.Check(saramaCfg.Producer.RequiredAcks, Equals, sarama.WaitForLocal)
+	c.Check(saramaCfg.Producer.Compression, Equals, sarama.CompressionSnappy)
+	c.Check(saramaCfg.Producer.Flush.Frequency, Equals, 2*time.Second)
+	c.Check(saramaCfg.Producer.Flush.Bytes, Equals, 0)
+
+	saramaCfg = proxyCfg.SaramaTopicProducerCfg("foo")
+	c.Check(saramaCfg.Producer.RequiredAcks, Equals, sarama.WaitForLocal)
+	c.Check(saramaCfg.Producer.Flush.Bytes, Equals, 1024*1024)
+}
+
+func (s *ConfigSuite) TestTopicProducerIdempotentAcksConflict(c *C) {
+	data := []byte("" +
+		"proxies:\n" +
+		"  default:\n" +
+		"    kafka:\n" +
+		"      version: 2.0.0\n" +
+		"    producer:\n" +
+		"      idempotent: true\n" +
+		"      topics:\n" +
+		"        metrics:\n" +
+		"          required_acks: wait_for_local\n")
+
+	// When
+	_, err := FromYAML(data)
+
+	// Then
+	c.Assert(err.Error(), Equals, "invalid config parameter: "+
+		"invalid config, cluster=default: "+
+		"producer.topics.metrics: required_acks must be wait_for_all if producer.idempotent")
+}
+
+func (s *ConfigSuite) TestCompressionZstd(c *C) {
+	data := []byte("" +
+		"proxies:\n" +
+		"  default:\n" +
+		"    kafka:\n" +
+		"      version: 2.1.0\n" +
+		"    producer:\n" +
+		"      compression: zstd\n" +
+		"      topics:\n" +
+		"        billing:\n" +
+		"          compression_level: 19\n" +
+		"        metrics:\n" +
+		"          compression: gzip\n")
+
+	// When
+	appCfg, err := FromYAML(data)
+
+	// Then
+	c.Assert(err, IsNil)
+	proxyCfg := appCfg.Proxies["default"]
+
+	saramaCfg := proxyCfg.SaramaProducerCfg()
+	c.Check(saramaCfg.Producer.Compression, Equals, sarama.CompressionZSTD)
+	c.Check(saramaCfg.Producer.CompressionLevel, Equals, sarama.CompressionLevelDefault)
+	c.Check(saramaCfg.Validate(), IsNil)
+
+	saramaCfg = proxyCfg.SaramaTopicProducerCfg("billing")
+	c.Check(saramaCfg.Producer.Compression, Equals, sarama.CompressionZSTD)
+	c.Check(saramaCfg.Producer.CompressionLevel, Equals, 19)
+
+	saramaCfg = proxyCfg.SaramaTopicProducerCfg("metrics")
+	c.Check(saramaCfg.Producer.Compression, Equals, sarama.CompressionGZIP)
+	c.Check(saramaCfg.Producer.CompressionLevel, Equals, sarama.CompressionLevelDefault)
+}
+
+func (s *ConfigSuite) TestCompressionInvalid(c *C) {
+	for i, tc := range []struct {
+		kafkaVersion string
+		producer     string
+		error        string
+	}{{
+		kafkaVersion: "2.0.1",
+		producer:     "      compression: zstd\n",
+		error:        "producer.compression zstd requires kafka.version >= 2.1.0",
+	}, {
+		kafkaVersion: "2.0.1",
+		producer: "" +
+			"      topics:\n" +
+			"        metrics:\n" +
+			"          compression: zstd\n",
+		error: "producer.topics.metrics: compression zstd requires kafka.version >= 2.1.0",
+	}, {
+		kafkaVersion: "0.9.0.0",
+		producer:     "      compression: lz4\n",
+		error:        "producer.compression lz4 requires kafka.version >= 0.10.0.0",
+	}, {
+		kafkaVersion: "2.1.0",
+		producer: "" +
+			"      compression: zstd\n" +
+			"      compression_level: 23\n",
+		error: "producer.compression_level must be in [1, 22] for zstd",
+	}, {
+		kafkaVersion: "2.1.0",
+		producer: "" +
+			"      compression: gzip\n" +
+			"      compression_level: 10\n",
+		error: "producer.compression_level must be in [1, 9] for gzip",
+	}, {
+		kafkaVersion: "2.1.0",
+		producer: "" +
+			"      compression: snappy\n" +
+			"      compression_level: 1\n",
+		error: "producer.compression_level is only supported by gzip and zstd",
+	}, {
+		kafkaVersion: "2.1.0",
+		producer: "" +
+			"      compression: zstd\n" +
+			"      compression_level: 19\n" +
+			"      topics:\n" +
+			"        metrics:\n" +
+			"          compression: snappy\n" +
+			"          compression_level: 19\n",
+		error: "producer.topics.metrics: compression_level is only supported by gzip and zstd",
+	}} {
+		data := []byte("" +
+			"proxies:\n" +
+			"  default:\n" +
+			"    kafka:\n" +
+			"      version: " + tc.kafkaVersion + "\n" +
+			"    producer:\n" +
+			tc.producer)
+
+		// When
+		_, err := FromYAML(data)
+
+		// Then
+		c.Assert(err, NotNil, Commentf("case #%d", i))
+		c.Check(err.Error(), Equals, "invalid config parameter: "+
+			"invalid config, cluster=default: "+tc.error, Commentf("case #%d", i))
+	}
+}
+
+func (s *ConfigSuite) TestProduceRoutes(c *C) {
+	data := []byte("" +
+		"proxies:\n" +
+		"  east:\n" +
+		"    produce_routes:\n" +
+		"      orders:\n" +
+		"        mirror_to: [backup]\n" +
+		"        failover_to: [west]\n" +
+		"        failover_budget: 100\n" +
+		"        failover_window: 1m\n" +
+		"  west:\n" +
+		"    client_id: west\n" +
+		"  backup:\n" +
+		"    client_id: backup\n")
+
+	// When
+	appCfg, err := FromYAML(data)
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Assert(appCfg.Proxies["east"].ProduceRoutes, DeepEquals, map[string]ProduceRoute{
+		"orders": {
+			MirrorTo:       []string{"backup"},
+			FailoverTo:     []string{"west"},
+			FailoverBudget: 100,
+			FailoverWindow: time.Minute,
+		},
+	})
+}
+
+func (s *ConfigSuite) TestProduceRoutesInvalid(c *C) {
+	for i, tc := range []struct {
+		route string
+		error string
+	}{{
+		route: "        mirror_to: [north]\n",
+		error: "unknown cluster north",
+	}, {
+		route: "" +
+			"        failover_to: [east]\n" +
+			"        failover_budget: 100\n" +
+			"        failover_window: 1m\n",
+		error: "cannot route to itself",
+	}, {
+		route: "" +
+			"        failover_to: [west]\n" +
+			"        failover_window: 1m\n",
+		error: "failover_budget must be > 0",
+	}, {
+		route: "" +
+			"        failover_to: [west]\n" +
+			"        failover_budget: 100\n",
+		error: "failover_window must be > 0",
+	}} {
+		data := []byte("" +
+			"proxies:\n" +
+			"  east:\n" +
+			"    produce_routes:\n" +
+			"      orders:\n" +
+			tc.route +
+			"  west:\n" +
+			"    client_id: west\n")
+
+		// When
+		_, err := FromYAML(data)
+
+		// Then
+		c.Assert(err, NotNil, Commentf("case #%d", i))
+		c.Check(err.Error(), Equals, "invalid config parameter: "+
+			"invalid config, cluster=east: produce_routes.orders: "+tc.error, Commentf("case #%d", i))
+	}
+}
+
+func (s *ConfigSuite) TestTopicMapping(c *C) {
+	data := []byte("" +
+		"proxies:\n" +
+		"  east:\n" +
+		"    topic_mapping:\n" +
+		"      aliases:\n" +
+		"        orders:\n" +
+		"          topic: orders-v2\n" +
+		"        audit:\n" +
+		"          topic: audit-log\n" +
+		"          cluster: west\n" +
+		"      tenant_prefixes:\n" +
+		"        acme: acme.\n" +
+		"  west:\n" +
+		"    client_id: west\n")
+
+	// When
+	appCfg, err := FromYAML(data)
+
+	// Then
+	c.Assert(err, IsNil)
+	topicMapping := appCfg.Proxies["east"].TopicMapping
+	c.Assert(topicMapping.Aliases, DeepEquals, map[string]TopicAlias{
+		"orders": {Topic: "orders-v2"},
+		"audit":  {Topic: "audit-log", Cluster: "west"},
+	})
+	c.Assert(topicMapping.TenantPrefixes, DeepEquals, map[string]string{"acme": "acme."})
+}
+
+func (s *ConfigSuite) TestTopicMappingInvalid(c *C) {
+	for i, tc := range []struct {
+		alias string
+		error string
+	}{{
+		alias: "          cluster: west\n",
+		error: "topic must not be empty",
+	}, {
+		alias: "" +
+			"          topic: audit-log\n" +
+			"          cluster: north\n",
+		error: "unknown cluster north",
+	}} {
+		data := []byte("" +
+			"proxies:\n" +
+			"  east:\n" +
+			"    topic_mapping:\n" +
+			"      aliases:\n" +
+			"        audit:\n" +
+			tc.alias +
+			"  west:\n" +
+			"    client_id: west\n")
+
+		// When
+		_, err := FromYAML(data)
+
+		// Then
+		c.Assert(err, NotNil, Commentf("case #%d", i))
+		c.Check(err.Error(), Equals, "invalid config parameter: "+
+			"invalid config, cluster=east: topic_mapping.aliases.audit: "+tc.error, Commentf("case #%d", i))
+	}
+}
+
+func (s *ConfigSuite) TestBrowseInvalid(c *C) {
+	for i, tc := range []struct {
+		browse string
+		error  string
+	}{{
+		browse: "      max_count: 0\n",
+		error:  "browse.max_count must be > 0",
+	}, {
+		browse: "      max_bytes: -1\n",
+		error:  "browse.max_bytes must be > 0",
+	}, {
+		browse: "      preview_length: -1\n",
+		error:  "browse.preview_length must be >= 0",
+	}, {
+		browse: "" +
+			"      client_limit:\n" +
+			"        bytes_per_second: -1\n",
+		error: "browse.client_limit: bytes_per_second must be >= 0",
+	}, {
+		browse: "" +
+			"      total_limit:\n" +
+			"        messages_per_second: -1\n",
+		error: "browse.total_limit: messages_per_second must be >= 0",
+	}} {
+		data := []byte("" +
+			"proxies:\n" +
+			"  east:\n" +
+			"    browse:\n" +
+			tc.browse)
+
+		// When
+		_, err := FromYAML(data)
+
+		// Then
+		c.Assert(err, NotNil, Commentf("case #%d", i))
+		c.Check(err.Error(), Equals, "invalid config parameter: "+
+			"invalid config, cluster=east: "+tc.error, Commentf("case #%d", i))
+	}
+}
+
+// Client certificates can only be verified if the servers use TLS.
+func (s *ConfigSuite) TestClientCAPathRequiresTLS(c *C) {
+	data := []byte("" +
+		"proxies:\n" +
+		"  east:\n" +
+		"    kafka:\n" +
+		"      seed_peers: [localhost:9092]\n" +
+		"tls:\n" +
+		"  client_ca_path: /usr/local/etc/clients-ca.crt\n")
+
+	// When
+	_, err := FromYAML(data)
+
+	// Then
+	c.Assert(err, NotNil)
+	c.Check(err.Error(), Equals, "invalid config parameter: "+
+		"tls.client_ca_path requires tls.certificate_path and tls.key_path")
+}
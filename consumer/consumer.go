@@ -42,6 +42,11 @@ type T interface {
 	// and returns a channel that a response should be expected from.
 	AsyncConsume(group, topic string) <-chan Response
 
+	// RefreshPartitions refreshes the partition list of a topic and makes
+	// consumer groups that consume the topic reassign partitions, so that
+	// partitions added to the topic are consumed without a restart.
+	RefreshPartitions(topic string) error
+
 	// Stop sends a shutdown signal to all internal goroutines and blocks until
 	// they are stopped. It is guaranteed that all last consumed offsets of all
 	// consumer groups/topics are committed to Kafka before Consumer stops.
@@ -65,10 +70,36 @@ type Response struct {
 // Message encapsulates a Kafka message returned by the consumer.
 type Message struct {
 	sarama.ConsumerMessage
+	TimestampType TimestampType
 	HighWaterMark int64
 	EventsCh      chan<- Event
 }
 
+// TimestampType tells how the timestamp of a message was assigned.
+type TimestampType int
+
+const (
+	// TimestampNone is used for messages written in a format that does not
+	// support timestamps, that is by Kafka older then 0.10.
+	TimestampNone TimestampType = iota
+	// TimestampCreateTime means that the timestamp was set by the producer.
+	TimestampCreateTime
+	// TimestampLogAppendTime means that the timestamp was set by the broker
+	// when the message was appended to the log.
+	TimestampLogAppendTime
+)
+
+func (tt TimestampType) String() string {
+	switch tt {
+	case TimestampCreateTime:
+		return "create_time"
+	case TimestampLogAppendTime:
+		return "log_append_time"
+	default:
+		return ""
+	}
+}
+
 func NewRequest(group, topic string) Request {
 	return Request{
 		Timestamp:  time.Now().UTC(),
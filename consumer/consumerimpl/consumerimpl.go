@@ -1,6 +1,8 @@
 package consumerimpl
 
 import (
+	"sync"
+
 	"github.com/Shopify/sarama"
 	"github.com/mailgun/kafka-pixy/actor"
 	"github.com/mailgun/kafka-pixy/config"
@@ -31,6 +33,9 @@ type t struct {
 	kafkaClt   sarama.Client
 	zkConn     *zk.Conn
 	offsetMgrF offsetmgr.Factory
+
+	groupCsmsMu sync.Mutex
+	groupCsms   map[string]*groupcsm.T
 }
 
 // Spawn creates a consumer instance with the specified configuration and
@@ -52,6 +57,7 @@ func Spawn(parentActDesc *actor.Descriptor, cfg *config.Proxy, offsetMgrF offset
 		kafkaClt:   kafkaClt,
 		offsetMgrF: offsetMgrF,
 		zkConn:     zkConn,
+		groupCsms:  make(map[string]*groupcsm.T),
 	}
 	c.dispatcher = dispatcher.Spawn(c.actDesc, c, c.cfg)
 	return c, nil
@@ -70,6 +76,24 @@ func (c *t) AsyncConsume(group, topic string) <-chan consumer.Response {
 	return rq.ResponseCh
 }
 
+// implements `consumer.T`
+func (c *t) RefreshPartitions(topic string) error {
+	if err := c.kafkaClt.RefreshMetadata(topic); err != nil {
+		return errors.Wrap(err, "failed to refresh metadata")
+	}
+	c.groupCsmsMu.Lock()
+	defer c.groupCsmsMu.Unlock()
+	for group, gc := range c.groupCsms {
+		select {
+		case <-gc.Stopped():
+			delete(c.groupCsms, group)
+		default:
+			gc.RefreshPartitions()
+		}
+	}
+	return nil
+}
+
 // implements `consumer.T`
 func (c *t) Stop() {
 	c.dispatcher.Stop()
@@ -84,7 +108,12 @@ func (c *t) KeyOf(rq consumer.Request) dispatcher.Key {
 
 // implements `dispatcher.Factory`.
 func (c *t) SpawnChild(childSpec dispatcher.ChildSpec) {
-	groupcsm.Spawn(c.actDesc, childSpec, c.cfg, c.kafkaClt, c.zkConn, c.offsetMgrF)
+	gc := groupcsm.Spawn(c.actDesc, childSpec, c.cfg, c.kafkaClt, c.zkConn, c.offsetMgrF)
+	// A group consumer that has been stopped may still be in the map, it is
+	// replaced with the new one.
+	c.groupCsmsMu.Lock()
+	c.groupCsms[string(childSpec.Key())] = gc
+	c.groupCsmsMu.Unlock()
 }
 
 // String returns a string ID of this instance to be used in logs.
@@ -16,6 +16,7 @@ import (
 	"github.com/mailgun/kafka-pixy/consumer/partitioncsm"
 	"github.com/mailgun/kafka-pixy/consumer/subscriber"
 	"github.com/mailgun/kafka-pixy/consumer/topiccsm"
+	"github.com/mailgun/kafka-pixy/none"
 	"github.com/mailgun/kafka-pixy/offsetmgr"
 	"github.com/mailgun/kafka-pixy/prettyfmt"
 	"github.com/pkg/errors"
@@ -38,6 +39,8 @@ type T struct {
 	offsetMgrF  offsetmgr.Factory
 	subscriber  *subscriber.T
 	topicCsmCh  chan *topiccsm.T
+	refreshCh   chan none.T
+	stoppedCh   chan none.T
 	wg          sync.WaitGroup
 
 	multiplexersMu sync.Mutex
@@ -59,6 +62,8 @@ func Spawn(parentActDesc *actor.Descriptor, childSpec dispatcher.ChildSpec,
 		offsetMgrF:   offsetMgrF,
 		multiplexers: make(map[string]*multiplexer.T),
 		topicCsmCh:   make(chan *topiccsm.T, cfg.Consumer.ChannelBufferSize),
+		refreshCh:    make(chan none.T, 1),
+		stoppedCh:    make(chan none.T),
 	}
 
 	gc.subscriber = subscriber.Spawn(gc.actDesc, gc.group, gc.cfg, gc.zkConn)
@@ -88,6 +93,22 @@ func (gc *T) String() string {
 	return gc.actDesc.String()
 }
 
+// RefreshPartitions makes the group consumer reassign partitions of consumed
+// topics, to pick up partitions that have been added to them. It is expected
+// that metadata of the Kafka client has been refreshed by the caller. The
+// method never blocks.
+func (gc *T) RefreshPartitions() {
+	select {
+	case gc.refreshCh <- none.V:
+	default:
+	}
+}
+
+// Stopped returns a channel that is closed when the group consumer stops.
+func (gc *T) Stopped() <-chan none.T {
+	return gc.stoppedCh
+}
+
 // finalizer is called when all downstream topic consumers expire or if
 // the dispatcher is explicitly told to stop by the upstream dispatcher.
 func (gc *T) finalizer() {
@@ -98,6 +119,7 @@ func (gc *T) finalizer() {
 	gc.msgFetcherF.Stop()
 	// If we are the last member of the group then remove it.
 	gc.subscriber.DeleteGroupIfEmpty()
+	close(gc.stoppedCh)
 }
 
 func (gc *T) isSafe2Stop(topic string) bool {
@@ -154,6 +176,15 @@ func (gc *T) run() {
 			}
 			rebalanceRequired = true
 
+		case <-gc.refreshCh:
+			// Partitions can only be reassigned when subscriptions of the
+			// group members are known.
+			if subscriptions == nil || stopped {
+				continue
+			}
+			gc.actDesc.Log().Info("Partitions refresh requested")
+			rebalanceRequired = true
+
 		case err := <-rebalanceResultCh:
 			rebalancePending = false
 			if err != nil {
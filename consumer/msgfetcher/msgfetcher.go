@@ -328,18 +328,21 @@ func (mf *msgFetcher) parseFetchResponse(fetchRs fetchRs) ([]consumer.Message, e
 	for _, recordsSet := range fetchRsBlock.RecordsSet {
 		recordBatch := recordsSet.RecordBatch
 		if recordBatch != nil {
-			fetchedMessages = append(fetchedMessages, mf.parseRecordBatch(recordBatch, highWaterMarkOffset)...)
+			fetchedMessages = append(fetchedMessages, mf.parseRecordBatch(recordBatch)...)
 			continue
 		}
 		messageSet := recordsSet.MsgSet
 		if messageSet != nil {
-			fetchedMessages = append(fetchedMessages, mf.parseMessageSet(messageSet, highWaterMarkOffset)...)
+			fetchedMessages = append(fetchedMessages, mf.parseMessageSet(messageSet)...)
 		}
 	}
+	for i := range fetchedMessages {
+		fetchedMessages[i].HighWaterMark = highWaterMarkOffset
+	}
 	return fetchedMessages, nil
 }
 
-func (mf *msgFetcher) parseMessageSet(messageSet *sarama.MessageSet, highWaterMarkOffset int64) []consumer.Message {
+func (mf *msgFetcher) parseMessageSet(messageSet *sarama.MessageSet) []consumer.Message {
 	// We got no messages. If we got a trailing one, it means there is a
 	// producer that writes messages larger then Consumer.FetchMaxBytes in size.
 	if len(messageSet.Messages) == 0 && messageSet.PartialTrailingMessage {
@@ -348,36 +351,10 @@ func (mf *msgFetcher) parseMessageSet(messageSet *sarama.MessageSet, highWaterMa
 		return nil
 	}
 
-	var fetchedMessages []consumer.Message
-	for _, msgBlock := range messageSet.Messages {
-		lastMsgIdx := len(msgBlock.Messages()) - 1
-		baseOffset := msgBlock.Offset - msgBlock.Messages()[lastMsgIdx].Offset
-		for _, msg := range msgBlock.Messages() {
-			offset := msg.Offset
-			if msg.Msg.Version >= 1 {
-				offset += baseOffset
-			}
-			if offset < mf.offset {
-				continue
-			}
-			consumerMsg := consumer.Message{
-				ConsumerMessage: sarama.ConsumerMessage{
-					Topic:     mf.id.topic,
-					Partition: mf.id.partition,
-					Key:       msg.Msg.Key,
-					Value:     msg.Msg.Value,
-					Offset:    offset,
-					Timestamp: msg.Msg.Timestamp,
-				},
-				HighWaterMark: highWaterMarkOffset,
-			}
-			fetchedMessages = append(fetchedMessages, consumerMsg)
-		}
-	}
-	return fetchedMessages
+	return consumer.ParseMessageSet(messageSet, mf.id.topic, mf.id.partition, mf.offset)
 }
 
-func (mf *msgFetcher) parseRecordBatch(recordBatch *sarama.RecordBatch, highWaterMarkOffset int64) []consumer.Message {
+func (mf *msgFetcher) parseRecordBatch(recordBatch *sarama.RecordBatch) []consumer.Message {
 	if recordBatch.Control {
 		mf.actDesc.Log().Warn("Control record batch ignored")
 		return nil
@@ -391,28 +368,7 @@ func (mf *msgFetcher) parseRecordBatch(recordBatch *sarama.RecordBatch, highWate
 		return nil
 	}
 
-	var fetchedMessages []consumer.Message
-	for _, record := range recordBatch.Records {
-		offset := recordBatch.FirstOffset + record.OffsetDelta
-		if offset < mf.offset {
-			continue
-		}
-
-		consumerMsg := consumer.Message{
-			ConsumerMessage: sarama.ConsumerMessage{
-				Topic:     mf.id.topic,
-				Partition: mf.id.partition,
-				Key:       record.Key,
-				Value:     record.Value,
-				Headers:   record.Headers,
-				Offset:    offset,
-				Timestamp: recordBatch.FirstTimestamp.Add(record.TimestampDelta),
-			},
-			HighWaterMark: highWaterMarkOffset,
-		}
-		fetchedMessages = append(fetchedMessages, consumerMsg)
-	}
-	return fetchedMessages
+	return consumer.ParseRecordBatch(recordBatch, mf.id.topic, mf.id.partition, mf.offset)
 }
 
 // reportError sends message fetch errors to the error channel if the user
@@ -1,6 +1,7 @@
 package msgfetcher
 
 import (
+	"fmt"
 	"sync"
 	"testing"
 	"time"
@@ -123,6 +124,102 @@ func (s *MsgFetcherSuite) TestOffsetNewest(c *C) {
 	c.Assert(msg.HighWaterMark, Equals, int64(14))
 }
 
+// Messages are returned with their timestamps and timestamp types.
+func (s *MsgFetcherSuite) TestTimestamps(c *C) {
+	s.cfg.Kafka.Version.Set(sarama.V0_11_0_0)
+	createTime := time.Date(2021, 3, 4, 5, 6, 7, 8000000, time.UTC)
+	logAppendTime := createTime.Add(time.Minute)
+	for i, tc := range []struct {
+		logAppendTime bool
+		timestamp     time.Time
+		timestampType consumer.TimestampType
+	}{
+		{logAppendTime: false, timestamp: createTime, timestampType: consumer.TimestampCreateTime},
+		{logAppendTime: true, timestamp: logAppendTime, timestampType: consumer.TimestampLogAppendTime},
+	} {
+		fetchRs := &sarama.FetchResponse{Version: 4, LogAppendTime: tc.logAppendTime, Timestamp: logAppendTime}
+		fetchRs.AddRecordWithTimestamp("my_topic", 0, nil, testMsg, 0, createTime)
+		s.broker0.SetHandlerByMap(map[string]sarama.MockResponse{
+			"MetadataRequest": sarama.NewMockMetadataResponse(c).
+				SetBroker(s.broker0.Addr(), s.broker0.BrokerID()).
+				SetLeader("my_topic", 0, s.broker0.BrokerID()),
+			"OffsetRequest": sarama.NewMockOffsetResponse(c).
+				SetVersion(1).
+				SetOffset("my_topic", 0, sarama.OffsetOldest, 0).
+				SetOffset("my_topic", 0, sarama.OffsetNewest, 1),
+			"FetchRequest": sarama.NewMockWrapper(fetchRs),
+		})
+		kafkaClt, _ := sarama.NewClient([]string{s.broker0.Addr()}, s.cfg.SaramaClientCfg())
+		f := SpawnFactory(s.ns, s.cfg, kafkaClt)
+		mf, _, err := f.Spawn(s.ns.NewChild("my_topic", 0), "my_topic", 0, 0)
+		c.Assert(err, IsNil)
+
+		// When
+		msg := <-mf.Messages()
+
+		// Then
+		c.Check(msg.Timestamp.Equal(tc.timestamp), Equals, true, Commentf("case #%d: %v", i, msg.Timestamp))
+		c.Check(msg.TimestampType, Equals, tc.timestampType, Commentf("case #%d", i))
+
+		mf.Stop()
+		f.Stop()
+		kafkaClt.Close()
+	}
+}
+
+// Messages of zstd compressed record batches are decompressed and returned
+// one by one.
+func (s *MsgFetcherSuite) TestZstdRecordBatches(c *C) {
+	s.cfg.Kafka.Version.Set(sarama.V2_1_0_0)
+	timestamp := time.Date(2021, 3, 4, 5, 6, 7, 8000000, time.UTC)
+	fetchRs := &sarama.FetchResponse{Version: 4}
+	fetchRs.AddRecordWithTimestamp("my_topic", 0, sarama.StringEncoder("k0"), sarama.StringEncoder("v0"), 0, timestamp)
+	fetchRs.AddRecordWithTimestamp("my_topic", 0, sarama.StringEncoder("k1"), sarama.StringEncoder("v1"), 1, timestamp)
+	fetchRs.AddRecordWithTimestamp("my_topic", 0, sarama.StringEncoder("k2"), sarama.StringEncoder("v2"), 2, timestamp)
+	fetchRs.SetLastOffsetDelta("my_topic", 0, 2)
+	fetchRs.AddRecordBatchWithTimestamp("my_topic", 0, sarama.StringEncoder("k3"), sarama.StringEncoder("v3"), 3, 0, false, timestamp)
+	for _, records := range fetchRs.GetBlock("my_topic", 0).RecordsSet {
+		records.RecordBatch.Codec = sarama.CompressionZSTD
+		records.RecordBatch.CompressionLevel = 3
+	}
+	s.broker0.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(c).
+			SetBroker(s.broker0.Addr(), s.broker0.BrokerID()).
+			SetLeader("my_topic", 0, s.broker0.BrokerID()),
+		"OffsetRequest": sarama.NewMockOffsetResponse(c).
+			SetVersion(1).
+			SetOffset("my_topic", 0, sarama.OffsetOldest, 0).
+			SetOffset("my_topic", 0, sarama.OffsetNewest, 4),
+		"FetchRequest": sarama.NewMockWrapper(fetchRs),
+	})
+
+	kafkaClt, _ := sarama.NewClient([]string{s.broker0.Addr()}, s.cfg.SaramaClientCfg())
+	defer kafkaClt.Close()
+
+	f := SpawnFactory(s.ns, s.cfg, kafkaClt)
+	defer f.Stop()
+
+	// When
+	mf, _, err := f.Spawn(s.ns.NewChild("my_topic", 0), "my_topic", 0, 0)
+	c.Assert(err, IsNil)
+	defer mf.Stop()
+
+	// Then
+	for i := 0; i < 4; i++ {
+		select {
+		case msg := <-mf.Messages():
+			c.Assert(msg.Offset, Equals, int64(i))
+			c.Assert(string(msg.Key), Equals, fmt.Sprintf("k%d", i))
+			c.Assert(string(msg.Value), Equals, fmt.Sprintf("v%d", i))
+			c.Assert(msg.Timestamp.Equal(timestamp), Equals, true)
+		case err := <-mf.(*msgFetcher).errorsCh:
+			c.Fatal(err)
+		case <-time.After(3 * time.Second):
+			c.Fatalf("message #%d is not received", i)
+		}
+	}
+}
+
 // It is possible to close a partition consumer and create the same anew.
 func (s *MsgFetcherSuite) TestRecreate(c *C) {
 	s.broker0.SetHandlerByMap(map[string]sarama.MockResponse{
@@ -586,6 +683,8 @@ func (s *MsgFetcherSuite) TestRebalancingMultiplePartitions(c *C) {
 	// seed broker tells that the new partition 0 leader is leader1
 	s.broker0.SetHandlerByMap(map[string]sarama.MockResponse{
 		"MetadataRequest": sarama.NewMockMetadataResponse(c).
+			SetBroker(leader0.Addr(), leader0.BrokerID()).
+			SetBroker(leader1.Addr(), leader1.BrokerID()).
 			SetLeader("my_topic", 0, leader1.BrokerID()).
 			SetLeader("my_topic", 1, leader1.BrokerID()),
 	})
@@ -626,6 +725,8 @@ func (s *MsgFetcherSuite) TestRebalancingMultiplePartitions(c *C) {
 	// metadata assigns 0 to leader1 and 1 to leader0
 	s.broker0.SetHandlerByMap(map[string]sarama.MockResponse{
 		"MetadataRequest": sarama.NewMockMetadataResponse(c).
+			SetBroker(leader0.Addr(), leader0.BrokerID()).
+			SetBroker(leader1.Addr(), leader1.BrokerID()).
 			SetLeader("my_topic", 0, leader1.BrokerID()).
 			SetLeader("my_topic", 1, leader0.BrokerID()),
 	})
@@ -136,6 +136,17 @@ func (ot *T) OnAcked(offset int64) (offsetmgr.Offset, int) {
 	return ot.offset, len(ot.offers)
 }
 
+// OnSkipped should be called when a message has been consumed without being
+// offered to a consumer, e.g. when it is a chunk of a message that is offered
+// reassembled. The offset is considered acknowledged. It returns the same
+// values as OnAcked.
+func (ot *T) OnSkipped(offset int64) (offsetmgr.Offset, int) {
+	if ot.updateAckedRanges(offset) {
+		ot.offset.Meta = encodeAckedRanges(ot.offset.Val, ot.ackedRanges)
+	}
+	return ot.offset, len(ot.offers)
+}
+
 // IsAcked checks if an offset has already been acknowledged. The second
 // returned value is the smallest not acked offset that is greater than the
 // specified offset.
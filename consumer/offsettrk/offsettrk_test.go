@@ -246,6 +246,26 @@ func (s *OffsetTrkSuite) TestIsAcked(c *C) {
 	}
 }
 
+// Skipped offsets are acknowledged without affecting offers.
+func (s *OffsetTrkSuite) TestOnSkipped(c *C) {
+	ot := New(s.ns, offsetmgr.Offset{Val: 300}, -1)
+	ot.OnOffered(msg(302))
+
+	// When
+	offset, offerCount := ot.OnSkipped(301)
+	c.Assert(offset.Val, Equals, int64(300))
+	c.Assert(SparseAcks2Str(offset), Equals, "1-2")
+	c.Assert(offerCount, Equals, 1)
+	offset, offerCount = ot.OnSkipped(300)
+	c.Assert(offset.Val, Equals, int64(302))
+	c.Assert(offerCount, Equals, 1)
+	offset, offerCount = ot.OnAcked(302)
+
+	// Then
+	c.Assert(offset, Equals, offsetmgr.Offset{Val: 303})
+	c.Assert(offerCount, Equals, 0)
+}
+
 func (s *OffsetTrkSuite) TestOfferAckLoop(c *C) {
 	ot := New(s.ns, offsetmgr.Offset{Val: 300}, -1)
 	for i, tc := range []struct {
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/mailgun/kafka-pixy/actor"
+	"github.com/mailgun/kafka-pixy/chunking"
 	"github.com/mailgun/kafka-pixy/config"
 	"github.com/mailgun/kafka-pixy/consumer"
 	"github.com/mailgun/kafka-pixy/consumer/msgfetcher"
@@ -55,6 +56,13 @@ type T struct {
 	offsetTrk       *offsettrk.T
 	offerCount      int32
 
+	// Chunks of messages split by a producer are collected here until a
+	// message can be reassembled. Offsets of all chunks but the last one are
+	// kept by offsets of offered reassembled messages, to be acknowledged
+	// along with them.
+	chunks       *chunking.Assembler
+	chunkOffsets map[int64][]int64
+
 	// For tests only!
 	firstMsgFetched bool
 }
@@ -123,6 +131,8 @@ func (pc *T) run() {
 	}
 	pc.actDesc.Log().Infof("Initial offset: %s", offsetRepr(pc.committedOffset))
 	pc.offsetTrk = offsettrk.New(pc.actDesc, pc.committedOffset, pc.cfg.Consumer.AckTimeout)
+	pc.chunks = chunking.NewAssembler(pc.cfg.Consumer.ChunkTimeout)
+	pc.chunkOffsets = make(map[int64][]int64)
 	pc.submittedOffset = pc.committedOffset
 	pc.offsetsOk = true
 	pc.notifyTestInitialized(pc.committedOffset)
@@ -136,10 +146,7 @@ func (pc *T) run() {
 		select {
 		case event := <-pc.eventsCh:
 			if event.T == consumer.EvAcked {
-				var offerCount int
-				pc.submittedOffset, offerCount = pc.offsetTrk.OnAcked(event.Offset)
-				atomic.StoreInt32(&pc.offerCount, int32(offerCount))
-				pc.offsetMgr.SubmitOffset(pc.submittedOffset)
+				pc.onAcked(event.Offset)
 			}
 		case <-time.After(timeout):
 			continue
@@ -156,6 +163,9 @@ func (pc *T) runFetchLoop() bool {
 	}
 	defer mf.Stop()
 
+	// Chunks of incomplete messages are going to be fetched again.
+	pc.chunks.Reset()
+
 	var offerCount int
 	pc.submittedOffset, offerCount = pc.offsetTrk.Adjust(realOffsetVal)
 	atomic.StoreInt32(&pc.offerCount, int32(offerCount))
@@ -188,6 +198,10 @@ func (pc *T) runFetchLoop() bool {
 				msgOk = false
 				continue
 			}
+			// Keep fetching until all chunks of a message are fetched.
+			if msg, msgOk = pc.reassemble(msg); !msgOk {
+				continue
+			}
 			msg.EventsCh = pc.eventsCh
 			pc.notifyTestFetched()
 			nilOrMsgOutCh = pc.messagesCh
@@ -226,9 +240,7 @@ func (pc *T) runFetchLoop() bool {
 				nilOrMsgInCh = mf.Messages()
 
 			case consumer.EvAcked:
-				pc.submittedOffset, offerCount = pc.offsetTrk.OnAcked(event.Offset)
-				atomic.StoreInt32(&pc.offerCount, int32(offerCount))
-				pc.offsetMgr.SubmitOffset(pc.submittedOffset)
+				offerCount = pc.onAcked(event.Offset)
 				if !msgOk && offerCount <= pc.cfg.Consumer.MaxPendingMessages {
 					nilOrMsgInCh = mf.Messages()
 				}
@@ -250,8 +262,7 @@ func (pc *T) nextRetry() (consumer.Message, bool) {
 	for ok && pc.cfg.Consumer.MaxRetries >= 0 && retryNo > pc.cfg.Consumer.MaxRetries {
 		pc.actDesc.Log().Errorf("Too many retries: retryNo=%d, offset=%d, key=%s, msg=%s",
 			retryNo, msg.Offset, string(msg.Key), base64.StdEncoding.EncodeToString(msg.Value))
-		pc.submittedOffset, _ = pc.offsetTrk.OnAcked(msg.Offset)
-		pc.offsetMgr.SubmitOffset(pc.submittedOffset)
+		pc.onAcked(msg.Offset)
 		// TODO: Dump expired messages to a long term storage?
 		msg, retryNo, ok = pc.offsetTrk.NextRetry()
 	}
@@ -262,6 +273,62 @@ func (pc *T) nextRetry() (consumer.Message, bool) {
 	return msg, ok
 }
 
+// onAcked acknowledges an offered message along with all its chunks if it
+// was reassembled from chunks, and submits the resulting offset. It returns
+// the total number of offered messages.
+func (pc *T) onAcked(offset int64) int {
+	var offerCount int
+	pc.submittedOffset, offerCount = pc.offsetTrk.OnAcked(offset)
+	for _, chunkOffset := range pc.chunkOffsets[offset] {
+		pc.submittedOffset, offerCount = pc.offsetTrk.OnSkipped(chunkOffset)
+	}
+	delete(pc.chunkOffsets, offset)
+	atomic.StoreInt32(&pc.offerCount, int32(offerCount))
+	pc.offsetMgr.SubmitOffset(pc.submittedOffset)
+	return offerCount
+}
+
+// reassemble returns a fetched message as is unless it is a chunk. Chunks are
+// collected until all chunks of a message are fetched, and then the
+// reassembled message is returned. The returned flag is false if there is no
+// message to offer yet.
+func (pc *T) reassemble(msg consumer.Message) (consumer.Message, bool) {
+	pc.expireChunks(msg.Timestamp)
+	info, ok, err := chunking.Parse(msg.Headers)
+	if err != nil {
+		pc.actDesc.Log().WithError(err).Errorf("Bad chunk offered as is: offset=%d", msg.Offset)
+		return msg, true
+	}
+	if !ok {
+		return msg, true
+	}
+	assembled, chunkOffsets, ok, err := pc.chunks.Add(msg, info)
+	if err != nil {
+		pc.actDesc.Log().WithError(err).Errorf("Bad chunk offered as is: offset=%d", msg.Offset)
+		return msg, true
+	}
+	if !ok {
+		return consumer.Message{}, false
+	}
+	pc.chunkOffsets[assembled.Offset] = chunkOffsets
+	return assembled, true
+}
+
+// expireChunks discards incomplete messages, that no chunks were produced for
+// within the chunk timeout before the given timestamp, and acknowledges
+// their chunks. Otherwise they would block offset commits forever.
+func (pc *T) expireChunks(timestamp time.Time) {
+	for id, offsets := range pc.chunks.Expire(timestamp) {
+		pc.actDesc.Log().Errorf("Incomplete chunked message discarded: id=%s, offsets=%v", id, offsets)
+		var offerCount int
+		for _, offset := range offsets {
+			pc.submittedOffset, offerCount = pc.offsetTrk.OnSkipped(offset)
+		}
+		atomic.StoreInt32(&pc.offerCount, int32(offerCount))
+		pc.offsetMgr.SubmitOffset(pc.submittedOffset)
+	}
+}
+
 func (pc *T) stopOffsetMgr() {
 	pc.offsetMgr.Stop()
 	if !pc.offsetsOk {
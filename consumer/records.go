@@ -0,0 +1,80 @@
+package consumer
+
+import (
+	"github.com/Shopify/sarama"
+)
+
+// ParseRecordBatch returns messages of a record batch fetched from a topic
+// partition, that have offsets not less than `offset`. Control batches, that
+// is transaction markers, yield no messages.
+func ParseRecordBatch(batch *sarama.RecordBatch, topic string, partition int32, offset int64) []Message {
+	if batch.Control {
+		return nil
+	}
+	var messages []Message
+	for _, record := range batch.Records {
+		msgOffset := batch.FirstOffset + record.OffsetDelta
+		if msgOffset < offset {
+			continue
+		}
+		timestamp, timestampType := batch.FirstTimestamp.Add(record.TimestampDelta), TimestampCreateTime
+		// With log append time the broker sets the batch max timestamp only.
+		if batch.LogAppendTime {
+			timestamp, timestampType = batch.MaxTimestamp, TimestampLogAppendTime
+		}
+		messages = append(messages, Message{
+			ConsumerMessage: sarama.ConsumerMessage{
+				Topic:     topic,
+				Partition: partition,
+				Key:       record.Key,
+				Value:     record.Value,
+				Headers:   record.Headers,
+				Offset:    msgOffset,
+				Timestamp: timestamp,
+			},
+			TimestampType: timestampType,
+		})
+	}
+	return messages
+}
+
+// ParseMessageSet returns messages of a legacy message set fetched from a
+// topic partition, that have offsets not less than `offset`. Compressed
+// messages are unwrapped.
+func ParseMessageSet(msgSet *sarama.MessageSet, topic string, partition int32, offset int64) []Message {
+	var messages []Message
+	for _, msgBlock := range msgSet.Messages {
+		innerMsgs := msgBlock.Messages()
+		baseOffset := msgBlock.Offset - innerMsgs[len(innerMsgs)-1].Offset
+		for _, msg := range innerMsgs {
+			msgOffset := msg.Offset
+			if msg.Msg.Version >= 1 {
+				msgOffset += baseOffset
+			}
+			if msgOffset < offset {
+				continue
+			}
+			timestamp, timestampType := msg.Msg.Timestamp, TimestampNone
+			if msg.Msg.Version >= 1 {
+				timestampType = TimestampCreateTime
+				// With log append time the broker sets the timestamp of the
+				// outer (compressed) message only.
+				if msgBlock.Msg.LogAppendTime {
+					timestamp, timestampType = msgBlock.Msg.Timestamp, TimestampLogAppendTime
+				}
+			}
+			messages = append(messages, Message{
+				ConsumerMessage: sarama.ConsumerMessage{
+					Topic:     topic,
+					Partition: partition,
+					Key:       msg.Msg.Key,
+					Value:     msg.Msg.Value,
+					Offset:    msgOffset,
+					Timestamp: timestamp,
+				},
+				TimestampType: timestampType,
+			})
+		}
+	}
+	return messages
+}
@@ -0,0 +1,80 @@
+package consumer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+type RecordsSuite struct{}
+
+var _ = Suite(&RecordsSuite{})
+
+// Records before the requested offset are skipped, and timestamps are
+// resolved according to the batch timestamp type.
+func (s *RecordsSuite) TestParseRecordBatch(c *C) {
+	firstTimestamp := time.Unix(1500000000, 0)
+	batch := sarama.RecordBatch{
+		FirstOffset:    10,
+		FirstTimestamp: firstTimestamp,
+		Records: []*sarama.Record{
+			{OffsetDelta: 0, Key: []byte("k0"), Value: []byte("v0")},
+			{OffsetDelta: 1, Key: []byte("k1"), Value: []byte("v1"), TimestampDelta: time.Second},
+			{OffsetDelta: 2, Value: []byte("v2"), TimestampDelta: 2 * time.Second,
+				Headers: []*sarama.RecordHeader{{Key: []byte("h"), Value: []byte("hv")}}},
+		},
+	}
+
+	// When
+	messages := ParseRecordBatch(&batch, "foo", 3, 11)
+
+	// Then
+	c.Assert(len(messages), Equals, 2)
+	c.Check(messages[0].Topic, Equals, "foo")
+	c.Check(messages[0].Partition, Equals, int32(3))
+	c.Check(messages[0].Offset, Equals, int64(11))
+	c.Check(string(messages[0].Key), Equals, "k1")
+	c.Check(messages[0].Timestamp, Equals, firstTimestamp.Add(time.Second))
+	c.Check(messages[0].TimestampType, Equals, TimestampCreateTime)
+	c.Check(messages[1].Offset, Equals, int64(12))
+	c.Check(messages[1].Key, IsNil)
+	c.Check(string(messages[1].Headers[0].Value), Equals, "hv")
+}
+
+// With log append time all records of a batch get the batch max timestamp.
+func (s *RecordsSuite) TestParseRecordBatchLogAppendTime(c *C) {
+	maxTimestamp := time.Unix(1500000100, 0)
+	batch := sarama.RecordBatch{
+		FirstOffset:    10,
+		FirstTimestamp: time.Unix(1500000000, 0),
+		MaxTimestamp:   maxTimestamp,
+		LogAppendTime:  true,
+		Records:        []*sarama.Record{{OffsetDelta: 0}, {OffsetDelta: 1}},
+	}
+
+	// When
+	messages := ParseRecordBatch(&batch, "foo", 0, 0)
+
+	// Then
+	c.Assert(len(messages), Equals, 2)
+	for _, msg := range messages {
+		c.Check(msg.Timestamp, Equals, maxTimestamp)
+		c.Check(msg.TimestampType, Equals, TimestampLogAppendTime)
+	}
+}
+
+// Transaction markers are not messages.
+func (s *RecordsSuite) TestParseRecordBatchControl(c *C) {
+	batch := sarama.RecordBatch{
+		FirstOffset: 10,
+		Control:     true,
+		Records:     []*sarama.Record{{OffsetDelta: 0}},
+	}
+	c.Check(ParseRecordBatch(&batch, "foo", 0, 10), HasLen, 0)
+}
@@ -109,6 +109,24 @@ type ProdRq struct {
 	AsyncMode bool `protobuf:"varint,6,opt,name=async_mode,json=asyncMode,proto3" json:"async_mode,omitempty"`
 	// Headers to include with the published message
 	Headers []*RecordHeader `protobuf:"bytes,7,rep,name=headers,proto3" json:"headers,omitempty"`
+	// If true then the message is written to the partition specified in the
+	// partition field, and key_value and key_undefined are not used to select
+	// a partition. If the topic does not have such partition, then the
+	// request fails with Invalid Argument (3).
+	ExplicitPartition bool `protobuf:"varint,8,opt,name=explicit_partition,json=explicitPartition,proto3" json:"explicit_partition,omitempty"`
+	// Partition to write the message to, if explicit_partition is true.
+	Partition int32 `protobuf:"varint,9,opt,name=partition,proto3" json:"partition,omitempty"`
+	// Message timestamp in milliseconds since the Unix epoch. If 0, then the
+	// current time is used. Requires Kafka 0.10.0.0 or later, otherwise it is
+	// ignored.
+	Timestamp int64 `protobuf:"varint,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// If set in async mode, then the result of writing the message to Kafka
+	// is reported under this ID by the DeliveryReports stream. Such messages
+	// are not persisted in the producer spool, and failures are reported to
+	// the client rather than to the dead letter handler. It is an error to
+	// set it when async_mode is false, or when the client did not
+	// authenticate with a TLS client certificate.
+	CorrelationId string `protobuf:"bytes,11,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
 }
 
 func (x *ProdRq) Reset() {
@@ -192,6 +210,34 @@ func (x *ProdRq) GetHeaders() []*RecordHeader {
 	return nil
 }
 
+func (x *ProdRq) GetExplicitPartition() bool {
+	if x != nil {
+		return x.ExplicitPartition
+	}
+	return false
+}
+
+func (x *ProdRq) GetPartition() int32 {
+	if x != nil {
+		return x.Partition
+	}
+	return 0
+}
+
+func (x *ProdRq) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ProdRq) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
 type ProdRs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -203,6 +249,10 @@ type ProdRs struct {
 	// Offset the message was written to. The value only makes sense if
 	// ProdReq.async_mode was false.
 	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Name of the cluster that accepted the message. It differs from the
+	// requested one if the message was failed over to another cluster
+	// according to a produce route of the topic. Only set by Produce.
+	Cluster string `protobuf:"bytes,3,opt,name=cluster,proto3" json:"cluster,omitempty"`
 }
 
 func (x *ProdRs) Reset() {
@@ -251,31 +301,38 @@ func (x *ProdRs) GetOffset() int64 {
 	return 0
 }
 
-type ConsNAckRq struct {
+func (x *ProdRs) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+type ProdMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Name of a Kafka cluster to operate on.
-	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
 	// Name of a topic to produce to.
-	Topic string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
-	// Name of a consumer group.
-	Group string `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
-	// If true then no message is acknowledged by the request.
-	NoAck bool `protobuf:"varint,4,opt,name=no_ack,json=noAck,proto3" json:"no_ack,omitempty"`
-	// If true and no_ack is false then the message returned by the requests is
-	// automatically acknowledged by Kafka-Pixy before the request completes.
-	AutoAck bool `protobuf:"varint,5,opt,name=auto_ack,json=autoAck,proto3" json:"auto_ack,omitempty"`
-	// If both no_ack and auto_ack are false (by default), then ack_partition
-	// and ack_offset along with cluster-group-topic determine the message that
-	// should be acknowledged by the request.
-	AckPartition int32 `protobuf:"varint,6,opt,name=ack_partition,json=ackPartition,proto3" json:"ack_partition,omitempty"`
-	AckOffset    int64 `protobuf:"varint,7,opt,name=ack_offset,json=ackOffset,proto3" json:"ack_offset,omitempty"`
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	// Hash of the key is used to determine the partition to produce to. By
+	// default it is an empty array which is a valid key, unless key_undefined
+	// is set to true and then a random partition is selected.
+	KeyValue []byte `protobuf:"bytes,2,opt,name=key_value,json=keyValue,proto3" json:"key_value,omitempty"`
+	// If true then the message is written to a random partition, otherwise
+	// hash of key_value is used to determine the partition.
+	KeyUndefined bool `protobuf:"varint,3,opt,name=key_undefined,json=keyUndefined,proto3" json:"key_undefined,omitempty"`
+	// Message body.
+	Message []byte `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// Headers to include with the published message
+	Headers []*RecordHeader `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty"`
+	// Message timestamp in milliseconds since the Unix epoch. If 0, then the
+	// current time is used.
+	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *ConsNAckRq) Reset() {
-	*x = ConsNAckRq{}
+func (x *ProdMsg) Reset() {
+	*x = ProdMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kafkapixy_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -283,13 +340,13 @@ func (x *ConsNAckRq) Reset() {
 	}
 }
 
-func (x *ConsNAckRq) String() string {
+func (x *ProdMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConsNAckRq) ProtoMessage() {}
+func (*ProdMsg) ProtoMessage() {}
 
-func (x *ConsNAckRq) ProtoReflect() protoreflect.Message {
+func (x *ProdMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_kafkapixy_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -301,82 +358,66 @@ func (x *ConsNAckRq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConsNAckRq.ProtoReflect.Descriptor instead.
-func (*ConsNAckRq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProdMsg.ProtoReflect.Descriptor instead.
+func (*ProdMsg) Descriptor() ([]byte, []int) {
 	return file_kafkapixy_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ConsNAckRq) GetCluster() string {
-	if x != nil {
-		return x.Cluster
-	}
-	return ""
-}
-
-func (x *ConsNAckRq) GetTopic() string {
+func (x *ProdMsg) GetTopic() string {
 	if x != nil {
 		return x.Topic
 	}
 	return ""
 }
 
-func (x *ConsNAckRq) GetGroup() string {
+func (x *ProdMsg) GetKeyValue() []byte {
 	if x != nil {
-		return x.Group
+		return x.KeyValue
 	}
-	return ""
+	return nil
 }
 
-func (x *ConsNAckRq) GetNoAck() bool {
+func (x *ProdMsg) GetKeyUndefined() bool {
 	if x != nil {
-		return x.NoAck
+		return x.KeyUndefined
 	}
 	return false
 }
 
-func (x *ConsNAckRq) GetAutoAck() bool {
+func (x *ProdMsg) GetMessage() []byte {
 	if x != nil {
-		return x.AutoAck
+		return x.Message
 	}
-	return false
+	return nil
 }
 
-func (x *ConsNAckRq) GetAckPartition() int32 {
+func (x *ProdMsg) GetHeaders() []*RecordHeader {
 	if x != nil {
-		return x.AckPartition
+		return x.Headers
 	}
-	return 0
+	return nil
 }
 
-func (x *ConsNAckRq) GetAckOffset() int64 {
+func (x *ProdMsg) GetTimestamp() int64 {
 	if x != nil {
-		return x.AckOffset
+		return x.Timestamp
 	}
 	return 0
 }
 
-type ConsRs struct {
+type ProdAtomicRq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Partition the message was read from.
-	Partition int32 `protobuf:"varint,1,opt,name=partition,proto3" json:"partition,omitempty"`
-	// Offset of the read message in the partition.
-	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	// Key that was used to produce the message, unless key_undefined is true,
-	// then it is undefined.
-	KeyValue []byte `protobuf:"bytes,3,opt,name=key_value,json=keyValue,proto3" json:"key_value,omitempty"`
-	// If true then the message was produced to a random partition.
-	KeyUndefined bool `protobuf:"varint,4,opt,name=key_undefined,json=keyUndefined,proto3" json:"key_undefined,omitempty"`
-	// Message body
-	Message []byte `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
-	// Headers associated with the message
-	Headers []*RecordHeader `protobuf:"bytes,6,rep,name=headers,proto3" json:"headers,omitempty"`
+	// Name of a Kafka cluster to operate on.
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// Messages to be written in a single transaction.
+	Messages []*ProdMsg `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
 }
 
-func (x *ConsRs) Reset() {
-	*x = ConsRs{}
+func (x *ProdAtomicRq) Reset() {
+	*x = ProdAtomicRq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kafkapixy_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -384,13 +425,13 @@ func (x *ConsRs) Reset() {
 	}
 }
 
-func (x *ConsRs) String() string {
+func (x *ProdAtomicRq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConsRs) ProtoMessage() {}
+func (*ProdAtomicRq) ProtoMessage() {}
 
-func (x *ConsRs) ProtoReflect() protoreflect.Message {
+func (x *ProdAtomicRq) ProtoReflect() protoreflect.Message {
 	mi := &file_kafkapixy_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -402,72 +443,37 @@ func (x *ConsRs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConsRs.ProtoReflect.Descriptor instead.
-func (*ConsRs) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProdAtomicRq.ProtoReflect.Descriptor instead.
+func (*ProdAtomicRq) Descriptor() ([]byte, []int) {
 	return file_kafkapixy_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ConsRs) GetPartition() int32 {
-	if x != nil {
-		return x.Partition
-	}
-	return 0
-}
-
-func (x *ConsRs) GetOffset() int64 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
-}
-
-func (x *ConsRs) GetKeyValue() []byte {
-	if x != nil {
-		return x.KeyValue
-	}
-	return nil
-}
-
-func (x *ConsRs) GetKeyUndefined() bool {
-	if x != nil {
-		return x.KeyUndefined
-	}
-	return false
-}
-
-func (x *ConsRs) GetMessage() []byte {
+func (x *ProdAtomicRq) GetCluster() string {
 	if x != nil {
-		return x.Message
+		return x.Cluster
 	}
-	return nil
+	return ""
 }
 
-func (x *ConsRs) GetHeaders() []*RecordHeader {
+func (x *ProdAtomicRq) GetMessages() []*ProdMsg {
 	if x != nil {
-		return x.Headers
+		return x.Messages
 	}
 	return nil
 }
 
-type AckRq struct {
+type ProdAtomicRs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Name of a Kafka cluster to operate on.
-	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
-	// Name of a topic to produce to.
-	Topic string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
-	// Name of a consumer group.
-	Group string `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
-	// Partition that the acknowledged message was consumed from.
-	Partition int32 `protobuf:"varint,4,opt,name=partition,proto3" json:"partition,omitempty"`
-	// Offset in the partition that the acknowledged message was consumed from.
-	Offset int64 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Partitions and offsets the messages were written to, in the order the
+	// messages were given in the request.
+	Results []*ProdRs `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *AckRq) Reset() {
-	*x = AckRq{}
+func (x *ProdAtomicRs) Reset() {
+	*x = ProdAtomicRs{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kafkapixy_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -475,13 +481,13 @@ func (x *AckRq) Reset() {
 	}
 }
 
-func (x *AckRq) String() string {
+func (x *ProdAtomicRs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AckRq) ProtoMessage() {}
+func (*ProdAtomicRs) ProtoMessage() {}
 
-func (x *AckRq) ProtoReflect() protoreflect.Message {
+func (x *ProdAtomicRs) ProtoReflect() protoreflect.Message {
 	mi := &file_kafkapixy_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -493,54 +499,31 @@ func (x *AckRq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AckRq.ProtoReflect.Descriptor instead.
-func (*AckRq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProdAtomicRs.ProtoReflect.Descriptor instead.
+func (*ProdAtomicRs) Descriptor() ([]byte, []int) {
 	return file_kafkapixy_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *AckRq) GetCluster() string {
-	if x != nil {
-		return x.Cluster
-	}
-	return ""
-}
-
-func (x *AckRq) GetTopic() string {
-	if x != nil {
-		return x.Topic
-	}
-	return ""
-}
-
-func (x *AckRq) GetGroup() string {
-	if x != nil {
-		return x.Group
-	}
-	return ""
-}
-
-func (x *AckRq) GetPartition() int32 {
-	if x != nil {
-		return x.Partition
-	}
-	return 0
-}
-
-func (x *AckRq) GetOffset() int64 {
+func (x *ProdAtomicRs) GetResults() []*ProdRs {
 	if x != nil {
-		return x.Offset
+		return x.Results
 	}
-	return 0
+	return nil
 }
 
-type AckRs struct {
+type ProdBatchRq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Name of a Kafka cluster to operate on.
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// Messages to be written.
+	Messages []*ProdMsg `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
 }
 
-func (x *AckRs) Reset() {
-	*x = AckRs{}
+func (x *ProdBatchRq) Reset() {
+	*x = ProdBatchRq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kafkapixy_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -548,13 +531,13 @@ func (x *AckRs) Reset() {
 	}
 }
 
-func (x *AckRs) String() string {
+func (x *ProdBatchRq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AckRs) ProtoMessage() {}
+func (*ProdBatchRq) ProtoMessage() {}
 
-func (x *AckRs) ProtoReflect() protoreflect.Message {
+func (x *ProdBatchRq) ProtoReflect() protoreflect.Message {
 	mi := &file_kafkapixy_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -566,36 +549,47 @@ func (x *AckRs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AckRs.ProtoReflect.Descriptor instead.
-func (*AckRs) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProdBatchRq.ProtoReflect.Descriptor instead.
+func (*ProdBatchRq) Descriptor() ([]byte, []int) {
 	return file_kafkapixy_proto_rawDescGZIP(), []int{6}
 }
 
-type PartitionOffset struct {
+func (x *ProdBatchRq) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *ProdBatchRq) GetMessages() []*ProdMsg {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type ProdBatchResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The Partition this structure describes
+	// Partition the message was written to. It is -1 if the message failed.
 	Partition int32 `protobuf:"varint,1,opt,name=partition,proto3" json:"partition,omitempty"`
-	// The beginning offset
-	Begin int64 `protobuf:"varint,2,opt,name=begin,proto3" json:"begin,omitempty"`
-	// The ending offset
-	End int64 `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
-	// The number of messages in the partition
-	Count int64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
-	// Offset in the partition
-	Offset int64 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
-	// The number of un-consumed messages in the partition
-	Lag int64 `protobuf:"varint,6,opt,name=lag,proto3" json:"lag,omitempty"`
-	// Metatdata associated with the partition
-	Metadata string `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// human readable representation of sparsely committed ranges
-	SparseAcks string `protobuf:"bytes,8,opt,name=sparse_acks,json=sparseAcks,proto3" json:"sparse_acks,omitempty"`
-}
-
-func (x *PartitionOffset) Reset() {
-	*x = PartitionOffset{}
+	// Offset the message was written to. It is -1 if the message failed.
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// gRPC status code that the message would have failed with, had it been
+	// produced by the Produce method. 0 (OK) if the message was written.
+	ErrorCode int32 `protobuf:"varint,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// Error description, empty if the message was written.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// Name of the cluster that accepted the message. It differs from the
+	// requested one if the message was failed over to another cluster
+	// according to a produce route of the topic. Empty if the message failed.
+	Cluster string `protobuf:"bytes,5,opt,name=cluster,proto3" json:"cluster,omitempty"`
+}
+
+func (x *ProdBatchResult) Reset() {
+	*x = ProdBatchResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kafkapixy_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -603,13 +597,13 @@ func (x *PartitionOffset) Reset() {
 	}
 }
 
-func (x *PartitionOffset) String() string {
+func (x *ProdBatchResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PartitionOffset) ProtoMessage() {}
+func (*ProdBatchResult) ProtoMessage() {}
 
-func (x *PartitionOffset) ProtoReflect() protoreflect.Message {
+func (x *ProdBatchResult) ProtoReflect() protoreflect.Message {
 	mi := &file_kafkapixy_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -621,82 +615,57 @@ func (x *PartitionOffset) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PartitionOffset.ProtoReflect.Descriptor instead.
-func (*PartitionOffset) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProdBatchResult.ProtoReflect.Descriptor instead.
+func (*ProdBatchResult) Descriptor() ([]byte, []int) {
 	return file_kafkapixy_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *PartitionOffset) GetPartition() int32 {
+func (x *ProdBatchResult) GetPartition() int32 {
 	if x != nil {
 		return x.Partition
 	}
 	return 0
 }
 
-func (x *PartitionOffset) GetBegin() int64 {
+func (x *ProdBatchResult) GetOffset() int64 {
 	if x != nil {
-		return x.Begin
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *PartitionOffset) GetEnd() int64 {
+func (x *ProdBatchResult) GetErrorCode() int32 {
 	if x != nil {
-		return x.End
+		return x.ErrorCode
 	}
 	return 0
 }
 
-func (x *PartitionOffset) GetCount() int64 {
+func (x *ProdBatchResult) GetError() string {
 	if x != nil {
-		return x.Count
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-func (x *PartitionOffset) GetOffset() int64 {
+func (x *ProdBatchResult) GetCluster() string {
 	if x != nil {
-		return x.Offset
+		return x.Cluster
 	}
-	return 0
+	return ""
 }
 
-func (x *PartitionOffset) GetLag() int64 {
-	if x != nil {
-		return x.Lag
-	}
-	return 0
-}
-
-func (x *PartitionOffset) GetMetadata() string {
-	if x != nil {
-		return x.Metadata
-	}
-	return ""
-}
-
-func (x *PartitionOffset) GetSparseAcks() string {
-	if x != nil {
-		return x.SparseAcks
-	}
-	return ""
-}
-
-type GetOffsetsRq struct {
+type ProdBatchRs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Name of a Kafka cluster
-	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
-	// Name of a topic
-	Topic string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
-	// Name of a consumer group.
-	Group string `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	// Results in the order the messages were given in the request.
+	Results []*ProdBatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *GetOffsetsRq) Reset() {
-	*x = GetOffsetsRq{}
+func (x *ProdBatchRs) Reset() {
+	*x = ProdBatchRs{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kafkapixy_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -704,13 +673,13 @@ func (x *GetOffsetsRq) Reset() {
 	}
 }
 
-func (x *GetOffsetsRq) String() string {
+func (x *ProdBatchRs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOffsetsRq) ProtoMessage() {}
+func (*ProdBatchRs) ProtoMessage() {}
 
-func (x *GetOffsetsRq) ProtoReflect() protoreflect.Message {
+func (x *ProdBatchRs) ProtoReflect() protoreflect.Message {
 	mi := &file_kafkapixy_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -722,42 +691,34 @@ func (x *GetOffsetsRq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOffsetsRq.ProtoReflect.Descriptor instead.
-func (*GetOffsetsRq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProdBatchRs.ProtoReflect.Descriptor instead.
+func (*ProdBatchRs) Descriptor() ([]byte, []int) {
 	return file_kafkapixy_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetOffsetsRq) GetCluster() string {
-	if x != nil {
-		return x.Cluster
-	}
-	return ""
-}
-
-func (x *GetOffsetsRq) GetTopic() string {
-	if x != nil {
-		return x.Topic
-	}
-	return ""
-}
-
-func (x *GetOffsetsRq) GetGroup() string {
+func (x *ProdBatchRs) GetResults() []*ProdBatchResult {
 	if x != nil {
-		return x.Group
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-type GetOffsetsRs struct {
+type ProdStreamRq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Offsets []*PartitionOffset `protobuf:"bytes,1,rep,name=offsets,proto3" json:"offsets,omitempty"`
+	// Name of a Kafka cluster to operate on.
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// Sequence number assigned to the message by the client. It is returned
+	// with the message result in ProdStreamRs.
+	Seq int64 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	// Message to be written.
+	Message *ProdMsg `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *GetOffsetsRs) Reset() {
-	*x = GetOffsetsRs{}
+func (x *ProdStreamRq) Reset() {
+	*x = ProdStreamRq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kafkapixy_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -765,13 +726,13 @@ func (x *GetOffsetsRs) Reset() {
 	}
 }
 
-func (x *GetOffsetsRs) String() string {
+func (x *ProdStreamRq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOffsetsRs) ProtoMessage() {}
+func (*ProdStreamRq) ProtoMessage() {}
 
-func (x *GetOffsetsRs) ProtoReflect() protoreflect.Message {
+func (x *ProdStreamRq) ProtoReflect() protoreflect.Message {
 	mi := &file_kafkapixy_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -783,37 +744,56 @@ func (x *GetOffsetsRs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOffsetsRs.ProtoReflect.Descriptor instead.
-func (*GetOffsetsRs) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProdStreamRq.ProtoReflect.Descriptor instead.
+func (*ProdStreamRq) Descriptor() ([]byte, []int) {
 	return file_kafkapixy_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetOffsetsRs) GetOffsets() []*PartitionOffset {
+func (x *ProdStreamRq) GetCluster() string {
 	if x != nil {
-		return x.Offsets
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *ProdStreamRq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *ProdStreamRq) GetMessage() *ProdMsg {
+	if x != nil {
+		return x.Message
 	}
 	return nil
 }
 
-// Partition metadata as retrieved from kafka
-type PartitionMetadata struct {
+type ProdStreamRs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The Partition this structure describes
-	Partition int32 `protobuf:"varint,1,opt,name=partition,proto3" json:"partition,omitempty"`
-	// The node id for the kafka broker currently acting as leader for this partition.
-	// If no leader exists because we are in the middle of a leader election this id will be -1.
-	Leader int32 `protobuf:"varint,2,opt,name=leader,proto3" json:"leader,omitempty"`
-	// The set of alive nodes that currently acts as slaves for the leader for this partition.
-	Replicas []int32 `protobuf:"varint,3,rep,packed,name=replicas,proto3" json:"replicas,omitempty"`
-	// The set subset of the replicas that are "caught up" to the leader
-	Isr []int32 `protobuf:"varint,4,rep,packed,name=isr,proto3" json:"isr,omitempty"`
-}
-
-func (x *PartitionMetadata) Reset() {
-	*x = PartitionMetadata{}
+	// Sequence number of the message that the result is for.
+	Seq int64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	// Partition the message was written to. It is -1 if the message failed.
+	Partition int32 `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	// Offset the message was written to. It is -1 if the message failed.
+	Offset int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// gRPC status code that the message would have failed with, had it been
+	// produced by the Produce method. 0 (OK) if the message was written.
+	ErrorCode int32 `protobuf:"varint,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// Error description, empty if the message was written.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// Name of the cluster that accepted the message. It differs from the
+	// requested one if the message was failed over to another cluster
+	// according to a produce route of the topic. Empty if the message failed.
+	Cluster string `protobuf:"bytes,6,opt,name=cluster,proto3" json:"cluster,omitempty"`
+}
+
+func (x *ProdStreamRs) Reset() {
+	*x = ProdStreamRs{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kafkapixy_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -821,13 +801,13 @@ func (x *PartitionMetadata) Reset() {
 	}
 }
 
-func (x *PartitionMetadata) String() string {
+func (x *ProdStreamRs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PartitionMetadata) ProtoMessage() {}
+func (*ProdStreamRs) ProtoMessage() {}
 
-func (x *PartitionMetadata) ProtoReflect() protoreflect.Message {
+func (x *ProdStreamRs) ProtoReflect() protoreflect.Message {
 	mi := &file_kafkapixy_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -839,54 +819,61 @@ func (x *PartitionMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PartitionMetadata.ProtoReflect.Descriptor instead.
-func (*PartitionMetadata) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProdStreamRs.ProtoReflect.Descriptor instead.
+func (*ProdStreamRs) Descriptor() ([]byte, []int) {
 	return file_kafkapixy_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *PartitionMetadata) GetPartition() int32 {
+func (x *ProdStreamRs) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *ProdStreamRs) GetPartition() int32 {
 	if x != nil {
 		return x.Partition
 	}
 	return 0
 }
 
-func (x *PartitionMetadata) GetLeader() int32 {
+func (x *ProdStreamRs) GetOffset() int64 {
 	if x != nil {
-		return x.Leader
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *PartitionMetadata) GetReplicas() []int32 {
+func (x *ProdStreamRs) GetErrorCode() int32 {
 	if x != nil {
-		return x.Replicas
+		return x.ErrorCode
 	}
-	return nil
+	return 0
 }
 
-func (x *PartitionMetadata) GetIsr() []int32 {
+func (x *ProdStreamRs) GetError() string {
 	if x != nil {
-		return x.Isr
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-type GetTopicMetadataRq struct {
+func (x *ProdStreamRs) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+type DeliveryReportsRq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Name of a Kafka cluster
-	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
-	// Name of a topic
-	Topic string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
-	// Should include partition metadata
-	WithPartitions bool `protobuf:"varint,3,opt,name=with_partitions,json=withPartitions,proto3" json:"with_partitions,omitempty"`
 }
 
-func (x *GetTopicMetadataRq) Reset() {
-	*x = GetTopicMetadataRq{}
+func (x *DeliveryReportsRq) Reset() {
+	*x = DeliveryReportsRq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kafkapixy_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -894,13 +881,13 @@ func (x *GetTopicMetadataRq) Reset() {
 	}
 }
 
-func (x *GetTopicMetadataRq) String() string {
+func (x *DeliveryReportsRq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTopicMetadataRq) ProtoMessage() {}
+func (*DeliveryReportsRq) ProtoMessage() {}
 
-func (x *GetTopicMetadataRq) ProtoReflect() protoreflect.Message {
+func (x *DeliveryReportsRq) ProtoReflect() protoreflect.Message {
 	mi := &file_kafkapixy_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -912,47 +899,34 @@ func (x *GetTopicMetadataRq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTopicMetadataRq.ProtoReflect.Descriptor instead.
-func (*GetTopicMetadataRq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeliveryReportsRq.ProtoReflect.Descriptor instead.
+func (*DeliveryReportsRq) Descriptor() ([]byte, []int) {
 	return file_kafkapixy_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetTopicMetadataRq) GetCluster() string {
-	if x != nil {
-		return x.Cluster
-	}
-	return ""
-}
-
-func (x *GetTopicMetadataRq) GetTopic() string {
-	if x != nil {
-		return x.Topic
-	}
-	return ""
-}
-
-func (x *GetTopicMetadataRq) GetWithPartitions() bool {
-	if x != nil {
-		return x.WithPartitions
-	}
-	return false
-}
-
-type GetTopicMetadataRs struct {
+type DeliveryReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Version of this metadata
-	Version int32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	// Config values
-	Config map[string]string `protobuf:"bytes,2,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// Optional list of metadata for the partitions of this topic
-	Partitions []*PartitionMetadata `protobuf:"bytes,3,rep,name=partitions,proto3" json:"partitions,omitempty"`
-}
-
-func (x *GetTopicMetadataRs) Reset() {
-	*x = GetTopicMetadataRs{}
+	// Correlation ID supplied by the client in ProdRq.correlation_id.
+	CorrelationId string `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	// Name of the cluster that accepted the message.
+	Cluster string `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// Partition the message was written to. It is -1 if the message failed.
+	Partition int32 `protobuf:"varint,3,opt,name=partition,proto3" json:"partition,omitempty"`
+	// Offset the message was written to. It is -1 if the message failed.
+	Offset int64 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// gRPC status code that the message would have failed with, had it been
+	// produced by the Produce method in sync mode. 0 (OK) if the message was
+	// written.
+	ErrorCode int32 `protobuf:"varint,5,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// Error description, empty if the message was written.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *DeliveryReport) Reset() {
+	*x = DeliveryReport{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kafkapixy_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -960,13 +934,13 @@ func (x *GetTopicMetadataRs) Reset() {
 	}
 }
 
-func (x *GetTopicMetadataRs) String() string {
+func (x *DeliveryReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTopicMetadataRs) ProtoMessage() {}
+func (*DeliveryReport) ProtoMessage() {}
 
-func (x *GetTopicMetadataRs) ProtoReflect() protoreflect.Message {
+func (x *DeliveryReport) ProtoReflect() protoreflect.Message {
 	mi := &file_kafkapixy_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -978,42 +952,78 @@ func (x *GetTopicMetadataRs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTopicMetadataRs.ProtoReflect.Descriptor instead.
-func (*GetTopicMetadataRs) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeliveryReport.ProtoReflect.Descriptor instead.
+func (*DeliveryReport) Descriptor() ([]byte, []int) {
 	return file_kafkapixy_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetTopicMetadataRs) GetVersion() int32 {
+func (x *DeliveryReport) GetCorrelationId() string {
 	if x != nil {
-		return x.Version
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *DeliveryReport) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *DeliveryReport) GetPartition() int32 {
+	if x != nil {
+		return x.Partition
 	}
 	return 0
 }
 
-func (x *GetTopicMetadataRs) GetConfig() map[string]string {
+func (x *DeliveryReport) GetOffset() int64 {
 	if x != nil {
-		return x.Config
+		return x.Offset
 	}
-	return nil
+	return 0
 }
 
-func (x *GetTopicMetadataRs) GetPartitions() []*PartitionMetadata {
+func (x *DeliveryReport) GetErrorCode() int32 {
 	if x != nil {
-		return x.Partitions
+		return x.ErrorCode
 	}
-	return nil
+	return 0
 }
 
-type ListTopicRs struct {
+func (x *DeliveryReport) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ConsNAckRq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Topics map[string]*GetTopicMetadataRs `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Name of a Kafka cluster to operate on.
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// Name of a topic to produce to.
+	Topic string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	// Name of a consumer group.
+	Group string `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	// If true then no message is acknowledged by the request.
+	NoAck bool `protobuf:"varint,4,opt,name=no_ack,json=noAck,proto3" json:"no_ack,omitempty"`
+	// If true and no_ack is false then the message returned by the requests is
+	// automatically acknowledged by Kafka-Pixy before the request completes.
+	AutoAck bool `protobuf:"varint,5,opt,name=auto_ack,json=autoAck,proto3" json:"auto_ack,omitempty"`
+	// If both no_ack and auto_ack are false (by default), then ack_partition
+	// and ack_offset along with cluster-group-topic determine the message that
+	// should be acknowledged by the request.
+	AckPartition int32 `protobuf:"varint,6,opt,name=ack_partition,json=ackPartition,proto3" json:"ack_partition,omitempty"`
+	AckOffset    int64 `protobuf:"varint,7,opt,name=ack_offset,json=ackOffset,proto3" json:"ack_offset,omitempty"`
 }
 
-func (x *ListTopicRs) Reset() {
-	*x = ListTopicRs{}
+func (x *ConsNAckRq) Reset() {
+	*x = ConsNAckRq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kafkapixy_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1021,13 +1031,13 @@ func (x *ListTopicRs) Reset() {
 	}
 }
 
-func (x *ListTopicRs) String() string {
+func (x *ConsNAckRq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTopicRs) ProtoMessage() {}
+func (*ConsNAckRq) ProtoMessage() {}
 
-func (x *ListTopicRs) ProtoReflect() protoreflect.Message {
+func (x *ConsNAckRq) ProtoReflect() protoreflect.Message {
 	mi := &file_kafkapixy_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1039,31 +1049,89 @@ func (x *ListTopicRs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTopicRs.ProtoReflect.Descriptor instead.
-func (*ListTopicRs) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConsNAckRq.ProtoReflect.Descriptor instead.
+func (*ConsNAckRq) Descriptor() ([]byte, []int) {
 	return file_kafkapixy_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ListTopicRs) GetTopics() map[string]*GetTopicMetadataRs {
+func (x *ConsNAckRq) GetCluster() string {
 	if x != nil {
-		return x.Topics
+		return x.Cluster
 	}
-	return nil
+	return ""
 }
 
-type ListTopicRq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Name of a Kafka cluster
-	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
-	// Should include partition metadata
-	WithPartitions bool `protobuf:"varint,2,opt,name=with_partitions,json=withPartitions,proto3" json:"with_partitions,omitempty"`
+func (x *ConsNAckRq) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
 }
 
-func (x *ListTopicRq) Reset() {
-	*x = ListTopicRq{}
+func (x *ConsNAckRq) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *ConsNAckRq) GetNoAck() bool {
+	if x != nil {
+		return x.NoAck
+	}
+	return false
+}
+
+func (x *ConsNAckRq) GetAutoAck() bool {
+	if x != nil {
+		return x.AutoAck
+	}
+	return false
+}
+
+func (x *ConsNAckRq) GetAckPartition() int32 {
+	if x != nil {
+		return x.AckPartition
+	}
+	return 0
+}
+
+func (x *ConsNAckRq) GetAckOffset() int64 {
+	if x != nil {
+		return x.AckOffset
+	}
+	return 0
+}
+
+type ConsRs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Partition the message was read from.
+	Partition int32 `protobuf:"varint,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	// Offset of the read message in the partition.
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Key that was used to produce the message, unless key_undefined is true,
+	// then it is undefined.
+	KeyValue []byte `protobuf:"bytes,3,opt,name=key_value,json=keyValue,proto3" json:"key_value,omitempty"`
+	// If true then the message was produced to a random partition.
+	KeyUndefined bool `protobuf:"varint,4,opt,name=key_undefined,json=keyUndefined,proto3" json:"key_undefined,omitempty"`
+	// Message body
+	Message []byte `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	// Headers associated with the message
+	Headers []*RecordHeader `protobuf:"bytes,6,rep,name=headers,proto3" json:"headers,omitempty"`
+	// Message timestamp in milliseconds since the Unix epoch. It is 0 if the
+	// message was written in a format that does not support timestamps.
+	Timestamp int64 `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// How the timestamp was assigned: "create_time" if it was set by the
+	// producer, or "log_append_time" if it was set by the broker. It is
+	// empty if the message does not have a timestamp.
+	TimestampType string `protobuf:"bytes,8,opt,name=timestamp_type,json=timestampType,proto3" json:"timestamp_type,omitempty"`
+}
+
+func (x *ConsRs) Reset() {
+	*x = ConsRs{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kafkapixy_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1071,13 +1139,13 @@ func (x *ListTopicRq) Reset() {
 	}
 }
 
-func (x *ListTopicRq) String() string {
+func (x *ConsRs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTopicRq) ProtoMessage() {}
+func (*ConsRs) ProtoMessage() {}
 
-func (x *ListTopicRq) ProtoReflect() protoreflect.Message {
+func (x *ConsRs) ProtoReflect() protoreflect.Message {
 	mi := &file_kafkapixy_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1089,40 +1157,86 @@ func (x *ListTopicRq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTopicRq.ProtoReflect.Descriptor instead.
-func (*ListTopicRq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConsRs.ProtoReflect.Descriptor instead.
+func (*ConsRs) Descriptor() ([]byte, []int) {
 	return file_kafkapixy_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ListTopicRq) GetCluster() string {
+func (x *ConsRs) GetPartition() int32 {
 	if x != nil {
-		return x.Cluster
+		return x.Partition
 	}
-	return ""
+	return 0
 }
 
-func (x *ListTopicRq) GetWithPartitions() bool {
+func (x *ConsRs) GetOffset() int64 {
 	if x != nil {
-		return x.WithPartitions
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ConsRs) GetKeyValue() []byte {
+	if x != nil {
+		return x.KeyValue
+	}
+	return nil
+}
+
+func (x *ConsRs) GetKeyUndefined() bool {
+	if x != nil {
+		return x.KeyUndefined
 	}
 	return false
 }
 
-type ListConsumersRq struct {
+func (x *ConsRs) GetMessage() []byte {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *ConsRs) GetHeaders() []*RecordHeader {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *ConsRs) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ConsRs) GetTimestampType() string {
+	if x != nil {
+		return x.TimestampType
+	}
+	return ""
+}
+
+type AckRq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Name of a Kafka cluster
+	// Name of a Kafka cluster to operate on.
 	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
-	// Name of a topic
+	// Name of a topic to produce to.
 	Topic string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
-	// If non empty, return only the specified group in the result
+	// Name of a consumer group.
 	Group string `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	// Partition that the acknowledged message was consumed from.
+	Partition int32 `protobuf:"varint,4,opt,name=partition,proto3" json:"partition,omitempty"`
+	// Offset in the partition that the acknowledged message was consumed from.
+	Offset int64 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
 }
 
-func (x *ListConsumersRq) Reset() {
-	*x = ListConsumersRq{}
+func (x *AckRq) Reset() {
+	*x = AckRq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kafkapixy_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1130,13 +1244,13 @@ func (x *ListConsumersRq) Reset() {
 	}
 }
 
-func (x *ListConsumersRq) String() string {
+func (x *AckRq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListConsumersRq) ProtoMessage() {}
+func (*AckRq) ProtoMessage() {}
 
-func (x *ListConsumersRq) ProtoReflect() protoreflect.Message {
+func (x *AckRq) ProtoReflect() protoreflect.Message {
 	mi := &file_kafkapixy_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1148,42 +1262,54 @@ func (x *ListConsumersRq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListConsumersRq.ProtoReflect.Descriptor instead.
-func (*ListConsumersRq) Descriptor() ([]byte, []int) {
+// Deprecated: Use AckRq.ProtoReflect.Descriptor instead.
+func (*AckRq) Descriptor() ([]byte, []int) {
 	return file_kafkapixy_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ListConsumersRq) GetCluster() string {
+func (x *AckRq) GetCluster() string {
 	if x != nil {
 		return x.Cluster
 	}
 	return ""
 }
 
-func (x *ListConsumersRq) GetTopic() string {
+func (x *AckRq) GetTopic() string {
 	if x != nil {
 		return x.Topic
 	}
 	return ""
 }
 
-func (x *ListConsumersRq) GetGroup() string {
+func (x *AckRq) GetGroup() string {
 	if x != nil {
 		return x.Group
 	}
 	return ""
 }
 
-type ConsumerPartitions struct {
+func (x *AckRq) GetPartition() int32 {
+	if x != nil {
+		return x.Partition
+	}
+	return 0
+}
+
+func (x *AckRq) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type AckRs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Partitions []int32 `protobuf:"varint,1,rep,packed,name=partitions,proto3" json:"partitions,omitempty"`
 }
 
-func (x *ConsumerPartitions) Reset() {
-	*x = ConsumerPartitions{}
+func (x *AckRs) Reset() {
+	*x = AckRs{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kafkapixy_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1191,13 +1317,13 @@ func (x *ConsumerPartitions) Reset() {
 	}
 }
 
-func (x *ConsumerPartitions) String() string {
+func (x *AckRs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConsumerPartitions) ProtoMessage() {}
+func (*AckRs) ProtoMessage() {}
 
-func (x *ConsumerPartitions) ProtoReflect() protoreflect.Message {
+func (x *AckRs) ProtoReflect() protoreflect.Message {
 	mi := &file_kafkapixy_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1209,28 +1335,36 @@ func (x *ConsumerPartitions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConsumerPartitions.ProtoReflect.Descriptor instead.
-func (*ConsumerPartitions) Descriptor() ([]byte, []int) {
+// Deprecated: Use AckRs.ProtoReflect.Descriptor instead.
+func (*AckRs) Descriptor() ([]byte, []int) {
 	return file_kafkapixy_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ConsumerPartitions) GetPartitions() []int32 {
-	if x != nil {
-		return x.Partitions
-	}
-	return nil
-}
-
-type ConsumerGroups struct {
+type PartitionOffset struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Consumers map[string]*ConsumerPartitions `protobuf:"bytes,1,rep,name=consumers,proto3" json:"consumers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The Partition this structure describes
+	Partition int32 `protobuf:"varint,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	// The beginning offset
+	Begin int64 `protobuf:"varint,2,opt,name=begin,proto3" json:"begin,omitempty"`
+	// The ending offset
+	End int64 `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	// The number of messages in the partition
+	Count int64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// Offset in the partition
+	Offset int64 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	// The number of un-consumed messages in the partition
+	Lag int64 `protobuf:"varint,6,opt,name=lag,proto3" json:"lag,omitempty"`
+	// Metatdata associated with the partition
+	Metadata string `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// human readable representation of sparsely committed ranges
+	SparseAcks string `protobuf:"bytes,8,opt,name=sparse_acks,json=sparseAcks,proto3" json:"sparse_acks,omitempty"`
 }
 
-func (x *ConsumerGroups) Reset() {
-	*x = ConsumerGroups{}
+func (x *PartitionOffset) Reset() {
+	*x = PartitionOffset{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_kafkapixy_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1238,13 +1372,13 @@ func (x *ConsumerGroups) Reset() {
 	}
 }
 
-func (x *ConsumerGroups) String() string {
+func (x *PartitionOffset) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConsumerGroups) ProtoMessage() {}
+func (*PartitionOffset) ProtoMessage() {}
 
-func (x *ConsumerGroups) ProtoReflect() protoreflect.Message {
+func (x *PartitionOffset) ProtoReflect() protoreflect.Message {
 	mi := &file_kafkapixy_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1256,42 +1390,96 @@ func (x *ConsumerGroups) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConsumerGroups.ProtoReflect.Descriptor instead.
-func (*ConsumerGroups) Descriptor() ([]byte, []int) {
+// Deprecated: Use PartitionOffset.ProtoReflect.Descriptor instead.
+func (*PartitionOffset) Descriptor() ([]byte, []int) {
 	return file_kafkapixy_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ConsumerGroups) GetConsumers() map[string]*ConsumerPartitions {
+func (x *PartitionOffset) GetPartition() int32 {
 	if x != nil {
-		return x.Consumers
+		return x.Partition
 	}
-	return nil
+	return 0
 }
 
-type ListConsumersRs struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Groups map[string]*ConsumerGroups `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+func (x *PartitionOffset) GetBegin() int64 {
+	if x != nil {
+		return x.Begin
+	}
+	return 0
 }
 
-func (x *ListConsumersRs) Reset() {
-	*x = ListConsumersRs{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kafkapixy_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *PartitionOffset) GetEnd() int64 {
+	if x != nil {
+		return x.End
 	}
+	return 0
 }
 
-func (x *ListConsumersRs) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PartitionOffset) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
 }
 
-func (*ListConsumersRs) ProtoMessage() {}
-
-func (x *ListConsumersRs) ProtoReflect() protoreflect.Message {
+func (x *PartitionOffset) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *PartitionOffset) GetLag() int64 {
+	if x != nil {
+		return x.Lag
+	}
+	return 0
+}
+
+func (x *PartitionOffset) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *PartitionOffset) GetSparseAcks() string {
+	if x != nil {
+		return x.SparseAcks
+	}
+	return ""
+}
+
+type GetOffsetsRq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a Kafka cluster
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// Name of a topic
+	Topic string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	// Name of a consumer group.
+	Group string `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *GetOffsetsRq) Reset() {
+	*x = GetOffsetsRq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOffsetsRq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOffsetsRq) ProtoMessage() {}
+
+func (x *GetOffsetsRq) ProtoReflect() protoreflect.Message {
 	mi := &file_kafkapixy_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1303,19 +1491,157 @@ func (x *ListConsumersRs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListConsumersRs.ProtoReflect.Descriptor instead.
-func (*ListConsumersRs) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOffsetsRq.ProtoReflect.Descriptor instead.
+func (*GetOffsetsRq) Descriptor() ([]byte, []int) {
 	return file_kafkapixy_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ListConsumersRs) GetGroups() map[string]*ConsumerGroups {
+func (x *GetOffsetsRq) GetCluster() string {
 	if x != nil {
-		return x.Groups
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *GetOffsetsRq) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *GetOffsetsRq) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+type GetOffsetsRs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offsets []*PartitionOffset `protobuf:"bytes,1,rep,name=offsets,proto3" json:"offsets,omitempty"`
+}
+
+func (x *GetOffsetsRs) Reset() {
+	*x = GetOffsetsRs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOffsetsRs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOffsetsRs) ProtoMessage() {}
+
+func (x *GetOffsetsRs) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOffsetsRs.ProtoReflect.Descriptor instead.
+func (*GetOffsetsRs) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetOffsetsRs) GetOffsets() []*PartitionOffset {
+	if x != nil {
+		return x.Offsets
 	}
 	return nil
 }
 
-type SetOffsetsRq struct {
+// Partition metadata as retrieved from kafka
+type PartitionMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The Partition this structure describes
+	Partition int32 `protobuf:"varint,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	// The node id for the kafka broker currently acting as leader for this partition.
+	// If no leader exists because we are in the middle of a leader election this id will be -1.
+	Leader int32 `protobuf:"varint,2,opt,name=leader,proto3" json:"leader,omitempty"`
+	// The set of alive nodes that currently acts as slaves for the leader for this partition.
+	Replicas []int32 `protobuf:"varint,3,rep,packed,name=replicas,proto3" json:"replicas,omitempty"`
+	// The set subset of the replicas that are "caught up" to the leader
+	Isr []int32 `protobuf:"varint,4,rep,packed,name=isr,proto3" json:"isr,omitempty"`
+}
+
+func (x *PartitionMetadata) Reset() {
+	*x = PartitionMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PartitionMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartitionMetadata) ProtoMessage() {}
+
+func (x *PartitionMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartitionMetadata.ProtoReflect.Descriptor instead.
+func (*PartitionMetadata) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PartitionMetadata) GetPartition() int32 {
+	if x != nil {
+		return x.Partition
+	}
+	return 0
+}
+
+func (x *PartitionMetadata) GetLeader() int32 {
+	if x != nil {
+		return x.Leader
+	}
+	return 0
+}
+
+func (x *PartitionMetadata) GetReplicas() []int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
+func (x *PartitionMetadata) GetIsr() []int32 {
+	if x != nil {
+		return x.Isr
+	}
+	return nil
+}
+
+type GetTopicMetadataRq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1324,386 +1650,3108 @@ type SetOffsetsRq struct {
 	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
 	// Name of a topic
 	Topic string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
-	// Name of a consumer group.
-	Group   string             `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
-	Offsets []*PartitionOffset `protobuf:"bytes,4,rep,name=offsets,proto3" json:"offsets,omitempty"`
+	// Should include partition metadata
+	WithPartitions bool `protobuf:"varint,3,opt,name=with_partitions,json=withPartitions,proto3" json:"with_partitions,omitempty"`
 }
 
-func (x *SetOffsetsRq) Reset() {
-	*x = SetOffsetsRq{}
+func (x *GetTopicMetadataRq) Reset() {
+	*x = GetTopicMetadataRq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kafkapixy_proto_msgTypes[19]
+		mi := &file_kafkapixy_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetOffsetsRq) String() string {
+func (x *GetTopicMetadataRq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetOffsetsRq) ProtoMessage() {}
+func (*GetTopicMetadataRq) ProtoMessage() {}
 
-func (x *SetOffsetsRq) ProtoReflect() protoreflect.Message {
-	mi := &file_kafkapixy_proto_msgTypes[19]
+func (x *GetTopicMetadataRq) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SetOffsetsRq.ProtoReflect.Descriptor instead.
-func (*SetOffsetsRq) Descriptor() ([]byte, []int) {
-	return file_kafkapixy_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *SetOffsetsRq) GetCluster() string {
-	if x != nil {
-		return x.Cluster
-	}
-	return ""
-}
-
-func (x *SetOffsetsRq) GetTopic() string {
-	if x != nil {
-		return x.Topic
-	}
-	return ""
-}
-
-func (x *SetOffsetsRq) GetGroup() string {
-	if x != nil {
-		return x.Group
-	}
-	return ""
-}
-
-func (x *SetOffsetsRq) GetOffsets() []*PartitionOffset {
-	if x != nil {
-		return x.Offsets
-	}
-	return nil
-}
-
-type SetOffsetsRs struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *SetOffsetsRs) Reset() {
-	*x = SetOffsetsRs{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kafkapixy_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *SetOffsetsRs) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SetOffsetsRs) ProtoMessage() {}
-
-func (x *SetOffsetsRs) ProtoReflect() protoreflect.Message {
-	mi := &file_kafkapixy_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopicMetadataRq.ProtoReflect.Descriptor instead.
+func (*GetTopicMetadataRq) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetTopicMetadataRq) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *GetTopicMetadataRq) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *GetTopicMetadataRq) GetWithPartitions() bool {
+	if x != nil {
+		return x.WithPartitions
+	}
+	return false
+}
+
+type GetTopicMetadataRs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Version of this metadata
+	Version int32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Config values
+	Config map[string]string `protobuf:"bytes,2,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Optional list of metadata for the partitions of this topic
+	Partitions []*PartitionMetadata `protobuf:"bytes,3,rep,name=partitions,proto3" json:"partitions,omitempty"`
+}
+
+func (x *GetTopicMetadataRs) Reset() {
+	*x = GetTopicMetadataRs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTopicMetadataRs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopicMetadataRs) ProtoMessage() {}
+
+func (x *GetTopicMetadataRs) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopicMetadataRs.ProtoReflect.Descriptor instead.
+func (*GetTopicMetadataRs) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetTopicMetadataRs) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetTopicMetadataRs) GetConfig() map[string]string {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *GetTopicMetadataRs) GetPartitions() []*PartitionMetadata {
+	if x != nil {
+		return x.Partitions
+	}
+	return nil
+}
+
+type ListTopicRs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topics map[string]*GetTopicMetadataRs `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ListTopicRs) Reset() {
+	*x = ListTopicRs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTopicRs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTopicRs) ProtoMessage() {}
+
+func (x *ListTopicRs) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTopicRs.ProtoReflect.Descriptor instead.
+func (*ListTopicRs) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListTopicRs) GetTopics() map[string]*GetTopicMetadataRs {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+type ListTopicRq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a Kafka cluster
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// Should include partition metadata
+	WithPartitions bool `protobuf:"varint,2,opt,name=with_partitions,json=withPartitions,proto3" json:"with_partitions,omitempty"`
+}
+
+func (x *ListTopicRq) Reset() {
+	*x = ListTopicRq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTopicRq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTopicRq) ProtoMessage() {}
+
+func (x *ListTopicRq) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTopicRq.ProtoReflect.Descriptor instead.
+func (*ListTopicRq) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListTopicRq) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *ListTopicRq) GetWithPartitions() bool {
+	if x != nil {
+		return x.WithPartitions
+	}
+	return false
+}
+
+type ListConsumersRq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a Kafka cluster
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// Name of a topic
+	Topic string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	// If non empty, return only the specified group in the result
+	Group string `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *ListConsumersRq) Reset() {
+	*x = ListConsumersRq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConsumersRq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConsumersRq) ProtoMessage() {}
+
+func (x *ListConsumersRq) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConsumersRq.ProtoReflect.Descriptor instead.
+func (*ListConsumersRq) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListConsumersRq) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *ListConsumersRq) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *ListConsumersRq) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+type ConsumerPartitions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Partitions []int32 `protobuf:"varint,1,rep,packed,name=partitions,proto3" json:"partitions,omitempty"`
+	// Client ID of a member of a group coordinated by Kafka
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// Host of a member of a group coordinated by Kafka
+	ClientHost string `protobuf:"bytes,3,opt,name=client_host,json=clientHost,proto3" json:"client_host,omitempty"`
+}
+
+func (x *ConsumerPartitions) Reset() {
+	*x = ConsumerPartitions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsumerPartitions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumerPartitions) ProtoMessage() {}
+
+func (x *ConsumerPartitions) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumerPartitions.ProtoReflect.Descriptor instead.
+func (*ConsumerPartitions) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ConsumerPartitions) GetPartitions() []int32 {
+	if x != nil {
+		return x.Partitions
+	}
+	return nil
+}
+
+func (x *ConsumerPartitions) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ConsumerPartitions) GetClientHost() string {
+	if x != nil {
+		return x.ClientHost
+	}
+	return ""
+}
+
+type ConsumerGroups struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Consumers by client ID for groups coordinated by Kafka-Pixy, or by
+	// member ID for groups coordinated by Kafka
+	Consumers map[string]*ConsumerPartitions `protobuf:"bytes,1,rep,name=consumers,proto3" json:"consumers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Whether the group is coordinated by Kafka only, rather than by
+	// Kafka-Pixy via ZooKeeper
+	Native bool `protobuf:"varint,2,opt,name=native,proto3" json:"native,omitempty"`
+	// State of a group coordinated by Kafka, e.g. Stable or
+	// PreparingRebalance
+	State string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	// Partition assignment strategy of a group coordinated by Kafka, e.g.
+	// range or roundrobin
+	Protocol string `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// Whether both Kafka-Pixy and Kafka coordinate groups with this name. The
+	// consumers of both are listed together, native is false, and state and
+	// protocol describe the group coordinated by Kafka.
+	Mixed bool `protobuf:"varint,5,opt,name=mixed,proto3" json:"mixed,omitempty"`
+}
+
+func (x *ConsumerGroups) Reset() {
+	*x = ConsumerGroups{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsumerGroups) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumerGroups) ProtoMessage() {}
+
+func (x *ConsumerGroups) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumerGroups.ProtoReflect.Descriptor instead.
+func (*ConsumerGroups) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ConsumerGroups) GetConsumers() map[string]*ConsumerPartitions {
+	if x != nil {
+		return x.Consumers
+	}
+	return nil
+}
+
+func (x *ConsumerGroups) GetNative() bool {
+	if x != nil {
+		return x.Native
+	}
+	return false
+}
+
+func (x *ConsumerGroups) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ConsumerGroups) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *ConsumerGroups) GetMixed() bool {
+	if x != nil {
+		return x.Mixed
+	}
+	return false
+}
+
+type ListConsumersRs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups map[string]*ConsumerGroups `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ListConsumersRs) Reset() {
+	*x = ListConsumersRs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConsumersRs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConsumersRs) ProtoMessage() {}
+
+func (x *ListConsumersRs) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConsumersRs.ProtoReflect.Descriptor instead.
+func (*ListConsumersRs) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListConsumersRs) GetGroups() map[string]*ConsumerGroups {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type SetOffsetsRq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a Kafka cluster
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// Name of a topic
+	Topic string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	// Name of a consumer group.
+	Group   string             `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	Offsets []*PartitionOffset `protobuf:"bytes,4,rep,name=offsets,proto3" json:"offsets,omitempty"`
+}
+
+func (x *SetOffsetsRq) Reset() {
+	*x = SetOffsetsRq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetOffsetsRq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOffsetsRq) ProtoMessage() {}
+
+func (x *SetOffsetsRq) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOffsetsRq.ProtoReflect.Descriptor instead.
+func (*SetOffsetsRq) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetOffsetsRq) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *SetOffsetsRq) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *SetOffsetsRq) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *SetOffsetsRq) GetOffsets() []*PartitionOffset {
+	if x != nil {
+		return x.Offsets
+	}
+	return nil
+}
+
+type SetOffsetsRs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetOffsetsRs) Reset() {
+	*x = SetOffsetsRs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetOffsetsRs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOffsetsRs) ProtoMessage() {}
+
+func (x *SetOffsetsRs) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOffsetsRs.ProtoReflect.Descriptor instead.
+func (*SetOffsetsRs) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{30}
+}
+
+type CreateTopicRq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a Kafka cluster
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// Name of a topic
+	Topic string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	// Number of partitions of the topic
+	Partitions int32 `protobuf:"varint,3,opt,name=partitions,proto3" json:"partitions,omitempty"`
+	// Number of replicas of every partition of the topic
+	ReplicationFactor int32 `protobuf:"varint,4,opt,name=replication_factor,json=replicationFactor,proto3" json:"replication_factor,omitempty"`
+	// Topic configuration overrides, e.g. retention.ms
+	Config map[string]string `protobuf:"bytes,5,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// If true, then Kafka only checks whether the topic could be created.
+	ValidateOnly bool `protobuf:"varint,6,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (x *CreateTopicRq) Reset() {
+	*x = CreateTopicRq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTopicRq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTopicRq) ProtoMessage() {}
+
+func (x *CreateTopicRq) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTopicRq.ProtoReflect.Descriptor instead.
+func (*CreateTopicRq) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateTopicRq) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *CreateTopicRq) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *CreateTopicRq) GetPartitions() int32 {
+	if x != nil {
+		return x.Partitions
+	}
+	return 0
+}
+
+func (x *CreateTopicRq) GetReplicationFactor() int32 {
+	if x != nil {
+		return x.ReplicationFactor
+	}
+	return 0
+}
+
+func (x *CreateTopicRq) GetConfig() map[string]string {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *CreateTopicRq) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type CreateTopicRs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreateTopicRs) Reset() {
+	*x = CreateTopicRs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTopicRs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTopicRs) ProtoMessage() {}
+
+func (x *CreateTopicRs) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTopicRs.ProtoReflect.Descriptor instead.
+func (*CreateTopicRs) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{32}
+}
+
+type DeleteTopicRq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a Kafka cluster
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// Name of a topic
+	Topic string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+}
+
+func (x *DeleteTopicRq) Reset() {
+	*x = DeleteTopicRq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTopicRq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTopicRq) ProtoMessage() {}
+
+func (x *DeleteTopicRq) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTopicRq.ProtoReflect.Descriptor instead.
+func (*DeleteTopicRq) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteTopicRq) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *DeleteTopicRq) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+type DeleteTopicRs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteTopicRs) Reset() {
+	*x = DeleteTopicRs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTopicRs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTopicRs) ProtoMessage() {}
+
+func (x *DeleteTopicRs) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTopicRs.ProtoReflect.Descriptor instead.
+func (*DeleteTopicRs) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{34}
+}
+
+type AlterTopicConfigRq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a Kafka cluster
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// Name of a topic
+	Topic string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	// Configuration overrides to set
+	Set map[string]string `protobuf:"bytes,3,rep,name=set,proto3" json:"set,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Configuration overrides to remove, so that defaults apply
+	Delete []string `protobuf:"bytes,4,rep,name=delete,proto3" json:"delete,omitempty"`
+	// If true, then Kafka only checks whether the configuration could be
+	// changed.
+	ValidateOnly bool `protobuf:"varint,5,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (x *AlterTopicConfigRq) Reset() {
+	*x = AlterTopicConfigRq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AlterTopicConfigRq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlterTopicConfigRq) ProtoMessage() {}
+
+func (x *AlterTopicConfigRq) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlterTopicConfigRq.ProtoReflect.Descriptor instead.
+func (*AlterTopicConfigRq) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AlterTopicConfigRq) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *AlterTopicConfigRq) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *AlterTopicConfigRq) GetSet() map[string]string {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+func (x *AlterTopicConfigRq) GetDelete() []string {
+	if x != nil {
+		return x.Delete
+	}
+	return nil
+}
+
+func (x *AlterTopicConfigRq) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type AlterTopicConfigRs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AlterTopicConfigRs) Reset() {
+	*x = AlterTopicConfigRs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AlterTopicConfigRs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlterTopicConfigRs) ProtoMessage() {}
+
+func (x *AlterTopicConfigRs) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlterTopicConfigRs.ProtoReflect.Descriptor instead.
+func (*AlterTopicConfigRs) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{36}
+}
+
+type AddPartitionsRq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a Kafka cluster
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// Name of a topic
+	Topic string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	// Total number of partitions the topic should have after the request.
+	Count int32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// If true, then Kafka only checks whether partitions could be added.
+	ValidateOnly bool `protobuf:"varint,4,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+}
+
+func (x *AddPartitionsRq) Reset() {
+	*x = AddPartitionsRq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddPartitionsRq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPartitionsRq) ProtoMessage() {}
+
+func (x *AddPartitionsRq) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPartitionsRq.ProtoReflect.Descriptor instead.
+func (*AddPartitionsRq) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AddPartitionsRq) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *AddPartitionsRq) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *AddPartitionsRq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *AddPartitionsRq) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type AddPartitionsRs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddPartitionsRs) Reset() {
+	*x = AddPartitionsRs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddPartitionsRs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPartitionsRs) ProtoMessage() {}
+
+func (x *AddPartitionsRs) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPartitionsRs.ProtoReflect.Descriptor instead.
+func (*AddPartitionsRs) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{38}
+}
+
+type TopicPartition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a topic
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	// Partition of the topic
+	Partition int32 `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+}
+
+func (x *TopicPartition) Reset() {
+	*x = TopicPartition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopicPartition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicPartition) ProtoMessage() {}
+
+func (x *TopicPartition) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicPartition.ProtoReflect.Descriptor instead.
+func (*TopicPartition) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TopicPartition) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *TopicPartition) GetPartition() int32 {
+	if x != nil {
+		return x.Partition
+	}
+	return 0
+}
+
+type PartitionReassignment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a topic
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	// Partition of the topic
+	Partition int32 `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	// Broker IDs of the replicas to move the partition to. The first one is
+	// the preferred leader.
+	Replicas []int32 `protobuf:"varint,3,rep,packed,name=replicas,proto3" json:"replicas,omitempty"`
+}
+
+func (x *PartitionReassignment) Reset() {
+	*x = PartitionReassignment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PartitionReassignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartitionReassignment) ProtoMessage() {}
+
+func (x *PartitionReassignment) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartitionReassignment.ProtoReflect.Descriptor instead.
+func (*PartitionReassignment) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PartitionReassignment) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *PartitionReassignment) GetPartition() int32 {
+	if x != nil {
+		return x.Partition
+	}
+	return 0
+}
+
+func (x *PartitionReassignment) GetReplicas() []int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
+type PartitionProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a topic
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	// Partition of the topic
+	Partition int32 `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	// Broker IDs of the replicas the partition should end up with. The first
+	// one is the preferred leader.
+	Target []int32 `protobuf:"varint,3,rep,packed,name=target,proto3" json:"target,omitempty"`
+	// Broker ID of the current partition leader, or -1 if there is none.
+	Leader int32 `protobuf:"varint,4,opt,name=leader,proto3" json:"leader,omitempty"`
+	// Broker IDs of the current partition replicas
+	Replicas []int32 `protobuf:"varint,5,rep,packed,name=replicas,proto3" json:"replicas,omitempty"`
+	// Broker IDs of the current partition replicas that are in sync
+	Isr []int32 `protobuf:"varint,6,rep,packed,name=isr,proto3" json:"isr,omitempty"`
+	// Whether the partition has reached the target state
+	Done bool `protobuf:"varint,7,opt,name=done,proto3" json:"done,omitempty"`
+}
+
+func (x *PartitionProgress) Reset() {
+	*x = PartitionProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PartitionProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartitionProgress) ProtoMessage() {}
+
+func (x *PartitionProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartitionProgress.ProtoReflect.Descriptor instead.
+func (*PartitionProgress) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PartitionProgress) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *PartitionProgress) GetPartition() int32 {
+	if x != nil {
+		return x.Partition
+	}
+	return 0
+}
+
+func (x *PartitionProgress) GetTarget() []int32 {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *PartitionProgress) GetLeader() int32 {
+	if x != nil {
+		return x.Leader
+	}
+	return 0
+}
+
+func (x *PartitionProgress) GetReplicas() []int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
+func (x *PartitionProgress) GetIsr() []int32 {
+	if x != nil {
+		return x.Isr
+	}
+	return nil
+}
+
+func (x *PartitionProgress) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+type ElectPreferredLeadersRq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a Kafka cluster
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// Partitions to elect preferred leaders of
+	Partitions []*TopicPartition `protobuf:"bytes,2,rep,name=partitions,proto3" json:"partitions,omitempty"`
+}
+
+func (x *ElectPreferredLeadersRq) Reset() {
+	*x = ElectPreferredLeadersRq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ElectPreferredLeadersRq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElectPreferredLeadersRq) ProtoMessage() {}
+
+func (x *ElectPreferredLeadersRq) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElectPreferredLeadersRq.ProtoReflect.Descriptor instead.
+func (*ElectPreferredLeadersRq) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ElectPreferredLeadersRq) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *ElectPreferredLeadersRq) GetPartitions() []*TopicPartition {
+	if x != nil {
+		return x.Partitions
+	}
+	return nil
+}
+
+type ElectPreferredLeadersRs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Partitions []*PartitionProgress `protobuf:"bytes,1,rep,name=partitions,proto3" json:"partitions,omitempty"`
+}
+
+func (x *ElectPreferredLeadersRs) Reset() {
+	*x = ElectPreferredLeadersRs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ElectPreferredLeadersRs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElectPreferredLeadersRs) ProtoMessage() {}
+
+func (x *ElectPreferredLeadersRs) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElectPreferredLeadersRs.ProtoReflect.Descriptor instead.
+func (*ElectPreferredLeadersRs) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ElectPreferredLeadersRs) GetPartitions() []*PartitionProgress {
+	if x != nil {
+		return x.Partitions
+	}
+	return nil
+}
+
+type ReassignPartitionsRq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a Kafka cluster
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// Partitions to move along with replicas to move them to
+	Reassignments []*PartitionReassignment `protobuf:"bytes,2,rep,name=reassignments,proto3" json:"reassignments,omitempty"`
+}
+
+func (x *ReassignPartitionsRq) Reset() {
+	*x = ReassignPartitionsRq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReassignPartitionsRq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignPartitionsRq) ProtoMessage() {}
+
+func (x *ReassignPartitionsRq) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignPartitionsRq.ProtoReflect.Descriptor instead.
+func (*ReassignPartitionsRq) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ReassignPartitionsRq) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *ReassignPartitionsRq) GetReassignments() []*PartitionReassignment {
+	if x != nil {
+		return x.Reassignments
+	}
+	return nil
+}
+
+type ReassignPartitionsRs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Partitions []*PartitionProgress `protobuf:"bytes,1,rep,name=partitions,proto3" json:"partitions,omitempty"`
+}
+
+func (x *ReassignPartitionsRs) Reset() {
+	*x = ReassignPartitionsRs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReassignPartitionsRs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignPartitionsRs) ProtoMessage() {}
+
+func (x *ReassignPartitionsRs) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignPartitionsRs.ProtoReflect.Descriptor instead.
+func (*ReassignPartitionsRs) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ReassignPartitionsRs) GetPartitions() []*PartitionProgress {
+	if x != nil {
+		return x.Partitions
+	}
+	return nil
+}
+
+type GetReassignmentsRq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a Kafka cluster
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+}
+
+func (x *GetReassignmentsRq) Reset() {
+	*x = GetReassignmentsRq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReassignmentsRq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReassignmentsRq) ProtoMessage() {}
+
+func (x *GetReassignmentsRq) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReassignmentsRq.ProtoReflect.Descriptor instead.
+func (*GetReassignmentsRq) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetReassignmentsRq) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+type GetReassignmentsRs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Partitions []*PartitionProgress `protobuf:"bytes,1,rep,name=partitions,proto3" json:"partitions,omitempty"`
+}
+
+func (x *GetReassignmentsRs) Reset() {
+	*x = GetReassignmentsRs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReassignmentsRs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReassignmentsRs) ProtoMessage() {}
+
+func (x *GetReassignmentsRs) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReassignmentsRs.ProtoReflect.Descriptor instead.
+func (*GetReassignmentsRs) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetReassignmentsRs) GetPartitions() []*PartitionProgress {
+	if x != nil {
+		return x.Partitions
+	}
+	return nil
+}
+
+type BrokerMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Broker ID
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Address of the broker in the host:port form
+	Addr string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	// Rack of the broker, if configured
+	Rack string `protobuf:"bytes,3,opt,name=rack,proto3" json:"rack,omitempty"`
+	// Whether this Kafka-Pixy instance is able to connect to the broker
+	Connected bool `protobuf:"varint,4,opt,name=connected,proto3" json:"connected,omitempty"`
+	// An error that the last connection attempt failed with
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *BrokerMetadata) Reset() {
+	*x = BrokerMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BrokerMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrokerMetadata) ProtoMessage() {}
+
+func (x *BrokerMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrokerMetadata.ProtoReflect.Descriptor instead.
+func (*BrokerMetadata) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *BrokerMetadata) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BrokerMetadata) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *BrokerMetadata) GetRack() string {
+	if x != nil {
+		return x.Rack
+	}
+	return ""
+}
+
+func (x *BrokerMetadata) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+func (x *BrokerMetadata) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type DescribeClusterRq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a Kafka cluster
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+}
+
+func (x *DescribeClusterRq) Reset() {
+	*x = DescribeClusterRq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeClusterRq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeClusterRq) ProtoMessage() {}
+
+func (x *DescribeClusterRq) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeClusterRq.ProtoReflect.Descriptor instead.
+func (*DescribeClusterRq) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DescribeClusterRq) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+type DescribeClusterRs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the controller broker, or -1 if it is not known, e.g. because
+	// Kafka is older than v0.10.
+	ControllerId int32 `protobuf:"varint,1,opt,name=controller_id,json=controllerId,proto3" json:"controller_id,omitempty"`
+	// Brokers of the cluster ordered by ID
+	Brokers []*BrokerMetadata `protobuf:"bytes,2,rep,name=brokers,proto3" json:"brokers,omitempty"`
+	// Number of partitions that have fewer in-sync replicas than replicas
+	UnderReplicatedPartitions int32 `protobuf:"varint,3,opt,name=under_replicated_partitions,json=underReplicatedPartitions,proto3" json:"under_replicated_partitions,omitempty"`
+	// Number of partitions that have no leader
+	OfflinePartitions int32 `protobuf:"varint,4,opt,name=offline_partitions,json=offlinePartitions,proto3" json:"offline_partitions,omitempty"`
+}
+
+func (x *DescribeClusterRs) Reset() {
+	*x = DescribeClusterRs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeClusterRs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeClusterRs) ProtoMessage() {}
+
+func (x *DescribeClusterRs) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeClusterRs.ProtoReflect.Descriptor instead.
+func (*DescribeClusterRs) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DescribeClusterRs) GetControllerId() int32 {
+	if x != nil {
+		return x.ControllerId
+	}
+	return 0
+}
+
+func (x *DescribeClusterRs) GetBrokers() []*BrokerMetadata {
+	if x != nil {
+		return x.Brokers
+	}
+	return nil
+}
+
+func (x *DescribeClusterRs) GetUnderReplicatedPartitions() int32 {
+	if x != nil {
+		return x.UnderReplicatedPartitions
+	}
+	return 0
+}
+
+func (x *DescribeClusterRs) GetOfflinePartitions() int32 {
+	if x != nil {
+		return x.OfflinePartitions
+	}
+	return 0
+}
+
+type BrowseMessagesRq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a Kafka cluster
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// Name of a topic
+	Topic string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	// Partition to read messages from
+	Partition int32 `protobuf:"varint,3,opt,name=partition,proto3" json:"partition,omitempty"`
+	// Offset of the first message to read. If negative, then messages are
+	// read starting with the oldest one available.
+	From int64 `protobuf:"varint,4,opt,name=from,proto3" json:"from,omitempty"`
+	// Maximum number of messages to read. It defaults to 10 and is capped by
+	// config.yaml:proxies.<cluster>.browse.max_count.
+	Count int32 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *BrowseMessagesRq) Reset() {
+	*x = BrowseMessagesRq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BrowseMessagesRq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrowseMessagesRq) ProtoMessage() {}
+
+func (x *BrowseMessagesRq) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrowseMessagesRq.ProtoReflect.Descriptor instead.
+func (*BrowseMessagesRq) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *BrowseMessagesRq) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *BrowseMessagesRq) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *BrowseMessagesRq) GetPartition() int32 {
+	if x != nil {
+		return x.Partition
+	}
+	return 0
+}
+
+func (x *BrowseMessagesRq) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *BrowseMessagesRq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type BrowsedMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Offset of the message in the partition
+	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Key that was used to produce the message, unless key_undefined is true,
+	// then it is undefined.
+	KeyValue []byte `protobuf:"bytes,2,opt,name=key_value,json=keyValue,proto3" json:"key_value,omitempty"`
+	// If true then the message was produced to a random partition.
+	KeyUndefined bool `protobuf:"varint,3,opt,name=key_undefined,json=keyUndefined,proto3" json:"key_undefined,omitempty"`
+	// Message body
+	Message []byte `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// Headers associated with the message
+	Headers []*RecordHeader `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty"`
+	// Message timestamp in milliseconds since the Unix epoch. It is 0 if the
+	// message was written in a format that does not support timestamps.
+	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// How the timestamp was assigned: "create_time" if it was set by the
+	// producer, or "log_append_time" if it was set by the broker. It is
+	// empty if the message does not have a timestamp.
+	TimestampType string `protobuf:"bytes,7,opt,name=timestamp_type,json=timestampType,proto3" json:"timestamp_type,omitempty"`
+	// Human readable preview of the message body. It is JSON if the topic is
+	// bound to a schema with decode_on_consume enabled, and the body itself
+	// otherwise, truncated to config.yaml:proxies.<cluster>.browse.preview_length
+	// bytes.
+	Preview string `protobuf:"bytes,8,opt,name=preview,proto3" json:"preview,omitempty"`
+}
+
+func (x *BrowsedMessage) Reset() {
+	*x = BrowsedMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BrowsedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrowsedMessage) ProtoMessage() {}
+
+func (x *BrowsedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrowsedMessage.ProtoReflect.Descriptor instead.
+func (*BrowsedMessage) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *BrowsedMessage) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *BrowsedMessage) GetKeyValue() []byte {
+	if x != nil {
+		return x.KeyValue
+	}
+	return nil
+}
+
+func (x *BrowsedMessage) GetKeyUndefined() bool {
+	if x != nil {
+		return x.KeyUndefined
+	}
+	return false
+}
+
+func (x *BrowsedMessage) GetMessage() []byte {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *BrowsedMessage) GetHeaders() []*RecordHeader {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *BrowsedMessage) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *BrowsedMessage) GetTimestampType() string {
+	if x != nil {
+		return x.TimestampType
+	}
+	return ""
+}
+
+func (x *BrowsedMessage) GetPreview() string {
+	if x != nil {
+		return x.Preview
+	}
+	return ""
+}
+
+type BrowseMessagesRs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Messages ordered by offset
+	Messages []*BrowsedMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	// Offset to read the next page from
+	NextOffset int64 `protobuf:"varint,2,opt,name=next_offset,json=nextOffset,proto3" json:"next_offset,omitempty"`
+	// Offset of the oldest message available in the partition
+	Begin int64 `protobuf:"varint,3,opt,name=begin,proto3" json:"begin,omitempty"`
+	// Offset that the next message produced to the partition will get
+	End int64 `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *BrowseMessagesRs) Reset() {
+	*x = BrowseMessagesRs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kafkapixy_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BrowseMessagesRs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrowseMessagesRs) ProtoMessage() {}
+
+func (x *BrowseMessagesRs) ProtoReflect() protoreflect.Message {
+	mi := &file_kafkapixy_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrowseMessagesRs.ProtoReflect.Descriptor instead.
+func (*BrowseMessagesRs) Descriptor() ([]byte, []int) {
+	return file_kafkapixy_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *BrowseMessagesRs) GetMessages() []*BrowsedMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *BrowseMessagesRs) GetNextOffset() int64 {
+	if x != nil {
+		return x.NextOffset
+	}
+	return 0
+}
+
+func (x *BrowseMessagesRs) GetBegin() int64 {
+	if x != nil {
+		return x.Begin
+	}
+	return 0
+}
+
+func (x *BrowseMessagesRs) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+var File_kafkapixy_proto protoreflect.FileDescriptor
+
+var file_kafkapixy_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x6b, 0x61, 0x66, 0x6b, 0x61, 0x70, 0x69, 0x78, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x36, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xee, 0x02, 0x0a, 0x06, 0x50, 0x72,
+	0x6f, 0x64, 0x52, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x75, 0x6e, 0x64, 0x65, 0x66, 0x69, 0x6e,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x55, 0x6e, 0x64,
+	0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x27, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x78, 0x70, 0x6c,
+	0x69, 0x63, 0x69, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x58, 0x0a, 0x06, 0x50, 0x72,
+	0x6f, 0x64, 0x52, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x22, 0xc2, 0x01, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x4d, 0x73, 0x67,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x75, 0x6e, 0x64, 0x65, 0x66,
+	0x69, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x55,
+	0x6e, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x4e, 0x0a, 0x0c, 0x50, 0x72, 0x6f,
+	0x64, 0x41, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x52, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x4d, 0x73, 0x67, 0x52,
+	0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x31, 0x0a, 0x0c, 0x50, 0x72, 0x6f,
+	0x64, 0x41, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x52, 0x73, 0x12, 0x21, 0x0a, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x50, 0x72, 0x6f,
+	0x64, 0x52, 0x73, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x4d, 0x0a, 0x0b,
+	0x50, 0x72, 0x6f, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x4d, 0x73,
+	0x67, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x0f,
+	0x50, 0x72, 0x6f, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x22, 0x39, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22,
+	0x5e, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x50,
+	0x72, 0x6f, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0xa5, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x73,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73,
+	0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x22, 0x13, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x71, 0x22, 0xbc, 0x01, 0x0a,
+	0x0e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xc8, 0x01, 0x0a, 0x0a,
+	0x43, 0x6f, 0x6e, 0x73, 0x4e, 0x41, 0x63, 0x6b, 0x52, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x6e, 0x6f, 0x41, 0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x6f, 0x5f,
+	0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x75, 0x74, 0x6f, 0x41,
+	0x63, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x6b, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x63, 0x6b, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x6b, 0x5f, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x63, 0x6b,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x88, 0x02, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x73, 0x52,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x75, 0x6e, 0x64, 0x65,
+	0x66, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6b, 0x65, 0x79,
+	0x55, 0x6e, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x54, 0x79, 0x70,
+	0x65, 0x22, 0x83, 0x01, 0x0a, 0x05, 0x41, 0x63, 0x6b, 0x52, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x07, 0x0a, 0x05, 0x41, 0x63, 0x6b, 0x52, 0x73,
+	0x22, 0xd4, 0x01, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61, 0x67, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6c, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x70, 0x61, 0x72, 0x73, 0x65,
+	0x5f, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x70, 0x61,
+	0x72, 0x73, 0x65, 0x41, 0x63, 0x6b, 0x73, 0x22, 0x54, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x73, 0x52, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x3a, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x52, 0x73, 0x12, 0x2a, 0x0a,
+	0x07, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x52, 0x07, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x22, 0x77, 0x0a, 0x11, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73,
+	0x12, 0x10, 0x0a, 0x03, 0x69, 0x73, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x03, 0x69,
+	0x73, 0x72, 0x22, 0x6d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x27, 0x0a, 0x0f, 0x77, 0x69, 0x74, 0x68,
+	0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0xd6, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x0a, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
+	0x39, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8f, 0x01, 0x0a, 0x0b, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x1a, 0x4e, 0x0a, 0x0b,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x50, 0x0a, 0x0b,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x77, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x57,
+	0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x52,
+	0x71, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x72, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x22, 0x81, 0x02, 0x0a, 0x0e,
+	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x3c,
+	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6e, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x78, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6d, 0x69, 0x78, 0x65, 0x64, 0x1a, 0x51, 0x0a, 0x0e,
+	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x93, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
+	0x73, 0x52, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x72, 0x73, 0x52, 0x73, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x1a, 0x4a, 0x0a, 0x0b, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x43, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x80, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x73, 0x52, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2a, 0x0a, 0x07,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52,
+	0x07, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x22, 0x0e, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x52, 0x73, 0x22, 0xa2, 0x02, 0x0a, 0x0d, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x71, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x23, 0x0a,
+	0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e,
+	0x6c, 0x79, 0x1a, 0x39, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x0f, 0x0a,
+	0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x73, 0x22, 0x3f,
+	0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22,
+	0x0f, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x73,
+	0x22, 0xe9, 0x01, 0x0a, 0x12, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x2e, 0x0a, 0x03, 0x73, 0x65, 0x74, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x71, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x03, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x4f, 0x6e, 0x6c, 0x79, 0x1a, 0x36, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x14, 0x0a, 0x12,
+	0x41, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x73, 0x22, 0x7c, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79,
+	0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x73, 0x22, 0x44, 0x0a, 0x0e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x67, 0x0a, 0x15, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x73, 0x22, 0xb9, 0x01, 0x0a, 0x11, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x73, 0x72, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x03, 0x69, 0x73, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f,
+	0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x22, 0x64,
+	0x0a, 0x17, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4d, 0x0a, 0x17, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x50, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x73, 0x12,
+	0x32, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x6e, 0x0a, 0x14, 0x52, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x0d, 0x72, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x72, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x4a, 0x0a, 0x14, 0x52, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x2e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x22,
+	0x48, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0a, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7c, 0x0a, 0x0e, 0x42, 0x72, 0x6f,
+	0x6b, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72,
+	0x61, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x2d, 0x0a, 0x11, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x71, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x22, 0xd2, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x29, 0x0a, 0x07, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x42, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x07, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x3e, 0x0a, 0x1b,
+	0x75, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x19, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x12,
+	0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e,
+	0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x10,
+	0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x71,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8c, 0x02, 0x0a, 0x0e, 0x42, 0x72, 0x6f,
+	0x77, 0x73, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x75, 0x6e, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x55, 0x6e, 0x64, 0x65,
+	0x66, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x27, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x88, 0x01, 0x0a, 0x10, 0x42, 0x72, 0x6f, 0x77,
+	0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x73, 0x12, 0x2b, 0x0a, 0x08,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x6e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x65,
+	0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e,
+	0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65,
+	0x6e, 0x64, 0x32, 0xde, 0x08, 0x0a, 0x09, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x50, 0x69, 0x78, 0x79,
+	0x12, 0x1d, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x12, 0x07, 0x2e, 0x50, 0x72,
+	0x6f, 0x64, 0x52, 0x71, 0x1a, 0x07, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x52, 0x73, 0x22, 0x00, 0x12,
+	0x2f, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x41, 0x74, 0x6f, 0x6d, 0x69, 0x63,
+	0x12, 0x0d, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x41, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x52, 0x71, 0x1a,
+	0x0d, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x41, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x52, 0x73, 0x22, 0x00,
+	0x12, 0x2c, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x0c, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x71, 0x1a, 0x0c,
+	0x2e, 0x50, 0x72, 0x6f, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x73, 0x22, 0x00, 0x12, 0x33,
+	0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
+	0x0d, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x71, 0x1a, 0x0d,
+	0x2e, 0x50, 0x72, 0x6f, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x73, 0x22, 0x00, 0x28,
+	0x01, 0x30, 0x01, 0x12, 0x3a, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x12, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x71, 0x1a, 0x0f, 0x2e, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x25, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x4e, 0x41, 0x63, 0x6b, 0x12, 0x0b,
+	0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x4e, 0x41, 0x63, 0x6b, 0x52, 0x71, 0x1a, 0x07, 0x2e, 0x43, 0x6f,
+	0x6e, 0x73, 0x52, 0x73, 0x22, 0x00, 0x12, 0x17, 0x0a, 0x03, 0x41, 0x63, 0x6b, 0x12, 0x06, 0x2e,
+	0x41, 0x63, 0x6b, 0x52, 0x71, 0x1a, 0x06, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x73, 0x22, 0x00, 0x12,
+	0x2c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x12, 0x0d, 0x2e,
+	0x47, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x52, 0x71, 0x1a, 0x0d, 0x2e, 0x47,
+	0x65, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x52, 0x73, 0x22, 0x00, 0x12, 0x2c, 0x0a,
+	0x0a, 0x53, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x12, 0x0d, 0x2e, 0x53, 0x65,
+	0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x52, 0x71, 0x1a, 0x0d, 0x2e, 0x53, 0x65, 0x74,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x52, 0x73, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x0a, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x0c, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x71, 0x1a, 0x0c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f,
+	0x70, 0x69, 0x63, 0x52, 0x73, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x10, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x71, 0x1a, 0x10, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x73, 0x22, 0x00, 0x12, 0x3e,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x71, 0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x73, 0x22, 0x00, 0x12, 0x3b,
+	0x0a, 0x0f, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x12, 0x12, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x71, 0x1a, 0x12, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x73, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0b, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x0e, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x71, 0x1a, 0x0e, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x73, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0b,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x0e, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x71, 0x1a, 0x0e, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x73, 0x22, 0x00, 0x12, 0x3e, 0x0a,
+	0x10, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x13, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x71, 0x1a, 0x13, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x6f,
+	0x70, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x73, 0x22, 0x00, 0x12, 0x35, 0x0a,
+	0x0d, 0x41, 0x64, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10,
+	0x2e, 0x41, 0x64, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x71,
+	0x1a, 0x10, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x73, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x15, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x50, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x18, 0x2e,
+	0x45, 0x6c, 0x65, 0x63, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x71, 0x1a, 0x18, 0x2e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x50,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52,
+	0x73, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x12, 0x52, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x15, 0x2e, 0x52, 0x65, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x71,
+	0x1a, 0x15, 0x2e, 0x52, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x73, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x13, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x71, 0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x73, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0e, 0x42, 0x72, 0x6f,
+	0x77, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x11, 0x2e, 0x42, 0x72,
+	0x6f, 0x77, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x71, 0x1a, 0x11,
+	0x2e, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52,
+	0x73, 0x22, 0x00, 0x42, 0x4f, 0x0a, 0x11, 0x6d, 0x61, 0x69, 0x6c, 0x67, 0x75, 0x6e, 0x2e, 0x6b,
+	0x61, 0x66, 0x6b, 0x61, 0x70, 0x69, 0x78, 0x79, 0x42, 0x0e, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x50,
+	0x69, 0x78, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x69, 0x6c, 0x67, 0x75, 0x6e, 0x2f, 0x6b,
+	0x61, 0x66, 0x6b, 0x61, 0x2d, 0x70, 0x69, 0x78, 0x79, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f,
+	0x6c, 0x61, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_kafkapixy_proto_rawDescOnce sync.Once
+	file_kafkapixy_proto_rawDescData = file_kafkapixy_proto_rawDesc
+)
+
+func file_kafkapixy_proto_rawDescGZIP() []byte {
+	file_kafkapixy_proto_rawDescOnce.Do(func() {
+		file_kafkapixy_proto_rawDescData = protoimpl.X.CompressGZIP(file_kafkapixy_proto_rawDescData)
+	})
+	return file_kafkapixy_proto_rawDescData
+}
+
+var file_kafkapixy_proto_msgTypes = make([]protoimpl.MessageInfo, 60)
+var file_kafkapixy_proto_goTypes = []interface{}{
+	(*RecordHeader)(nil),            // 0: RecordHeader
+	(*ProdRq)(nil),                  // 1: ProdRq
+	(*ProdRs)(nil),                  // 2: ProdRs
+	(*ProdMsg)(nil),                 // 3: ProdMsg
+	(*ProdAtomicRq)(nil),            // 4: ProdAtomicRq
+	(*ProdAtomicRs)(nil),            // 5: ProdAtomicRs
+	(*ProdBatchRq)(nil),             // 6: ProdBatchRq
+	(*ProdBatchResult)(nil),         // 7: ProdBatchResult
+	(*ProdBatchRs)(nil),             // 8: ProdBatchRs
+	(*ProdStreamRq)(nil),            // 9: ProdStreamRq
+	(*ProdStreamRs)(nil),            // 10: ProdStreamRs
+	(*DeliveryReportsRq)(nil),       // 11: DeliveryReportsRq
+	(*DeliveryReport)(nil),          // 12: DeliveryReport
+	(*ConsNAckRq)(nil),              // 13: ConsNAckRq
+	(*ConsRs)(nil),                  // 14: ConsRs
+	(*AckRq)(nil),                   // 15: AckRq
+	(*AckRs)(nil),                   // 16: AckRs
+	(*PartitionOffset)(nil),         // 17: PartitionOffset
+	(*GetOffsetsRq)(nil),            // 18: GetOffsetsRq
+	(*GetOffsetsRs)(nil),            // 19: GetOffsetsRs
+	(*PartitionMetadata)(nil),       // 20: PartitionMetadata
+	(*GetTopicMetadataRq)(nil),      // 21: GetTopicMetadataRq
+	(*GetTopicMetadataRs)(nil),      // 22: GetTopicMetadataRs
+	(*ListTopicRs)(nil),             // 23: ListTopicRs
+	(*ListTopicRq)(nil),             // 24: ListTopicRq
+	(*ListConsumersRq)(nil),         // 25: ListConsumersRq
+	(*ConsumerPartitions)(nil),      // 26: ConsumerPartitions
+	(*ConsumerGroups)(nil),          // 27: ConsumerGroups
+	(*ListConsumersRs)(nil),         // 28: ListConsumersRs
+	(*SetOffsetsRq)(nil),            // 29: SetOffsetsRq
+	(*SetOffsetsRs)(nil),            // 30: SetOffsetsRs
+	(*CreateTopicRq)(nil),           // 31: CreateTopicRq
+	(*CreateTopicRs)(nil),           // 32: CreateTopicRs
+	(*DeleteTopicRq)(nil),           // 33: DeleteTopicRq
+	(*DeleteTopicRs)(nil),           // 34: DeleteTopicRs
+	(*AlterTopicConfigRq)(nil),      // 35: AlterTopicConfigRq
+	(*AlterTopicConfigRs)(nil),      // 36: AlterTopicConfigRs
+	(*AddPartitionsRq)(nil),         // 37: AddPartitionsRq
+	(*AddPartitionsRs)(nil),         // 38: AddPartitionsRs
+	(*TopicPartition)(nil),          // 39: TopicPartition
+	(*PartitionReassignment)(nil),   // 40: PartitionReassignment
+	(*PartitionProgress)(nil),       // 41: PartitionProgress
+	(*ElectPreferredLeadersRq)(nil), // 42: ElectPreferredLeadersRq
+	(*ElectPreferredLeadersRs)(nil), // 43: ElectPreferredLeadersRs
+	(*ReassignPartitionsRq)(nil),    // 44: ReassignPartitionsRq
+	(*ReassignPartitionsRs)(nil),    // 45: ReassignPartitionsRs
+	(*GetReassignmentsRq)(nil),      // 46: GetReassignmentsRq
+	(*GetReassignmentsRs)(nil),      // 47: GetReassignmentsRs
+	(*BrokerMetadata)(nil),          // 48: BrokerMetadata
+	(*DescribeClusterRq)(nil),       // 49: DescribeClusterRq
+	(*DescribeClusterRs)(nil),       // 50: DescribeClusterRs
+	(*BrowseMessagesRq)(nil),        // 51: BrowseMessagesRq
+	(*BrowsedMessage)(nil),          // 52: BrowsedMessage
+	(*BrowseMessagesRs)(nil),        // 53: BrowseMessagesRs
+	nil,                             // 54: GetTopicMetadataRs.ConfigEntry
+	nil,                             // 55: ListTopicRs.TopicsEntry
+	nil,                             // 56: ConsumerGroups.ConsumersEntry
+	nil,                             // 57: ListConsumersRs.GroupsEntry
+	nil,                             // 58: CreateTopicRq.ConfigEntry
+	nil,                             // 59: AlterTopicConfigRq.SetEntry
+}
+var file_kafkapixy_proto_depIdxs = []int32{
+	0,  // 0: ProdRq.headers:type_name -> RecordHeader
+	0,  // 1: ProdMsg.headers:type_name -> RecordHeader
+	3,  // 2: ProdAtomicRq.messages:type_name -> ProdMsg
+	2,  // 3: ProdAtomicRs.results:type_name -> ProdRs
+	3,  // 4: ProdBatchRq.messages:type_name -> ProdMsg
+	7,  // 5: ProdBatchRs.results:type_name -> ProdBatchResult
+	3,  // 6: ProdStreamRq.message:type_name -> ProdMsg
+	0,  // 7: ConsRs.headers:type_name -> RecordHeader
+	17, // 8: GetOffsetsRs.offsets:type_name -> PartitionOffset
+	54, // 9: GetTopicMetadataRs.config:type_name -> GetTopicMetadataRs.ConfigEntry
+	20, // 10: GetTopicMetadataRs.partitions:type_name -> PartitionMetadata
+	55, // 11: ListTopicRs.topics:type_name -> ListTopicRs.TopicsEntry
+	56, // 12: ConsumerGroups.consumers:type_name -> ConsumerGroups.ConsumersEntry
+	57, // 13: ListConsumersRs.groups:type_name -> ListConsumersRs.GroupsEntry
+	17, // 14: SetOffsetsRq.offsets:type_name -> PartitionOffset
+	58, // 15: CreateTopicRq.config:type_name -> CreateTopicRq.ConfigEntry
+	59, // 16: AlterTopicConfigRq.set:type_name -> AlterTopicConfigRq.SetEntry
+	39, // 17: ElectPreferredLeadersRq.partitions:type_name -> TopicPartition
+	41, // 18: ElectPreferredLeadersRs.partitions:type_name -> PartitionProgress
+	40, // 19: ReassignPartitionsRq.reassignments:type_name -> PartitionReassignment
+	41, // 20: ReassignPartitionsRs.partitions:type_name -> PartitionProgress
+	41, // 21: GetReassignmentsRs.partitions:type_name -> PartitionProgress
+	48, // 22: DescribeClusterRs.brokers:type_name -> BrokerMetadata
+	0,  // 23: BrowsedMessage.headers:type_name -> RecordHeader
+	52, // 24: BrowseMessagesRs.messages:type_name -> BrowsedMessage
+	22, // 25: ListTopicRs.TopicsEntry.value:type_name -> GetTopicMetadataRs
+	26, // 26: ConsumerGroups.ConsumersEntry.value:type_name -> ConsumerPartitions
+	27, // 27: ListConsumersRs.GroupsEntry.value:type_name -> ConsumerGroups
+	1,  // 28: KafkaPixy.Produce:input_type -> ProdRq
+	4,  // 29: KafkaPixy.ProduceAtomic:input_type -> ProdAtomicRq
+	6,  // 30: KafkaPixy.ProduceBatch:input_type -> ProdBatchRq
+	9,  // 31: KafkaPixy.ProduceStream:input_type -> ProdStreamRq
+	11, // 32: KafkaPixy.DeliveryReports:input_type -> DeliveryReportsRq
+	13, // 33: KafkaPixy.ConsumeNAck:input_type -> ConsNAckRq
+	15, // 34: KafkaPixy.Ack:input_type -> AckRq
+	18, // 35: KafkaPixy.GetOffsets:input_type -> GetOffsetsRq
+	29, // 36: KafkaPixy.SetOffsets:input_type -> SetOffsetsRq
+	24, // 37: KafkaPixy.ListTopics:input_type -> ListTopicRq
+	25, // 38: KafkaPixy.ListConsumers:input_type -> ListConsumersRq
+	21, // 39: KafkaPixy.GetTopicMetadata:input_type -> GetTopicMetadataRq
+	49, // 40: KafkaPixy.DescribeCluster:input_type -> DescribeClusterRq
+	31, // 41: KafkaPixy.CreateTopic:input_type -> CreateTopicRq
+	33, // 42: KafkaPixy.DeleteTopic:input_type -> DeleteTopicRq
+	35, // 43: KafkaPixy.AlterTopicConfig:input_type -> AlterTopicConfigRq
+	37, // 44: KafkaPixy.AddPartitions:input_type -> AddPartitionsRq
+	42, // 45: KafkaPixy.ElectPreferredLeaders:input_type -> ElectPreferredLeadersRq
+	44, // 46: KafkaPixy.ReassignPartitions:input_type -> ReassignPartitionsRq
+	46, // 47: KafkaPixy.GetReassignments:input_type -> GetReassignmentsRq
+	51, // 48: KafkaPixy.BrowseMessages:input_type -> BrowseMessagesRq
+	2,  // 49: KafkaPixy.Produce:output_type -> ProdRs
+	5,  // 50: KafkaPixy.ProduceAtomic:output_type -> ProdAtomicRs
+	8,  // 51: KafkaPixy.ProduceBatch:output_type -> ProdBatchRs
+	10, // 52: KafkaPixy.ProduceStream:output_type -> ProdStreamRs
+	12, // 53: KafkaPixy.DeliveryReports:output_type -> DeliveryReport
+	14, // 54: KafkaPixy.ConsumeNAck:output_type -> ConsRs
+	16, // 55: KafkaPixy.Ack:output_type -> AckRs
+	19, // 56: KafkaPixy.GetOffsets:output_type -> GetOffsetsRs
+	30, // 57: KafkaPixy.SetOffsets:output_type -> SetOffsetsRs
+	23, // 58: KafkaPixy.ListTopics:output_type -> ListTopicRs
+	28, // 59: KafkaPixy.ListConsumers:output_type -> ListConsumersRs
+	22, // 60: KafkaPixy.GetTopicMetadata:output_type -> GetTopicMetadataRs
+	50, // 61: KafkaPixy.DescribeCluster:output_type -> DescribeClusterRs
+	32, // 62: KafkaPixy.CreateTopic:output_type -> CreateTopicRs
+	34, // 63: KafkaPixy.DeleteTopic:output_type -> DeleteTopicRs
+	36, // 64: KafkaPixy.AlterTopicConfig:output_type -> AlterTopicConfigRs
+	38, // 65: KafkaPixy.AddPartitions:output_type -> AddPartitionsRs
+	43, // 66: KafkaPixy.ElectPreferredLeaders:output_type -> ElectPreferredLeadersRs
+	45, // 67: KafkaPixy.ReassignPartitions:output_type -> ReassignPartitionsRs
+	47, // 68: KafkaPixy.GetReassignments:output_type -> GetReassignmentsRs
+	53, // 69: KafkaPixy.BrowseMessages:output_type -> BrowseMessagesRs
+	49, // [49:70] is the sub-list for method output_type
+	28, // [28:49] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
+}
+
+func init() { file_kafkapixy_proto_init() }
+func file_kafkapixy_proto_init() {
+	if File_kafkapixy_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_kafkapixy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProdRq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProdRs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProdMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProdAtomicRq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProdAtomicRs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProdBatchRq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProdBatchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProdBatchRs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProdStreamRq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProdStreamRs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeliveryReportsRq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeliveryReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsNAckRq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsRs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AckRq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AckRs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartitionOffset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOffsetsRq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOffsetsRs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartitionMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTopicMetadataRq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTopicMetadataRs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTopicRs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTopicRq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListConsumersRq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsumerPartitions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsumerGroups); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListConsumersRs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetOffsetsRq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SetOffsetsRs.ProtoReflect.Descriptor instead.
-func (*SetOffsetsRs) Descriptor() ([]byte, []int) {
-	return file_kafkapixy_proto_rawDescGZIP(), []int{20}
-}
-
-var File_kafkapixy_proto protoreflect.FileDescriptor
-
-var file_kafkapixy_proto_rawDesc = []byte{
-	0x0a, 0x0f, 0x6b, 0x61, 0x66, 0x6b, 0x61, 0x70, 0x69, 0x78, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x36, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xdc, 0x01, 0x0a, 0x06, 0x50, 0x72,
-	0x6f, 0x64, 0x52, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
-	0x6f, 0x70, 0x69, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x75, 0x6e, 0x64, 0x65, 0x66, 0x69, 0x6e,
-	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x55, 0x6e, 0x64,
-	0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x12,
-	0x27, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0d, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
-	0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0x3e, 0x0a, 0x06, 0x50, 0x72, 0x6f, 0x64,
-	0x52, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0xc8, 0x01, 0x0a, 0x0a, 0x43, 0x6f, 0x6e,
-	0x73, 0x4e, 0x41, 0x63, 0x6b, 0x52, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x15, 0x0a,
-	0x06, 0x6e, 0x6f, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6e,
-	0x6f, 0x41, 0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x61, 0x63, 0x6b,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x75, 0x74, 0x6f, 0x41, 0x63, 0x6b, 0x12,
-	0x23, 0x0a, 0x0d, 0x61, 0x63, 0x6b, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x63, 0x6b, 0x50, 0x61, 0x72, 0x74, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x6b, 0x5f, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x63, 0x6b, 0x4f, 0x66, 0x66,
-	0x73, 0x65, 0x74, 0x22, 0xc3, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x73, 0x52, 0x73, 0x12, 0x1c,
-	0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
-	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x75, 0x6e, 0x64, 0x65, 0x66, 0x69, 0x6e,
-	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x55, 0x6e, 0x64,
-	0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x27, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x05, 0x41, 0x63,
-	0x6b, 0x52, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72,
-	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61,
-	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
-	0x07, 0x0a, 0x05, 0x41, 0x63, 0x6b, 0x52, 0x73, 0x22, 0xd4, 0x01, 0x0a, 0x0f, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09,
-	0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x65,
-	0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e,
-	0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65,
-	0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6c,
-	0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f,
-	0x0a, 0x0b, 0x73, 0x70, 0x61, 0x72, 0x73, 0x65, 0x5f, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x70, 0x61, 0x72, 0x73, 0x65, 0x41, 0x63, 0x6b, 0x73, 0x22,
-	0x54, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x52, 0x71, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70,
-	0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12,
-	0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x3a, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x73, 0x52, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x73, 0x22, 0x77, 0x0a, 0x11, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
-	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08,
-	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x73, 0x72, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x03, 0x69, 0x73, 0x72, 0x22, 0x6d, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x71,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63,
-	0x12, 0x27, 0x0a, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x50,
-	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x06, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x47, 0x65, 0x74,
-	0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x73, 0x2e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x70, 0x61, 0x72,
-	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0x8f, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63,
-	0x52, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x73,
-	0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x73, 0x1a, 0x4e, 0x0a, 0x0b, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0x50, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x69,
-	0x63, 0x52, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x27, 0x0a,
-	0x0f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x50, 0x61, 0x72, 0x74,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x57, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
-	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22,
-	0x34, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
-	0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x3c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73,
-	0x75, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x43, 0x6f,
-	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x43, 0x6f, 0x6e,
-	0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x6e,
-	0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x1a, 0x51, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
-	0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x43, 0x6f, 0x6e, 0x73,
-	0x75, 0x6d, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x93, 0x01, 0x0a, 0x0f, 0x4c, 0x69,
-	0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x73, 0x12, 0x34, 0x0a,
-	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x73, 0x2e,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x73, 0x1a, 0x4a, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0x80, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x52, 0x71,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63,
-	0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x73, 0x22, 0x0e, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73,
-	0x52, 0x73, 0x32, 0xe9, 0x02, 0x0a, 0x09, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x50, 0x69, 0x78, 0x79,
-	0x12, 0x1d, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x12, 0x07, 0x2e, 0x50, 0x72,
-	0x6f, 0x64, 0x52, 0x71, 0x1a, 0x07, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x52, 0x73, 0x22, 0x00, 0x12,
-	0x25, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x4e, 0x41, 0x63, 0x6b, 0x12, 0x0b,
-	0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x4e, 0x41, 0x63, 0x6b, 0x52, 0x71, 0x1a, 0x07, 0x2e, 0x43, 0x6f,
-	0x6e, 0x73, 0x52, 0x73, 0x22, 0x00, 0x12, 0x17, 0x0a, 0x03, 0x41, 0x63, 0x6b, 0x12, 0x06, 0x2e,
-	0x41, 0x63, 0x6b, 0x52, 0x71, 0x1a, 0x06, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x73, 0x22, 0x00, 0x12,
-	0x2c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x12, 0x0d, 0x2e,
-	0x47, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x52, 0x71, 0x1a, 0x0d, 0x2e, 0x47,
-	0x65, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x52, 0x73, 0x22, 0x00, 0x12, 0x2c, 0x0a,
-	0x0a, 0x53, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x12, 0x0d, 0x2e, 0x53, 0x65,
-	0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x52, 0x71, 0x1a, 0x0d, 0x2e, 0x53, 0x65, 0x74,
-	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x52, 0x73, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x0a, 0x4c,
-	0x69, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x0c, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x71, 0x1a, 0x0c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f,
-	0x70, 0x69, 0x63, 0x52, 0x73, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x43,
-	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x10, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
-	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x71, 0x1a, 0x10, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x73, 0x22, 0x00, 0x12, 0x3e,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x71, 0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70,
-	0x69, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x73, 0x22, 0x00, 0x42, 0x4f,
-	0x0a, 0x11, 0x6d, 0x61, 0x69, 0x6c, 0x67, 0x75, 0x6e, 0x2e, 0x6b, 0x61, 0x66, 0x6b, 0x61, 0x70,
-	0x69, 0x78, 0x79, 0x42, 0x0e, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x50, 0x69, 0x78, 0x79, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x6d, 0x61, 0x69, 0x6c, 0x67, 0x75, 0x6e, 0x2f, 0x6b, 0x61, 0x66, 0x6b, 0x61, 0x2d,
-	0x70, 0x69, 0x78, 0x79, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_kafkapixy_proto_rawDescOnce sync.Once
-	file_kafkapixy_proto_rawDescData = file_kafkapixy_proto_rawDesc
-)
-
-func file_kafkapixy_proto_rawDescGZIP() []byte {
-	file_kafkapixy_proto_rawDescOnce.Do(func() {
-		file_kafkapixy_proto_rawDescData = protoimpl.X.CompressGZIP(file_kafkapixy_proto_rawDescData)
-	})
-	return file_kafkapixy_proto_rawDescData
-}
-
-var file_kafkapixy_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
-var file_kafkapixy_proto_goTypes = []interface{}{
-	(*RecordHeader)(nil),       // 0: RecordHeader
-	(*ProdRq)(nil),             // 1: ProdRq
-	(*ProdRs)(nil),             // 2: ProdRs
-	(*ConsNAckRq)(nil),         // 3: ConsNAckRq
-	(*ConsRs)(nil),             // 4: ConsRs
-	(*AckRq)(nil),              // 5: AckRq
-	(*AckRs)(nil),              // 6: AckRs
-	(*PartitionOffset)(nil),    // 7: PartitionOffset
-	(*GetOffsetsRq)(nil),       // 8: GetOffsetsRq
-	(*GetOffsetsRs)(nil),       // 9: GetOffsetsRs
-	(*PartitionMetadata)(nil),  // 10: PartitionMetadata
-	(*GetTopicMetadataRq)(nil), // 11: GetTopicMetadataRq
-	(*GetTopicMetadataRs)(nil), // 12: GetTopicMetadataRs
-	(*ListTopicRs)(nil),        // 13: ListTopicRs
-	(*ListTopicRq)(nil),        // 14: ListTopicRq
-	(*ListConsumersRq)(nil),    // 15: ListConsumersRq
-	(*ConsumerPartitions)(nil), // 16: ConsumerPartitions
-	(*ConsumerGroups)(nil),     // 17: ConsumerGroups
-	(*ListConsumersRs)(nil),    // 18: ListConsumersRs
-	(*SetOffsetsRq)(nil),       // 19: SetOffsetsRq
-	(*SetOffsetsRs)(nil),       // 20: SetOffsetsRs
-	nil,                        // 21: GetTopicMetadataRs.ConfigEntry
-	nil,                        // 22: ListTopicRs.TopicsEntry
-	nil,                        // 23: ConsumerGroups.ConsumersEntry
-	nil,                        // 24: ListConsumersRs.GroupsEntry
-}
-var file_kafkapixy_proto_depIdxs = []int32{
-	0,  // 0: ProdRq.headers:type_name -> RecordHeader
-	0,  // 1: ConsRs.headers:type_name -> RecordHeader
-	7,  // 2: GetOffsetsRs.offsets:type_name -> PartitionOffset
-	21, // 3: GetTopicMetadataRs.config:type_name -> GetTopicMetadataRs.ConfigEntry
-	10, // 4: GetTopicMetadataRs.partitions:type_name -> PartitionMetadata
-	22, // 5: ListTopicRs.topics:type_name -> ListTopicRs.TopicsEntry
-	23, // 6: ConsumerGroups.consumers:type_name -> ConsumerGroups.ConsumersEntry
-	24, // 7: ListConsumersRs.groups:type_name -> ListConsumersRs.GroupsEntry
-	7,  // 8: SetOffsetsRq.offsets:type_name -> PartitionOffset
-	12, // 9: ListTopicRs.TopicsEntry.value:type_name -> GetTopicMetadataRs
-	16, // 10: ConsumerGroups.ConsumersEntry.value:type_name -> ConsumerPartitions
-	17, // 11: ListConsumersRs.GroupsEntry.value:type_name -> ConsumerGroups
-	1,  // 12: KafkaPixy.Produce:input_type -> ProdRq
-	3,  // 13: KafkaPixy.ConsumeNAck:input_type -> ConsNAckRq
-	5,  // 14: KafkaPixy.Ack:input_type -> AckRq
-	8,  // 15: KafkaPixy.GetOffsets:input_type -> GetOffsetsRq
-	19, // 16: KafkaPixy.SetOffsets:input_type -> SetOffsetsRq
-	14, // 17: KafkaPixy.ListTopics:input_type -> ListTopicRq
-	15, // 18: KafkaPixy.ListConsumers:input_type -> ListConsumersRq
-	11, // 19: KafkaPixy.GetTopicMetadata:input_type -> GetTopicMetadataRq
-	2,  // 20: KafkaPixy.Produce:output_type -> ProdRs
-	4,  // 21: KafkaPixy.ConsumeNAck:output_type -> ConsRs
-	6,  // 22: KafkaPixy.Ack:output_type -> AckRs
-	9,  // 23: KafkaPixy.GetOffsets:output_type -> GetOffsetsRs
-	20, // 24: KafkaPixy.SetOffsets:output_type -> SetOffsetsRs
-	13, // 25: KafkaPixy.ListTopics:output_type -> ListTopicRs
-	18, // 26: KafkaPixy.ListConsumers:output_type -> ListConsumersRs
-	12, // 27: KafkaPixy.GetTopicMetadata:output_type -> GetTopicMetadataRs
-	20, // [20:28] is the sub-list for method output_type
-	12, // [12:20] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
-}
-
-func init() { file_kafkapixy_proto_init() }
-func file_kafkapixy_proto_init() {
-	if File_kafkapixy_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_kafkapixy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecordHeader); i {
+		file_kafkapixy_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetOffsetsRs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1714,8 +4762,8 @@ func file_kafkapixy_proto_init() {
 				return nil
 			}
 		}
-		file_kafkapixy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProdRq); i {
+		file_kafkapixy_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTopicRq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1726,8 +4774,8 @@ func file_kafkapixy_proto_init() {
 				return nil
 			}
 		}
-		file_kafkapixy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProdRs); i {
+		file_kafkapixy_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTopicRs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1738,8 +4786,8 @@ func file_kafkapixy_proto_init() {
 				return nil
 			}
 		}
-		file_kafkapixy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConsNAckRq); i {
+		file_kafkapixy_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTopicRq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1750,8 +4798,8 @@ func file_kafkapixy_proto_init() {
 				return nil
 			}
 		}
-		file_kafkapixy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConsRs); i {
+		file_kafkapixy_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTopicRs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1762,8 +4810,8 @@ func file_kafkapixy_proto_init() {
 				return nil
 			}
 		}
-		file_kafkapixy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AckRq); i {
+		file_kafkapixy_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlterTopicConfigRq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1774,8 +4822,8 @@ func file_kafkapixy_proto_init() {
 				return nil
 			}
 		}
-		file_kafkapixy_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AckRs); i {
+		file_kafkapixy_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlterTopicConfigRs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1786,8 +4834,8 @@ func file_kafkapixy_proto_init() {
 				return nil
 			}
 		}
-		file_kafkapixy_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PartitionOffset); i {
+		file_kafkapixy_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPartitionsRq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1798,8 +4846,8 @@ func file_kafkapixy_proto_init() {
 				return nil
 			}
 		}
-		file_kafkapixy_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOffsetsRq); i {
+		file_kafkapixy_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPartitionsRs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1810,8 +4858,8 @@ func file_kafkapixy_proto_init() {
 				return nil
 			}
 		}
-		file_kafkapixy_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOffsetsRs); i {
+		file_kafkapixy_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopicPartition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1822,8 +4870,8 @@ func file_kafkapixy_proto_init() {
 				return nil
 			}
 		}
-		file_kafkapixy_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PartitionMetadata); i {
+		file_kafkapixy_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartitionReassignment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1834,8 +4882,8 @@ func file_kafkapixy_proto_init() {
 				return nil
 			}
 		}
-		file_kafkapixy_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTopicMetadataRq); i {
+		file_kafkapixy_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartitionProgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1846,8 +4894,8 @@ func file_kafkapixy_proto_init() {
 				return nil
 			}
 		}
-		file_kafkapixy_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTopicMetadataRs); i {
+		file_kafkapixy_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ElectPreferredLeadersRq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1858,8 +4906,8 @@ func file_kafkapixy_proto_init() {
 				return nil
 			}
 		}
-		file_kafkapixy_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTopicRs); i {
+		file_kafkapixy_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ElectPreferredLeadersRs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1870,8 +4918,8 @@ func file_kafkapixy_proto_init() {
 				return nil
 			}
 		}
-		file_kafkapixy_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTopicRq); i {
+		file_kafkapixy_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReassignPartitionsRq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1882,8 +4930,8 @@ func file_kafkapixy_proto_init() {
 				return nil
 			}
 		}
-		file_kafkapixy_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListConsumersRq); i {
+		file_kafkapixy_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReassignPartitionsRs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1894,8 +4942,8 @@ func file_kafkapixy_proto_init() {
 				return nil
 			}
 		}
-		file_kafkapixy_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConsumerPartitions); i {
+		file_kafkapixy_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReassignmentsRq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1906,8 +4954,8 @@ func file_kafkapixy_proto_init() {
 				return nil
 			}
 		}
-		file_kafkapixy_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConsumerGroups); i {
+		file_kafkapixy_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReassignmentsRs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1918,8 +4966,8 @@ func file_kafkapixy_proto_init() {
 				return nil
 			}
 		}
-		file_kafkapixy_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListConsumersRs); i {
+		file_kafkapixy_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BrokerMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1930,8 +4978,8 @@ func file_kafkapixy_proto_init() {
 				return nil
 			}
 		}
-		file_kafkapixy_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetOffsetsRq); i {
+		file_kafkapixy_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeClusterRq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1942,8 +4990,44 @@ func file_kafkapixy_proto_init() {
 				return nil
 			}
 		}
-		file_kafkapixy_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetOffsetsRs); i {
+		file_kafkapixy_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeClusterRs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BrowseMessagesRq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BrowsedMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kafkapixy_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BrowseMessagesRs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1961,7 +5045,7 @@ func file_kafkapixy_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_kafkapixy_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   25,
+			NumMessages:   60,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
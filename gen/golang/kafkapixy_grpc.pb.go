@@ -35,13 +35,68 @@ type KafkaPixyClient interface {
 	// ProdReq.key_undefined and ProdReq.key_value are left default, which is
 	// empty string and false respectively, then messages will be consitently
 	// written to a partiticular partition selected by the hash of an empty
-	// string.
+	// string. To write a message to a particular partition set
+	// ProdReq.explicit_partition to true and ProdReq.partition to the
+	// partition number.
 	//
 	// gRPC error codes:
 	//  * Invalid Argument (3): see the status description for details;
 	//  * Internal (13): see the status description and logs for details;
 	//  * Unavailable (14): the service is shutting down.
 	Produce(ctx context.Context, in *ProdRq, opts ...grpc.CallOption) (*ProdRs, error)
+	// ProduceAtomic writes several messages, possibly to different topics, as
+	// a single Kafka transaction. Either all messages become visible to
+	// consumers with read_committed isolation level or none does. Partitions
+	// are selected for messages the same way as it is done by Produce.
+	//
+	// The method is only available if
+	// config.yaml:proxies.<cluster>.producer.transactional_id_prefix is set.
+	// Requires Kafka version 0.11.0.0 or later.
+	//
+	// gRPC error codes:
+	//  * Invalid Argument (3): see the status description for details;
+	//  * Internal (13): see the status description and logs for details;
+	//  * Unavailable (14): the service is shutting down or transactions are
+	//    disabled by configuration.
+	ProduceAtomic(ctx context.Context, in *ProdAtomicRq, opts ...grpc.CallOption) (*ProdAtomicRs, error)
+	// ProduceBatch writes many messages, possibly to different topics, in a
+	// single call. All messages are submitted to the producer at once, so they
+	// are batched together when written to Kafka. The call blocks until all
+	// messages are either written or failed. Unlike ProduceAtomic, messages
+	// succeed or fail individually, and the response contains a result for
+	// every message in the order they were given in the request.
+	//
+	// gRPC error codes:
+	//  * Invalid Argument (3): see the status description for details.
+	//
+	// Errors of individual messages are reported in ProdBatchResult.
+	ProduceBatch(ctx context.Context, in *ProdBatchRq, opts ...grpc.CallOption) (*ProdBatchRs, error)
+	// ProduceStream opens a bidirectional stream to write messages to Kafka
+	// continuously. For every message received on the stream a result is sent
+	// back once the message is written to Kafka or failed. Results carry the
+	// sequence number supplied by the client in ProdStreamRq.seq and are sent
+	// in the order the messages were received.
+	//
+	// When the producer buffer is filled up Kafka-Pixy stops reading from the
+	// stream until the buffer is drained, so clients are slowed down by gRPC
+	// flow control.
+	//
+	// Errors of individual messages are reported in ProdStreamRs. The stream
+	// is terminated with the following gRPC error codes:
+	//  * Invalid Argument (3): see the status description for details.
+	ProduceStream(ctx context.Context, opts ...grpc.CallOption) (KafkaPixy_ProduceStreamClient, error)
+	// DeliveryReports opens a stream of results of messages produced by the
+	// Produce method in async mode with ProdRq.correlation_id set. A report is
+	// sent once a message is either written to Kafka or failed. Reports are
+	// sent to all streams opened by the client that produced the message, the
+	// client being identified by a verified TLS client certificate. Anonymous
+	// clients can neither open a stream nor set ProdRq.correlation_id, that
+	// fails with Unauthenticated (16). Reports of messages resolved while the
+	// client has no stream open, or while a stream is not keeping up with
+	// reports, are dropped, so clients should open a stream before producing.
+	// Dropped reports are counted in the delivery_reports_dropped metric at
+	// /debug/vars.
+	DeliveryReports(ctx context.Context, in *DeliveryReportsRq, opts ...grpc.CallOption) (KafkaPixy_DeliveryReportsClient, error)
 	// Consume reads a message from a topic and optionally acknowledges a
 	// message previously consumed from the same topic.
 	//
@@ -109,7 +164,9 @@ type KafkaPixyClient interface {
 	//  * Invalid Argument (3): If unable to find the cluster named in the request
 	//  * Internal (13): If Kafka returns an error on request
 	ListTopics(ctx context.Context, in *ListTopicRq, opts ...grpc.CallOption) (*ListTopicRs, error)
-	// Lists all consumers of a topic
+	// Lists all consumers of a topic. That includes both consumer groups
+	// coordinated by Kafka-Pixy via ZooKeeper and consumer groups coordinated
+	// by Kafka.
 	//
 	// gRPC error codes:
 	//  * Invalid Argument (3): If unable to find the cluster named in the request
@@ -122,6 +179,129 @@ type KafkaPixyClient interface {
 	//  * Internal (13): If Kafka returns an error on request
 	//  * NotFound (5): If the topic does not exist
 	GetTopicMetadata(ctx context.Context, in *GetTopicMetadataRq, opts ...grpc.CallOption) (*GetTopicMetadataRs, error)
+	// Returns brokers of a Kafka cluster along with their connectivity as
+	// seen by this Kafka-Pixy instance, the controller ID, and the numbers of
+	// under-replicated and offline partitions.
+	//
+	// gRPC error codes:
+	//  * Invalid Argument (3): If unable to find the cluster named in the
+	//    request
+	//  * Internal (13): If Kafka returns an error on request
+	//  * Unavailable (14): If the service is shutting down
+	DescribeCluster(ctx context.Context, in *DescribeClusterRq, opts ...grpc.CallOption) (*DescribeClusterRs, error)
+	// Creates a topic. Only clients listed in
+	// config.yaml:proxies.<cluster>.admin.topic_managers are allowed to do
+	// that. Clients are identified by verified TLS client certificates, the
+	// client ID metadata is never trusted for that.
+	//
+	// gRPC error codes:
+	//  * Invalid Argument (3): If unable to find the cluster named in the
+	//    request, or if the topic parameters are rejected by Kafka
+	//  * Already Exists (6): If the topic already exists
+	//  * Permission Denied (7): If the client is not allowed to manage topics
+	//  * Internal (13): If Kafka returns an error on request
+	//  * Unavailable (14): If the service is shutting down
+	CreateTopic(ctx context.Context, in *CreateTopicRq, opts ...grpc.CallOption) (*CreateTopicRs, error)
+	// Deletes a topic. Only clients listed in
+	// config.yaml:proxies.<cluster>.admin.topic_managers are allowed to do
+	// that.
+	//
+	// gRPC error codes:
+	//  * Invalid Argument (3): If unable to find the cluster named in the request
+	//  * NotFound (5): If the topic does not exist
+	//  * Permission Denied (7): If the client is not allowed to manage topics
+	//  * Failed Precondition (9): If topic deletion is disabled in Kafka
+	//  * Internal (13): If Kafka returns an error on request
+	//  * Unavailable (14): If the service is shutting down
+	DeleteTopic(ctx context.Context, in *DeleteTopicRq, opts ...grpc.CallOption) (*DeleteTopicRs, error)
+	// Changes configuration overrides of a topic. Overrides that are not
+	// mentioned in the request are left intact. Only clients listed in
+	// config.yaml:proxies.<cluster>.admin.topic_managers are allowed to do
+	// that.
+	//
+	// gRPC error codes:
+	//  * Invalid Argument (3): If unable to find the cluster named in the
+	//    request, or if the configuration is rejected by Kafka
+	//  * NotFound (5): If the topic does not exist
+	//  * Permission Denied (7): If the client is not allowed to manage topics
+	//  * Internal (13): If Kafka returns an error on request
+	//  * Unavailable (14): If the service is shutting down
+	AlterTopicConfig(ctx context.Context, in *AlterTopicConfigRq, opts ...grpc.CallOption) (*AlterTopicConfigRs, error)
+	// Increases the number of partitions of a topic. Kafka does not allow to
+	// decrease it. When partitions are added, consumer groups consuming the
+	// topic via this Kafka-Pixy instance start consuming the new partitions
+	// right away. Only clients listed in
+	// config.yaml:proxies.<cluster>.admin.topic_managers are allowed to do
+	// that.
+	//
+	// gRPC error codes:
+	//  * Invalid Argument (3): If unable to find the cluster named in the
+	//    request, or if the partition count is rejected by Kafka
+	//  * NotFound (5): If the topic does not exist
+	//  * Permission Denied (7): If the client is not allowed to manage topics
+	//  * Internal (13): If Kafka returns an error on request
+	//  * Unavailable (14): If the service is shutting down
+	AddPartitions(ctx context.Context, in *AddPartitionsRq, opts ...grpc.CallOption) (*AddPartitionsRs, error)
+	// Makes Kafka move leadership of partitions to their preferred replicas,
+	// that are the first replicas in the partition replica lists. The election
+	// is performed by Kafka asynchronously, the response reflects the state of
+	// the partitions right after the election has been requested. Only clients
+	// listed in config.yaml:proxies.<cluster>.admin.topic_managers are allowed
+	// to do that.
+	//
+	// gRPC error codes:
+	//  * Invalid Argument (3): If unable to find the cluster named in the
+	//    request, or if the partition list is empty or has duplicates
+	//  * NotFound (5): If a partition does not exist
+	//  * Permission Denied (7): If the client is not allowed to manage topics
+	//  * Failed Precondition (9): If a previously requested election is still
+	//    in progress
+	//  * Internal (13): If Kafka returns an error on request
+	//  * Unavailable (14): If the service is shutting down
+	ElectPreferredLeaders(ctx context.Context, in *ElectPreferredLeadersRq, opts ...grpc.CallOption) (*ElectPreferredLeadersRs, error)
+	// Makes Kafka move partitions to new replicas, e.g. to move replicas off a
+	// broker that is being retired. The reassignment is performed by Kafka
+	// asynchronously, and can be monitored with GetReassignments. Only clients
+	// listed in config.yaml:proxies.<cluster>.admin.topic_managers are allowed
+	// to do that.
+	//
+	// gRPC error codes:
+	//  * Invalid Argument (3): If unable to find the cluster named in the
+	//    request, or if the replica assignment is invalid
+	//  * NotFound (5): If a partition does not exist
+	//  * Permission Denied (7): If the client is not allowed to manage topics
+	//  * Failed Precondition (9): If a previously requested reassignment is
+	//    still in progress
+	//  * Internal (13): If Kafka returns an error on request
+	//  * Unavailable (14): If the service is shutting down
+	ReassignPartitions(ctx context.Context, in *ReassignPartitionsRq, opts ...grpc.CallOption) (*ReassignPartitionsRs, error)
+	// Returns progress of partition reassignments that are in progress. Kafka
+	// forgets about reassignments as soon as they are completed.
+	//
+	// gRPC error codes:
+	//  * Invalid Argument (3): If unable to find the cluster named in the
+	//    request
+	//  * Internal (13): If Kafka returns an error on request
+	//  * Unavailable (14): If the service is shutting down
+	GetReassignments(ctx context.Context, in *GetReassignmentsRq, opts ...grpc.CallOption) (*GetReassignmentsRs, error)
+	// Reads a page of messages from a partition without joining a consumer
+	// group. It never changes offsets committed by consumer groups, so it is
+	// safe to use for investigation of incidents. The page size is limited by
+	// config.yaml:proxies.<cluster>.browse.max_count and max_bytes, and the
+	// rate of requests by config.yaml:proxies.<cluster>.browse.client_limit.
+	//
+	// gRPC error codes:
+	//  * Invalid Argument (3): If unable to find the cluster named in the
+	//    request
+	//  * NotFound (5): If the partition does not exist
+	//  * Resource Exhausted (8): If the client exceeded the browse rate limit.
+	//    The status details include a google.rpc.RetryInfo telling how long
+	//    to wait before retrying.
+	//  * Out Of Range (11): If the offset to read from is not in the range
+	//    of offsets of the partition
+	//  * Internal (13): If Kafka returns an error on request
+	//  * Unavailable (14): If the service is shutting down
+	BrowseMessages(ctx context.Context, in *BrowseMessagesRq, opts ...grpc.CallOption) (*BrowseMessagesRs, error)
 }
 
 type kafkaPixyClient struct {
@@ -141,6 +321,87 @@ func (c *kafkaPixyClient) Produce(ctx context.Context, in *ProdRq, opts ...grpc.
 	return out, nil
 }
 
+func (c *kafkaPixyClient) ProduceAtomic(ctx context.Context, in *ProdAtomicRq, opts ...grpc.CallOption) (*ProdAtomicRs, error) {
+	out := new(ProdAtomicRs)
+	err := c.cc.Invoke(ctx, "/KafkaPixy/ProduceAtomic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaPixyClient) ProduceBatch(ctx context.Context, in *ProdBatchRq, opts ...grpc.CallOption) (*ProdBatchRs, error) {
+	out := new(ProdBatchRs)
+	err := c.cc.Invoke(ctx, "/KafkaPixy/ProduceBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaPixyClient) ProduceStream(ctx context.Context, opts ...grpc.CallOption) (KafkaPixy_ProduceStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &KafkaPixy_ServiceDesc.Streams[0], "/KafkaPixy/ProduceStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &kafkaPixyProduceStreamClient{stream}
+	return x, nil
+}
+
+type KafkaPixy_ProduceStreamClient interface {
+	Send(*ProdStreamRq) error
+	Recv() (*ProdStreamRs, error)
+	grpc.ClientStream
+}
+
+type kafkaPixyProduceStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *kafkaPixyProduceStreamClient) Send(m *ProdStreamRq) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *kafkaPixyProduceStreamClient) Recv() (*ProdStreamRs, error) {
+	m := new(ProdStreamRs)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *kafkaPixyClient) DeliveryReports(ctx context.Context, in *DeliveryReportsRq, opts ...grpc.CallOption) (KafkaPixy_DeliveryReportsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &KafkaPixy_ServiceDesc.Streams[1], "/KafkaPixy/DeliveryReports", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &kafkaPixyDeliveryReportsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type KafkaPixy_DeliveryReportsClient interface {
+	Recv() (*DeliveryReport, error)
+	grpc.ClientStream
+}
+
+type kafkaPixyDeliveryReportsClient struct {
+	grpc.ClientStream
+}
+
+func (x *kafkaPixyDeliveryReportsClient) Recv() (*DeliveryReport, error) {
+	m := new(DeliveryReport)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *kafkaPixyClient) ConsumeNAck(ctx context.Context, in *ConsNAckRq, opts ...grpc.CallOption) (*ConsRs, error) {
 	out := new(ConsRs)
 	err := c.cc.Invoke(ctx, "/KafkaPixy/ConsumeNAck", in, out, opts...)
@@ -204,6 +465,87 @@ func (c *kafkaPixyClient) GetTopicMetadata(ctx context.Context, in *GetTopicMeta
 	return out, nil
 }
 
+func (c *kafkaPixyClient) DescribeCluster(ctx context.Context, in *DescribeClusterRq, opts ...grpc.CallOption) (*DescribeClusterRs, error) {
+	out := new(DescribeClusterRs)
+	err := c.cc.Invoke(ctx, "/KafkaPixy/DescribeCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaPixyClient) CreateTopic(ctx context.Context, in *CreateTopicRq, opts ...grpc.CallOption) (*CreateTopicRs, error) {
+	out := new(CreateTopicRs)
+	err := c.cc.Invoke(ctx, "/KafkaPixy/CreateTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaPixyClient) DeleteTopic(ctx context.Context, in *DeleteTopicRq, opts ...grpc.CallOption) (*DeleteTopicRs, error) {
+	out := new(DeleteTopicRs)
+	err := c.cc.Invoke(ctx, "/KafkaPixy/DeleteTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaPixyClient) AlterTopicConfig(ctx context.Context, in *AlterTopicConfigRq, opts ...grpc.CallOption) (*AlterTopicConfigRs, error) {
+	out := new(AlterTopicConfigRs)
+	err := c.cc.Invoke(ctx, "/KafkaPixy/AlterTopicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaPixyClient) AddPartitions(ctx context.Context, in *AddPartitionsRq, opts ...grpc.CallOption) (*AddPartitionsRs, error) {
+	out := new(AddPartitionsRs)
+	err := c.cc.Invoke(ctx, "/KafkaPixy/AddPartitions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaPixyClient) ElectPreferredLeaders(ctx context.Context, in *ElectPreferredLeadersRq, opts ...grpc.CallOption) (*ElectPreferredLeadersRs, error) {
+	out := new(ElectPreferredLeadersRs)
+	err := c.cc.Invoke(ctx, "/KafkaPixy/ElectPreferredLeaders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaPixyClient) ReassignPartitions(ctx context.Context, in *ReassignPartitionsRq, opts ...grpc.CallOption) (*ReassignPartitionsRs, error) {
+	out := new(ReassignPartitionsRs)
+	err := c.cc.Invoke(ctx, "/KafkaPixy/ReassignPartitions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaPixyClient) GetReassignments(ctx context.Context, in *GetReassignmentsRq, opts ...grpc.CallOption) (*GetReassignmentsRs, error) {
+	out := new(GetReassignmentsRs)
+	err := c.cc.Invoke(ctx, "/KafkaPixy/GetReassignments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaPixyClient) BrowseMessages(ctx context.Context, in *BrowseMessagesRq, opts ...grpc.CallOption) (*BrowseMessagesRs, error) {
+	out := new(BrowseMessagesRs)
+	err := c.cc.Invoke(ctx, "/KafkaPixy/BrowseMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KafkaPixyServer is the server API for KafkaPixy service.
 // All implementations must embed UnimplementedKafkaPixyServer
 // for forward compatibility
@@ -225,13 +567,68 @@ type KafkaPixyServer interface {
 	// ProdReq.key_undefined and ProdReq.key_value are left default, which is
 	// empty string and false respectively, then messages will be consitently
 	// written to a partiticular partition selected by the hash of an empty
-	// string.
+	// string. To write a message to a particular partition set
+	// ProdReq.explicit_partition to true and ProdReq.partition to the
+	// partition number.
 	//
 	// gRPC error codes:
 	//  * Invalid Argument (3): see the status description for details;
 	//  * Internal (13): see the status description and logs for details;
 	//  * Unavailable (14): the service is shutting down.
 	Produce(context.Context, *ProdRq) (*ProdRs, error)
+	// ProduceAtomic writes several messages, possibly to different topics, as
+	// a single Kafka transaction. Either all messages become visible to
+	// consumers with read_committed isolation level or none does. Partitions
+	// are selected for messages the same way as it is done by Produce.
+	//
+	// The method is only available if
+	// config.yaml:proxies.<cluster>.producer.transactional_id_prefix is set.
+	// Requires Kafka version 0.11.0.0 or later.
+	//
+	// gRPC error codes:
+	//  * Invalid Argument (3): see the status description for details;
+	//  * Internal (13): see the status description and logs for details;
+	//  * Unavailable (14): the service is shutting down or transactions are
+	//    disabled by configuration.
+	ProduceAtomic(context.Context, *ProdAtomicRq) (*ProdAtomicRs, error)
+	// ProduceBatch writes many messages, possibly to different topics, in a
+	// single call. All messages are submitted to the producer at once, so they
+	// are batched together when written to Kafka. The call blocks until all
+	// messages are either written or failed. Unlike ProduceAtomic, messages
+	// succeed or fail individually, and the response contains a result for
+	// every message in the order they were given in the request.
+	//
+	// gRPC error codes:
+	//  * Invalid Argument (3): see the status description for details.
+	//
+	// Errors of individual messages are reported in ProdBatchResult.
+	ProduceBatch(context.Context, *ProdBatchRq) (*ProdBatchRs, error)
+	// ProduceStream opens a bidirectional stream to write messages to Kafka
+	// continuously. For every message received on the stream a result is sent
+	// back once the message is written to Kafka or failed. Results carry the
+	// sequence number supplied by the client in ProdStreamRq.seq and are sent
+	// in the order the messages were received.
+	//
+	// When the producer buffer is filled up Kafka-Pixy stops reading from the
+	// stream until the buffer is drained, so clients are slowed down by gRPC
+	// flow control.
+	//
+	// Errors of individual messages are reported in ProdStreamRs. The stream
+	// is terminated with the following gRPC error codes:
+	//  * Invalid Argument (3): see the status description for details.
+	ProduceStream(KafkaPixy_ProduceStreamServer) error
+	// DeliveryReports opens a stream of results of messages produced by the
+	// Produce method in async mode with ProdRq.correlation_id set. A report is
+	// sent once a message is either written to Kafka or failed. Reports are
+	// sent to all streams opened by the client that produced the message, the
+	// client being identified by a verified TLS client certificate. Anonymous
+	// clients can neither open a stream nor set ProdRq.correlation_id, that
+	// fails with Unauthenticated (16). Reports of messages resolved while the
+	// client has no stream open, or while a stream is not keeping up with
+	// reports, are dropped, so clients should open a stream before producing.
+	// Dropped reports are counted in the delivery_reports_dropped metric at
+	// /debug/vars.
+	DeliveryReports(*DeliveryReportsRq, KafkaPixy_DeliveryReportsServer) error
 	// Consume reads a message from a topic and optionally acknowledges a
 	// message previously consumed from the same topic.
 	//
@@ -299,7 +696,9 @@ type KafkaPixyServer interface {
 	//  * Invalid Argument (3): If unable to find the cluster named in the request
 	//  * Internal (13): If Kafka returns an error on request
 	ListTopics(context.Context, *ListTopicRq) (*ListTopicRs, error)
-	// Lists all consumers of a topic
+	// Lists all consumers of a topic. That includes both consumer groups
+	// coordinated by Kafka-Pixy via ZooKeeper and consumer groups coordinated
+	// by Kafka.
 	//
 	// gRPC error codes:
 	//  * Invalid Argument (3): If unable to find the cluster named in the request
@@ -312,6 +711,129 @@ type KafkaPixyServer interface {
 	//  * Internal (13): If Kafka returns an error on request
 	//  * NotFound (5): If the topic does not exist
 	GetTopicMetadata(context.Context, *GetTopicMetadataRq) (*GetTopicMetadataRs, error)
+	// Returns brokers of a Kafka cluster along with their connectivity as
+	// seen by this Kafka-Pixy instance, the controller ID, and the numbers of
+	// under-replicated and offline partitions.
+	//
+	// gRPC error codes:
+	//  * Invalid Argument (3): If unable to find the cluster named in the
+	//    request
+	//  * Internal (13): If Kafka returns an error on request
+	//  * Unavailable (14): If the service is shutting down
+	DescribeCluster(context.Context, *DescribeClusterRq) (*DescribeClusterRs, error)
+	// Creates a topic. Only clients listed in
+	// config.yaml:proxies.<cluster>.admin.topic_managers are allowed to do
+	// that. Clients are identified by verified TLS client certificates, the
+	// client ID metadata is never trusted for that.
+	//
+	// gRPC error codes:
+	//  * Invalid Argument (3): If unable to find the cluster named in the
+	//    request, or if the topic parameters are rejected by Kafka
+	//  * Already Exists (6): If the topic already exists
+	//  * Permission Denied (7): If the client is not allowed to manage topics
+	//  * Internal (13): If Kafka returns an error on request
+	//  * Unavailable (14): If the service is shutting down
+	CreateTopic(context.Context, *CreateTopicRq) (*CreateTopicRs, error)
+	// Deletes a topic. Only clients listed in
+	// config.yaml:proxies.<cluster>.admin.topic_managers are allowed to do
+	// that.
+	//
+	// gRPC error codes:
+	//  * Invalid Argument (3): If unable to find the cluster named in the request
+	//  * NotFound (5): If the topic does not exist
+	//  * Permission Denied (7): If the client is not allowed to manage topics
+	//  * Failed Precondition (9): If topic deletion is disabled in Kafka
+	//  * Internal (13): If Kafka returns an error on request
+	//  * Unavailable (14): If the service is shutting down
+	DeleteTopic(context.Context, *DeleteTopicRq) (*DeleteTopicRs, error)
+	// Changes configuration overrides of a topic. Overrides that are not
+	// mentioned in the request are left intact. Only clients listed in
+	// config.yaml:proxies.<cluster>.admin.topic_managers are allowed to do
+	// that.
+	//
+	// gRPC error codes:
+	//  * Invalid Argument (3): If unable to find the cluster named in the
+	//    request, or if the configuration is rejected by Kafka
+	//  * NotFound (5): If the topic does not exist
+	//  * Permission Denied (7): If the client is not allowed to manage topics
+	//  * Internal (13): If Kafka returns an error on request
+	//  * Unavailable (14): If the service is shutting down
+	AlterTopicConfig(context.Context, *AlterTopicConfigRq) (*AlterTopicConfigRs, error)
+	// Increases the number of partitions of a topic. Kafka does not allow to
+	// decrease it. When partitions are added, consumer groups consuming the
+	// topic via this Kafka-Pixy instance start consuming the new partitions
+	// right away. Only clients listed in
+	// config.yaml:proxies.<cluster>.admin.topic_managers are allowed to do
+	// that.
+	//
+	// gRPC error codes:
+	//  * Invalid Argument (3): If unable to find the cluster named in the
+	//    request, or if the partition count is rejected by Kafka
+	//  * NotFound (5): If the topic does not exist
+	//  * Permission Denied (7): If the client is not allowed to manage topics
+	//  * Internal (13): If Kafka returns an error on request
+	//  * Unavailable (14): If the service is shutting down
+	AddPartitions(context.Context, *AddPartitionsRq) (*AddPartitionsRs, error)
+	// Makes Kafka move leadership of partitions to their preferred replicas,
+	// that are the first replicas in the partition replica lists. The election
+	// is performed by Kafka asynchronously, the response reflects the state of
+	// the partitions right after the election has been requested. Only clients
+	// listed in config.yaml:proxies.<cluster>.admin.topic_managers are allowed
+	// to do that.
+	//
+	// gRPC error codes:
+	//  * Invalid Argument (3): If unable to find the cluster named in the
+	//    request, or if the partition list is empty or has duplicates
+	//  * NotFound (5): If a partition does not exist
+	//  * Permission Denied (7): If the client is not allowed to manage topics
+	//  * Failed Precondition (9): If a previously requested election is still
+	//    in progress
+	//  * Internal (13): If Kafka returns an error on request
+	//  * Unavailable (14): If the service is shutting down
+	ElectPreferredLeaders(context.Context, *ElectPreferredLeadersRq) (*ElectPreferredLeadersRs, error)
+	// Makes Kafka move partitions to new replicas, e.g. to move replicas off a
+	// broker that is being retired. The reassignment is performed by Kafka
+	// asynchronously, and can be monitored with GetReassignments. Only clients
+	// listed in config.yaml:proxies.<cluster>.admin.topic_managers are allowed
+	// to do that.
+	//
+	// gRPC error codes:
+	//  * Invalid Argument (3): If unable to find the cluster named in the
+	//    request, or if the replica assignment is invalid
+	//  * NotFound (5): If a partition does not exist
+	//  * Permission Denied (7): If the client is not allowed to manage topics
+	//  * Failed Precondition (9): If a previously requested reassignment is
+	//    still in progress
+	//  * Internal (13): If Kafka returns an error on request
+	//  * Unavailable (14): If the service is shutting down
+	ReassignPartitions(context.Context, *ReassignPartitionsRq) (*ReassignPartitionsRs, error)
+	// Returns progress of partition reassignments that are in progress. Kafka
+	// forgets about reassignments as soon as they are completed.
+	//
+	// gRPC error codes:
+	//  * Invalid Argument (3): If unable to find the cluster named in the
+	//    request
+	//  * Internal (13): If Kafka returns an error on request
+	//  * Unavailable (14): If the service is shutting down
+	GetReassignments(context.Context, *GetReassignmentsRq) (*GetReassignmentsRs, error)
+	// Reads a page of messages from a partition without joining a consumer
+	// group. It never changes offsets committed by consumer groups, so it is
+	// safe to use for investigation of incidents. The page size is limited by
+	// config.yaml:proxies.<cluster>.browse.max_count and max_bytes, and the
+	// rate of requests by config.yaml:proxies.<cluster>.browse.client_limit.
+	//
+	// gRPC error codes:
+	//  * Invalid Argument (3): If unable to find the cluster named in the
+	//    request
+	//  * NotFound (5): If the partition does not exist
+	//  * Resource Exhausted (8): If the client exceeded the browse rate limit.
+	//    The status details include a google.rpc.RetryInfo telling how long
+	//    to wait before retrying.
+	//  * Out Of Range (11): If the offset to read from is not in the range
+	//    of offsets of the partition
+	//  * Internal (13): If Kafka returns an error on request
+	//  * Unavailable (14): If the service is shutting down
+	BrowseMessages(context.Context, *BrowseMessagesRq) (*BrowseMessagesRs, error)
 	mustEmbedUnimplementedKafkaPixyServer()
 }
 
@@ -322,6 +844,18 @@ type UnimplementedKafkaPixyServer struct {
 func (UnimplementedKafkaPixyServer) Produce(context.Context, *ProdRq) (*ProdRs, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Produce not implemented")
 }
+func (UnimplementedKafkaPixyServer) ProduceAtomic(context.Context, *ProdAtomicRq) (*ProdAtomicRs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProduceAtomic not implemented")
+}
+func (UnimplementedKafkaPixyServer) ProduceBatch(context.Context, *ProdBatchRq) (*ProdBatchRs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProduceBatch not implemented")
+}
+func (UnimplementedKafkaPixyServer) ProduceStream(KafkaPixy_ProduceStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ProduceStream not implemented")
+}
+func (UnimplementedKafkaPixyServer) DeliveryReports(*DeliveryReportsRq, KafkaPixy_DeliveryReportsServer) error {
+	return status.Errorf(codes.Unimplemented, "method DeliveryReports not implemented")
+}
 func (UnimplementedKafkaPixyServer) ConsumeNAck(context.Context, *ConsNAckRq) (*ConsRs, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConsumeNAck not implemented")
 }
@@ -343,6 +877,33 @@ func (UnimplementedKafkaPixyServer) ListConsumers(context.Context, *ListConsumer
 func (UnimplementedKafkaPixyServer) GetTopicMetadata(context.Context, *GetTopicMetadataRq) (*GetTopicMetadataRs, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTopicMetadata not implemented")
 }
+func (UnimplementedKafkaPixyServer) DescribeCluster(context.Context, *DescribeClusterRq) (*DescribeClusterRs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeCluster not implemented")
+}
+func (UnimplementedKafkaPixyServer) CreateTopic(context.Context, *CreateTopicRq) (*CreateTopicRs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTopic not implemented")
+}
+func (UnimplementedKafkaPixyServer) DeleteTopic(context.Context, *DeleteTopicRq) (*DeleteTopicRs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTopic not implemented")
+}
+func (UnimplementedKafkaPixyServer) AlterTopicConfig(context.Context, *AlterTopicConfigRq) (*AlterTopicConfigRs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterTopicConfig not implemented")
+}
+func (UnimplementedKafkaPixyServer) AddPartitions(context.Context, *AddPartitionsRq) (*AddPartitionsRs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPartitions not implemented")
+}
+func (UnimplementedKafkaPixyServer) ElectPreferredLeaders(context.Context, *ElectPreferredLeadersRq) (*ElectPreferredLeadersRs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ElectPreferredLeaders not implemented")
+}
+func (UnimplementedKafkaPixyServer) ReassignPartitions(context.Context, *ReassignPartitionsRq) (*ReassignPartitionsRs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReassignPartitions not implemented")
+}
+func (UnimplementedKafkaPixyServer) GetReassignments(context.Context, *GetReassignmentsRq) (*GetReassignmentsRs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReassignments not implemented")
+}
+func (UnimplementedKafkaPixyServer) BrowseMessages(context.Context, *BrowseMessagesRq) (*BrowseMessagesRs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BrowseMessages not implemented")
+}
 func (UnimplementedKafkaPixyServer) mustEmbedUnimplementedKafkaPixyServer() {}
 
 // UnsafeKafkaPixyServer may be embedded to opt out of forward compatibility for this service.
@@ -374,6 +935,89 @@ func _KafkaPixy_Produce_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KafkaPixy_ProduceAtomic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProdAtomicRq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaPixyServer).ProduceAtomic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KafkaPixy/ProduceAtomic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaPixyServer).ProduceAtomic(ctx, req.(*ProdAtomicRq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaPixy_ProduceBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProdBatchRq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaPixyServer).ProduceBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KafkaPixy/ProduceBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaPixyServer).ProduceBatch(ctx, req.(*ProdBatchRq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaPixy_ProduceStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(KafkaPixyServer).ProduceStream(&kafkaPixyProduceStreamServer{stream})
+}
+
+type KafkaPixy_ProduceStreamServer interface {
+	Send(*ProdStreamRs) error
+	Recv() (*ProdStreamRq, error)
+	grpc.ServerStream
+}
+
+type kafkaPixyProduceStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *kafkaPixyProduceStreamServer) Send(m *ProdStreamRs) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *kafkaPixyProduceStreamServer) Recv() (*ProdStreamRq, error) {
+	m := new(ProdStreamRq)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _KafkaPixy_DeliveryReports_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DeliveryReportsRq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KafkaPixyServer).DeliveryReports(m, &kafkaPixyDeliveryReportsServer{stream})
+}
+
+type KafkaPixy_DeliveryReportsServer interface {
+	Send(*DeliveryReport) error
+	grpc.ServerStream
+}
+
+type kafkaPixyDeliveryReportsServer struct {
+	grpc.ServerStream
+}
+
+func (x *kafkaPixyDeliveryReportsServer) Send(m *DeliveryReport) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _KafkaPixy_ConsumeNAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ConsNAckRq)
 	if err := dec(in); err != nil {
@@ -500,6 +1144,168 @@ func _KafkaPixy_GetTopicMetadata_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KafkaPixy_DescribeCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeClusterRq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaPixyServer).DescribeCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KafkaPixy/DescribeCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaPixyServer).DescribeCluster(ctx, req.(*DescribeClusterRq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaPixy_CreateTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTopicRq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaPixyServer).CreateTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KafkaPixy/CreateTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaPixyServer).CreateTopic(ctx, req.(*CreateTopicRq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaPixy_DeleteTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTopicRq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaPixyServer).DeleteTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KafkaPixy/DeleteTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaPixyServer).DeleteTopic(ctx, req.(*DeleteTopicRq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaPixy_AlterTopicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterTopicConfigRq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaPixyServer).AlterTopicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KafkaPixy/AlterTopicConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaPixyServer).AlterTopicConfig(ctx, req.(*AlterTopicConfigRq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaPixy_AddPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPartitionsRq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaPixyServer).AddPartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KafkaPixy/AddPartitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaPixyServer).AddPartitions(ctx, req.(*AddPartitionsRq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaPixy_ElectPreferredLeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElectPreferredLeadersRq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaPixyServer).ElectPreferredLeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KafkaPixy/ElectPreferredLeaders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaPixyServer).ElectPreferredLeaders(ctx, req.(*ElectPreferredLeadersRq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaPixy_ReassignPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignPartitionsRq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaPixyServer).ReassignPartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KafkaPixy/ReassignPartitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaPixyServer).ReassignPartitions(ctx, req.(*ReassignPartitionsRq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaPixy_GetReassignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReassignmentsRq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaPixyServer).GetReassignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KafkaPixy/GetReassignments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaPixyServer).GetReassignments(ctx, req.(*GetReassignmentsRq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaPixy_BrowseMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BrowseMessagesRq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaPixyServer).BrowseMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KafkaPixy/BrowseMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaPixyServer).BrowseMessages(ctx, req.(*BrowseMessagesRq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // KafkaPixy_ServiceDesc is the grpc.ServiceDesc for KafkaPixy service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -511,6 +1317,14 @@ var KafkaPixy_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Produce",
 			Handler:    _KafkaPixy_Produce_Handler,
 		},
+		{
+			MethodName: "ProduceAtomic",
+			Handler:    _KafkaPixy_ProduceAtomic_Handler,
+		},
+		{
+			MethodName: "ProduceBatch",
+			Handler:    _KafkaPixy_ProduceBatch_Handler,
+		},
 		{
 			MethodName: "ConsumeNAck",
 			Handler:    _KafkaPixy_ConsumeNAck_Handler,
@@ -539,7 +1353,55 @@ var KafkaPixy_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTopicMetadata",
 			Handler:    _KafkaPixy_GetTopicMetadata_Handler,
 		},
+		{
+			MethodName: "DescribeCluster",
+			Handler:    _KafkaPixy_DescribeCluster_Handler,
+		},
+		{
+			MethodName: "CreateTopic",
+			Handler:    _KafkaPixy_CreateTopic_Handler,
+		},
+		{
+			MethodName: "DeleteTopic",
+			Handler:    _KafkaPixy_DeleteTopic_Handler,
+		},
+		{
+			MethodName: "AlterTopicConfig",
+			Handler:    _KafkaPixy_AlterTopicConfig_Handler,
+		},
+		{
+			MethodName: "AddPartitions",
+			Handler:    _KafkaPixy_AddPartitions_Handler,
+		},
+		{
+			MethodName: "ElectPreferredLeaders",
+			Handler:    _KafkaPixy_ElectPreferredLeaders_Handler,
+		},
+		{
+			MethodName: "ReassignPartitions",
+			Handler:    _KafkaPixy_ReassignPartitions_Handler,
+		},
+		{
+			MethodName: "GetReassignments",
+			Handler:    _KafkaPixy_GetReassignments_Handler,
+		},
+		{
+			MethodName: "BrowseMessages",
+			Handler:    _KafkaPixy_BrowseMessages_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ProduceStream",
+			Handler:       _KafkaPixy_ProduceStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DeliveryReports",
+			Handler:       _KafkaPixy_DeliveryReports_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "kafkapixy.proto",
 }
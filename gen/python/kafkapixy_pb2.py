@@ -19,7 +19,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=b'\n\021mailgun.kafkapixyB\016KafkaPixyProtoP\001Z(github.com/mailgun/kafka-pixy/gen/golang',
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\x0fkafkapixy.proto\"*\n\x0cRecordHeader\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x0c\"\x97\x01\n\x06ProdRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\x12\r\n\x05topic\x18\x02 \x01(\t\x12\x11\n\tkey_value\x18\x03 \x01(\x0c\x12\x15\n\rkey_undefined\x18\x04 \x01(\x08\x12\x0f\n\x07message\x18\x05 \x01(\x0c\x12\x12\n\nasync_mode\x18\x06 \x01(\x08\x12\x1e\n\x07headers\x18\x07 \x03(\x0b\x32\r.RecordHeader\"+\n\x06ProdRs\x12\x11\n\tpartition\x18\x01 \x01(\x05\x12\x0e\n\x06offset\x18\x02 \x01(\x03\"\x88\x01\n\nConsNAckRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\x12\r\n\x05topic\x18\x02 \x01(\t\x12\r\n\x05group\x18\x03 \x01(\t\x12\x0e\n\x06no_ack\x18\x04 \x01(\x08\x12\x10\n\x08\x61uto_ack\x18\x05 \x01(\x08\x12\x15\n\rack_partition\x18\x06 \x01(\x05\x12\x12\n\nack_offset\x18\x07 \x01(\x03\"\x86\x01\n\x06\x43onsRs\x12\x11\n\tpartition\x18\x01 \x01(\x05\x12\x0e\n\x06offset\x18\x02 \x01(\x03\x12\x11\n\tkey_value\x18\x03 \x01(\x0c\x12\x15\n\rkey_undefined\x18\x04 \x01(\x08\x12\x0f\n\x07message\x18\x05 \x01(\x0c\x12\x1e\n\x07headers\x18\x06 \x03(\x0b\x32\r.RecordHeader\"Y\n\x05\x41\x63kRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\x12\r\n\x05topic\x18\x02 \x01(\t\x12\r\n\x05group\x18\x03 \x01(\t\x12\x11\n\tpartition\x18\x04 \x01(\x05\x12\x0e\n\x06offset\x18\x05 \x01(\x03\"\x07\n\x05\x41\x63kRs\"\x93\x01\n\x0fPartitionOffset\x12\x11\n\tpartition\x18\x01 \x01(\x05\x12\r\n\x05\x62\x65gin\x18\x02 \x01(\x03\x12\x0b\n\x03\x65nd\x18\x03 \x01(\x03\x12\r\n\x05\x63ount\x18\x04 \x01(\x03\x12\x0e\n\x06offset\x18\x05 \x01(\x03\x12\x0b\n\x03lag\x18\x06 \x01(\x03\x12\x10\n\x08metadata\x18\x07 \x01(\t\x12\x13\n\x0bsparse_acks\x18\x08 \x01(\t\"=\n\x0cGetOffsetsRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\x12\r\n\x05topic\x18\x02 \x01(\t\x12\r\n\x05group\x18\x03 \x01(\t\"1\n\x0cGetOffsetsRs\x12!\n\x07offsets\x18\x01 \x03(\x0b\x32\x10.PartitionOffset\"U\n\x11PartitionMetadata\x12\x11\n\tpartition\x18\x01 \x01(\x05\x12\x0e\n\x06leader\x18\x02 \x01(\x05\x12\x10\n\x08replicas\x18\x03 \x03(\x05\x12\x0b\n\x03isr\x18\x04 \x03(\x05\"M\n\x12GetTopicMetadataRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\x12\r\n\x05topic\x18\x02 \x01(\t\x12\x17\n\x0fwith_partitions\x18\x03 \x01(\x08\"\xad\x01\n\x12GetTopicMetadataRs\x12\x0f\n\x07version\x18\x01 \x01(\x05\x12/\n\x06\x63onfig\x18\x02 \x03(\x0b\x32\x1f.GetTopicMetadataRs.ConfigEntry\x12&\n\npartitions\x18\x03 \x03(\x0b\x32\x12.PartitionMetadata\x1a-\n\x0b\x43onfigEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"{\n\x0bListTopicRs\x12(\n\x06topics\x18\x01 \x03(\x0b\x32\x18.ListTopicRs.TopicsEntry\x1a\x42\n\x0bTopicsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\"\n\x05value\x18\x02 \x01(\x0b\x32\x13.GetTopicMetadataRs:\x02\x38\x01\"7\n\x0bListTopicRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\x12\x17\n\x0fwith_partitions\x18\x02 \x01(\x08\"@\n\x0fListConsumersRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\x12\r\n\x05topic\x18\x02 \x01(\t\x12\r\n\x05group\x18\x03 \x01(\t\"(\n\x12\x43onsumerPartitions\x12\x12\n\npartitions\x18\x01 \x03(\x05\"\x8a\x01\n\x0e\x43onsumerGroups\x12\x31\n\tconsumers\x18\x01 \x03(\x0b\x32\x1e.ConsumerGroups.ConsumersEntry\x1a\x45\n\x0e\x43onsumersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\"\n\x05value\x18\x02 \x01(\x0b\x32\x13.ConsumerPartitions:\x02\x38\x01\"\x7f\n\x0fListConsumersRs\x12,\n\x06groups\x18\x01 \x03(\x0b\x32\x1c.ListConsumersRs.GroupsEntry\x1a>\n\x0bGroupsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x1e\n\x05value\x18\x02 \x01(\x0b\x32\x0f.ConsumerGroups:\x02\x38\x01\"`\n\x0cSetOffsetsRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\x12\r\n\x05topic\x18\x02 \x01(\t\x12\r\n\x05group\x18\x03 \x01(\t\x12!\n\x07offsets\x18\x04 \x03(\x0b\x32\x10.PartitionOffset\"\x0e\n\x0cSetOffsetsRs2\xe9\x02\n\tKafkaPixy\x12\x1d\n\x07Produce\x12\x07.ProdRq\x1a\x07.ProdRs\"\x00\x12%\n\x0b\x43onsumeNAck\x12\x0b.ConsNAckRq\x1a\x07.ConsRs\"\x00\x12\x17\n\x03\x41\x63k\x12\x06.AckRq\x1a\x06.AckRs\"\x00\x12,\n\nGetOffsets\x12\r.GetOffsetsRq\x1a\r.GetOffsetsRs\"\x00\x12,\n\nSetOffsets\x12\r.SetOffsetsRq\x1a\r.SetOffsetsRs\"\x00\x12*\n\nListTopics\x12\x0c.ListTopicRq\x1a\x0c.ListTopicRs\"\x00\x12\x35\n\rListConsumers\x12\x10.ListConsumersRq\x1a\x10.ListConsumersRs\"\x00\x12>\n\x10GetTopicMetadata\x12\x13.GetTopicMetadataRq\x1a\x13.GetTopicMetadataRs\"\x00\x42O\n\x11mailgun.kafkapixyB\x0eKafkaPixyProtoP\x01Z(github.com/mailgun/kafka-pixy/gen/golangb\x06proto3'
+  serialized_pb=b'\n\x0fkafkapixy.proto\"*\n\x0cRecordHeader\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x0c\"\xf1\x01\n\x06ProdRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\x12\r\n\x05topic\x18\x02 \x01(\t\x12\x11\n\tkey_value\x18\x03 \x01(\x0c\x12\x15\n\rkey_undefined\x18\x04 \x01(\x08\x12\x0f\n\x07message\x18\x05 \x01(\x0c\x12\x12\n\nasync_mode\x18\x06 \x01(\x08\x12\x1e\n\x07headers\x18\x07 \x03(\x0b\x32\r.RecordHeader\x12\x1a\n\x12\x65xplicit_partition\x18\x08 \x01(\x08\x12\x11\n\tpartition\x18\t \x01(\x05\x12\x11\n\ttimestamp\x18\n \x01(\x03\x12\x16\n\x0e\x63orrelation_id\x18\x0b \x01(\t\"<\n\x06ProdRs\x12\x11\n\tpartition\x18\x01 \x01(\x05\x12\x0e\n\x06offset\x18\x02 \x01(\x03\x12\x0f\n\x07\x63luster\x18\x03 \x01(\t\"\x86\x01\n\x07ProdMsg\x12\r\n\x05topic\x18\x01 \x01(\t\x12\x11\n\tkey_value\x18\x02 \x01(\x0c\x12\x15\n\rkey_undefined\x18\x03 \x01(\x08\x12\x0f\n\x07message\x18\x04 \x01(\x0c\x12\x1e\n\x07headers\x18\x05 \x03(\x0b\x32\r.RecordHeader\x12\x11\n\ttimestamp\x18\x06 \x01(\x03\";\n\x0cProdAtomicRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\x12\x1a\n\x08messages\x18\x02 \x03(\x0b\x32\x08.ProdMsg\"(\n\x0cProdAtomicRs\x12\x18\n\x07results\x18\x01 \x03(\x0b\x32\x07.ProdRs\":\n\x0bProdBatchRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\x12\x1a\n\x08messages\x18\x02 \x03(\x0b\x32\x08.ProdMsg\"h\n\x0fProdBatchResult\x12\x11\n\tpartition\x18\x01 \x01(\x05\x12\x0e\n\x06offset\x18\x02 \x01(\x03\x12\x12\n\nerror_code\x18\x03 \x01(\x05\x12\r\n\x05\x65rror\x18\x04 \x01(\t\x12\x0f\n\x07\x63luster\x18\x05 \x01(\t\"0\n\x0bProdBatchRs\x12!\n\x07results\x18\x01 \x03(\x0b\x32\x10.ProdBatchResult\"G\n\x0cProdStreamRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\x12\x0b\n\x03seq\x18\x02 \x01(\x03\x12\x19\n\x07message\x18\x03 \x01(\x0b\x32\x08.ProdMsg\"r\n\x0cProdStreamRs\x12\x0b\n\x03seq\x18\x01 \x01(\x03\x12\x11\n\tpartition\x18\x02 \x01(\x05\x12\x0e\n\x06offset\x18\x03 \x01(\x03\x12\x12\n\nerror_code\x18\x04 \x01(\x05\x12\r\n\x05\x65rror\x18\x05 \x01(\t\x12\x0f\n\x07\x63luster\x18\x06 \x01(\t\"\x13\n\x11\x44\x65liveryReportsRq\"\x7f\n\x0e\x44\x65liveryReport\x12\x16\n\x0e\x63orrelation_id\x18\x01 \x01(\t\x12\x0f\n\x07\x63luster\x18\x02 \x01(\t\x12\x11\n\tpartition\x18\x03 \x01(\x05\x12\x0e\n\x06offset\x18\x04 \x01(\x03\x12\x12\n\nerror_code\x18\x05 \x01(\x05\x12\r\n\x05\x65rror\x18\x06 \x01(\t\"\x88\x01\n\nConsNAckRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\x12\r\n\x05topic\x18\x02 \x01(\t\x12\r\n\x05group\x18\x03 \x01(\t\x12\x0e\n\x06no_ack\x18\x04 \x01(\x08\x12\x10\n\x08\x61uto_ack\x18\x05 \x01(\x08\x12\x15\n\rack_partition\x18\x06 \x01(\x05\x12\x12\n\nack_offset\x18\x07 \x01(\x03\"\xb1\x01\n\x06\x43onsRs\x12\x11\n\tpartition\x18\x01 \x01(\x05\x12\x0e\n\x06offset\x18\x02 \x01(\x03\x12\x11\n\tkey_value\x18\x03 \x01(\x0c\x12\x15\n\rkey_undefined\x18\x04 \x01(\x08\x12\x0f\n\x07message\x18\x05 \x01(\x0c\x12\x1e\n\x07headers\x18\x06 \x03(\x0b\x32\r.RecordHeader\x12\x11\n\ttimestamp\x18\x07 \x01(\x03\x12\x16\n\x0etimestamp_type\x18\x08 \x01(\t\"Y\n\x05\x41\x63kRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\x12\r\n\x05topic\x18\x02 \x01(\t\x12\r\n\x05group\x18\x03 \x01(\t\x12\x11\n\tpartition\x18\x04 \x01(\x05\x12\x0e\n\x06offset\x18\x05 \x01(\x03\"\x07\n\x05\x41\x63kRs\"\x93\x01\n\x0fPartitionOffset\x12\x11\n\tpartition\x18\x01 \x01(\x05\x12\r\n\x05\x62\x65gin\x18\x02 \x01(\x03\x12\x0b\n\x03\x65nd\x18\x03 \x01(\x03\x12\r\n\x05\x63ount\x18\x04 \x01(\x03\x12\x0e\n\x06offset\x18\x05 \x01(\x03\x12\x0b\n\x03lag\x18\x06 \x01(\x03\x12\x10\n\x08metadata\x18\x07 \x01(\t\x12\x13\n\x0bsparse_acks\x18\x08 \x01(\t\"=\n\x0cGetOffsetsRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\x12\r\n\x05topic\x18\x02 \x01(\t\x12\r\n\x05group\x18\x03 \x01(\t\"1\n\x0cGetOffsetsRs\x12!\n\x07offsets\x18\x01 \x03(\x0b\x32\x10.PartitionOffset\"U\n\x11PartitionMetadata\x12\x11\n\tpartition\x18\x01 \x01(\x05\x12\x0e\n\x06leader\x18\x02 \x01(\x05\x12\x10\n\x08replicas\x18\x03 \x03(\x05\x12\x0b\n\x03isr\x18\x04 \x03(\x05\"M\n\x12GetTopicMetadataRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\x12\r\n\x05topic\x18\x02 \x01(\t\x12\x17\n\x0fwith_partitions\x18\x03 \x01(\x08\"\xad\x01\n\x12GetTopicMetadataRs\x12\x0f\n\x07version\x18\x01 \x01(\x05\x12/\n\x06\x63onfig\x18\x02 \x03(\x0b\x32\x1f.GetTopicMetadataRs.ConfigEntry\x12&\n\npartitions\x18\x03 \x03(\x0b\x32\x12.PartitionMetadata\x1a-\n\x0b\x43onfigEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"{\n\x0bListTopicRs\x12(\n\x06topics\x18\x01 \x03(\x0b\x32\x18.ListTopicRs.TopicsEntry\x1a\x42\n\x0bTopicsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\"\n\x05value\x18\x02 \x01(\x0b\x32\x13.GetTopicMetadataRs:\x02\x38\x01\"7\n\x0bListTopicRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\x12\x17\n\x0fwith_partitions\x18\x02 \x01(\x08\"@\n\x0fListConsumersRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\x12\r\n\x05topic\x18\x02 \x01(\t\x12\r\n\x05group\x18\x03 \x01(\t\"P\n\x12\x43onsumerPartitions\x12\x12\n\npartitions\x18\x01 \x03(\x05\x12\x11\n\tclient_id\x18\x02 \x01(\t\x12\x13\n\x0b\x63lient_host\x18\x03 \x01(\t\"\xca\x01\n\x0e\x43onsumerGroups\x12\x31\n\tconsumers\x18\x01 \x03(\x0b\x32\x1e.ConsumerGroups.ConsumersEntry\x12\x0e\n\x06native\x18\x02 \x01(\x08\x12\r\n\x05state\x18\x03 \x01(\t\x12\x10\n\x08protocol\x18\x04 \x01(\t\x12\r\n\x05mixed\x18\x05 \x01(\x08\x1a\x45\n\x0e\x43onsumersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\"\n\x05value\x18\x02 \x01(\x0b\x32\x13.ConsumerPartitions:\x02\x38\x01\"\x7f\n\x0fListConsumersRs\x12,\n\x06groups\x18\x01 \x03(\x0b\x32\x1c.ListConsumersRs.GroupsEntry\x1a>\n\x0bGroupsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x1e\n\x05value\x18\x02 \x01(\x0b\x32\x0f.ConsumerGroups:\x02\x38\x01\"`\n\x0cSetOffsetsRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\x12\r\n\x05topic\x18\x02 \x01(\t\x12\r\n\x05group\x18\x03 \x01(\t\x12!\n\x07offsets\x18\x04 \x03(\x0b\x32\x10.PartitionOffset\"\x0e\n\x0cSetOffsetsRs\"\xd1\x01\n\rCreateTopicRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\x12\r\n\x05topic\x18\x02 \x01(\t\x12\x12\n\npartitions\x18\x03 \x01(\x05\x12\x1a\n\x12replication_factor\x18\x04 \x01(\x05\x12*\n\x06\x63onfig\x18\x05 \x03(\x0b\x32\x1a.CreateTopicRq.ConfigEntry\x12\x15\n\rvalidate_only\x18\x06 \x01(\x08\x1a-\n\x0b\x43onfigEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x0f\n\rCreateTopicRs\"/\n\rDeleteTopicRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\x12\r\n\x05topic\x18\x02 \x01(\t\"\x0f\n\rDeleteTopicRs\"\xb2\x01\n\x12\x41lterTopicConfigRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\x12\r\n\x05topic\x18\x02 \x01(\t\x12)\n\x03set\x18\x03 \x03(\x0b\x32\x1c.AlterTopicConfigRq.SetEntry\x12\x0e\n\x06\x64\x65lete\x18\x04 \x03(\t\x12\x15\n\rvalidate_only\x18\x05 \x01(\x08\x1a*\n\x08SetEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x14\n\x12\x41lterTopicConfigRs\"W\n\x0f\x41\x64\x64PartitionsRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\x12\r\n\x05topic\x18\x02 \x01(\t\x12\r\n\x05\x63ount\x18\x03 \x01(\x05\x12\x15\n\rvalidate_only\x18\x04 \x01(\x08\"\x11\n\x0f\x41\x64\x64PartitionsRs\"2\n\x0eTopicPartition\x12\r\n\x05topic\x18\x01 \x01(\t\x12\x11\n\tpartition\x18\x02 \x01(\x05\"K\n\x15PartitionReassignment\x12\r\n\x05topic\x18\x01 \x01(\t\x12\x11\n\tpartition\x18\x02 \x01(\x05\x12\x10\n\x08replicas\x18\x03 \x03(\x05\"\x82\x01\n\x11PartitionProgress\x12\r\n\x05topic\x18\x01 \x01(\t\x12\x11\n\tpartition\x18\x02 \x01(\x05\x12\x0e\n\x06target\x18\x03 \x03(\x05\x12\x0e\n\x06leader\x18\x04 \x01(\x05\x12\x10\n\x08replicas\x18\x05 \x03(\x05\x12\x0b\n\x03isr\x18\x06 \x03(\x05\x12\x0c\n\x04\x64one\x18\x07 \x01(\x08\"O\n\x17\x45lectPreferredLeadersRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\x12#\n\npartitions\x18\x02 \x03(\x0b\x32\x0f.TopicPartition\"A\n\x17\x45lectPreferredLeadersRs\x12&\n\npartitions\x18\x01 \x03(\x0b\x32\x12.PartitionProgress\"V\n\x14ReassignPartitionsRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\x12-\n\rreassignments\x18\x02 \x03(\x0b\x32\x16.PartitionReassignment\">\n\x14ReassignPartitionsRs\x12&\n\npartitions\x18\x01 \x03(\x0b\x32\x12.PartitionProgress\"%\n\x12GetReassignmentsRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\"<\n\x12GetReassignmentsRs\x12&\n\npartitions\x18\x01 \x03(\x0b\x32\x12.PartitionProgress\"Z\n\x0e\x42rokerMetadata\x12\n\n\x02id\x18\x01 \x01(\x05\x12\x0c\n\x04\x61\x64\x64r\x18\x02 \x01(\t\x12\x0c\n\x04rack\x18\x03 \x01(\t\x12\x11\n\tconnected\x18\x04 \x01(\x08\x12\r\n\x05\x65rror\x18\x05 \x01(\t\"$\n\x11\x44\x65scribeClusterRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\"\x8d\x01\n\x11\x44\x65scribeClusterRs\x12\x15\n\rcontroller_id\x18\x01 \x01(\x05\x12 \n\x07\x62rokers\x18\x02 \x03(\x0b\x32\x0f.BrokerMetadata\x12#\n\x1bunder_replicated_partitions\x18\x03 \x01(\x05\x12\x1a\n\x12offline_partitions\x18\x04 \x01(\x05\"b\n\x10\x42rowseMessagesRq\x12\x0f\n\x07\x63luster\x18\x01 \x01(\t\x12\r\n\x05topic\x18\x02 \x01(\t\x12\x11\n\tpartition\x18\x03 \x01(\x05\x12\x0c\n\x04\x66rom\x18\x04 \x01(\x03\x12\r\n\x05\x63ount\x18\x05 \x01(\x05\"\xb7\x01\n\x0e\x42rowsedMessage\x12\x0e\n\x06offset\x18\x01 \x01(\x03\x12\x11\n\tkey_value\x18\x02 \x01(\x0c\x12\x15\n\rkey_undefined\x18\x03 \x01(\x08\x12\x0f\n\x07message\x18\x04 \x01(\x0c\x12\x1e\n\x07headers\x18\x05 \x03(\x0b\x32\r.RecordHeader\x12\x11\n\ttimestamp\x18\x06 \x01(\x03\x12\x16\n\x0etimestamp_type\x18\x07 \x01(\t\x12\x0f\n\x07preview\x18\x08 \x01(\t\"f\n\x10\x42rowseMessagesRs\x12!\n\x08messages\x18\x01 \x03(\x0b\x32\x0f.BrowsedMessage\x12\x13\n\x0bnext_offset\x18\x02 \x01(\x03\x12\r\n\x05\x62\x65gin\x18\x03 \x01(\x03\x12\x0b\n\x03\x65nd\x18\x04 \x01(\x03\x32\xde\x08\n\tKafkaPixy\x12\x1d\n\x07Produce\x12\x07.ProdRq\x1a\x07.ProdRs\"\x00\x12/\n\rProduceAtomic\x12\r.ProdAtomicRq\x1a\r.ProdAtomicRs\"\x00\x12,\n\x0cProduceBatch\x12\x0c.ProdBatchRq\x1a\x0c.ProdBatchRs\"\x00\x12\x33\n\rProduceStream\x12\r.ProdStreamRq\x1a\r.ProdStreamRs\"\x00(\x01\x30\x01\x12:\n\x0f\x44\x65liveryReports\x12\x12.DeliveryReportsRq\x1a\x0f.DeliveryReport\"\x00\x30\x01\x12%\n\x0b\x43onsumeNAck\x12\x0b.ConsNAckRq\x1a\x07.ConsRs\"\x00\x12\x17\n\x03\x41\x63k\x12\x06.AckRq\x1a\x06.AckRs\"\x00\x12,\n\nGetOffsets\x12\r.GetOffsetsRq\x1a\r.GetOffsetsRs\"\x00\x12,\n\nSetOffsets\x12\r.SetOffsetsRq\x1a\r.SetOffsetsRs\"\x00\x12*\n\nListTopics\x12\x0c.ListTopicRq\x1a\x0c.ListTopicRs\"\x00\x12\x35\n\rListConsumers\x12\x10.ListConsumersRq\x1a\x10.ListConsumersRs\"\x00\x12>\n\x10GetTopicMetadata\x12\x13.GetTopicMetadataRq\x1a\x13.GetTopicMetadataRs\"\x00\x12;\n\x0f\x44\x65scribeCluster\x12\x12.DescribeClusterRq\x1a\x12.DescribeClusterRs\"\x00\x12/\n\x0b\x43reateTopic\x12\x0e.CreateTopicRq\x1a\x0e.CreateTopicRs\"\x00\x12/\n\x0b\x44\x65leteTopic\x12\x0e.DeleteTopicRq\x1a\x0e.DeleteTopicRs\"\x00\x12>\n\x10\x41lterTopicConfig\x12\x13.AlterTopicConfigRq\x1a\x13.AlterTopicConfigRs\"\x00\x12\x35\n\rAddPartitions\x12\x10.AddPartitionsRq\x1a\x10.AddPartitionsRs\"\x00\x12M\n\x15\x45lectPreferredLeaders\x12\x18.ElectPreferredLeadersRq\x1a\x18.ElectPreferredLeadersRs\"\x00\x12\x44\n\x12ReassignPartitions\x12\x15.ReassignPartitionsRq\x1a\x15.ReassignPartitionsRs\"\x00\x12>\n\x10GetReassignments\x12\x13.GetReassignmentsRq\x1a\x13.GetReassignmentsRs\"\x00\x12\x38\n\x0e\x42rowseMessages\x12\x11.BrowseMessagesRq\x1a\x11.BrowseMessagesRs\"\x00\x42O\n\x11mailgun.kafkapixyB\x0eKafkaPixyProtoP\x01Z(github.com/mailgun/kafka-pixy/gen/golangb\x06proto3'
 )
 
 
@@ -121,6 +121,34 @@ _PRODRQ = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='explicit_partition', full_name='ProdRq.explicit_partition', index=7,
+      number=8, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='partition', full_name='ProdRq.partition', index=8,
+      number=9, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='timestamp', full_name='ProdRq.timestamp', index=9,
+      number=10, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='correlation_id', full_name='ProdRq.correlation_id', index=10,
+      number=11, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -134,7 +162,7 @@ _PRODRQ = _descriptor.Descriptor(
   oneofs=[
   ],
   serialized_start=64,
-  serialized_end=215,
+  serialized_end=305,
 )
 
 
@@ -160,6 +188,13 @@ _PRODRS = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='cluster', full_name='ProdRs.cluster', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -172,64 +207,57 @@ _PRODRS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=217,
-  serialized_end=260,
+  serialized_start=307,
+  serialized_end=367,
 )
 
 
-_CONSNACKRQ = _descriptor.Descriptor(
-  name='ConsNAckRq',
-  full_name='ConsNAckRq',
+_PRODMSG = _descriptor.Descriptor(
+  name='ProdMsg',
+  full_name='ProdMsg',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='cluster', full_name='ConsNAckRq.cluster', index=0,
+      name='topic', full_name='ProdMsg.topic', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='topic', full_name='ConsNAckRq.topic', index=1,
-      number=2, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=b"".decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
-    _descriptor.FieldDescriptor(
-      name='group', full_name='ConsNAckRq.group', index=2,
-      number=3, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=b"".decode('utf-8'),
+      name='key_value', full_name='ProdMsg.key_value', index=1,
+      number=2, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"",
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='no_ack', full_name='ConsNAckRq.no_ack', index=3,
-      number=4, type=8, cpp_type=7, label=1,
+      name='key_undefined', full_name='ProdMsg.key_undefined', index=2,
+      number=3, type=8, cpp_type=7, label=1,
       has_default_value=False, default_value=False,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='auto_ack', full_name='ConsNAckRq.auto_ack', index=4,
-      number=5, type=8, cpp_type=7, label=1,
-      has_default_value=False, default_value=False,
+      name='message', full_name='ProdMsg.message', index=3,
+      number=4, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"",
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='ack_partition', full_name='ConsNAckRq.ack_partition', index=5,
-      number=6, type=5, cpp_type=1, label=1,
-      has_default_value=False, default_value=0,
+      name='headers', full_name='ProdMsg.headers', index=4,
+      number=5, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='ack_offset', full_name='ConsNAckRq.ack_offset', index=6,
-      number=7, type=3, cpp_type=2, label=1,
+      name='timestamp', full_name='ProdMsg.timestamp', index=5,
+      number=6, type=3, cpp_type=2, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
@@ -246,57 +274,100 @@ _CONSNACKRQ = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=263,
-  serialized_end=399,
+  serialized_start=370,
+  serialized_end=504,
 )
 
 
-_CONSRS = _descriptor.Descriptor(
-  name='ConsRs',
-  full_name='ConsRs',
+_PRODATOMICRQ = _descriptor.Descriptor(
+  name='ProdAtomicRq',
+  full_name='ProdAtomicRq',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='partition', full_name='ConsRs.partition', index=0,
-      number=1, type=5, cpp_type=1, label=1,
-      has_default_value=False, default_value=0,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
-    _descriptor.FieldDescriptor(
-      name='offset', full_name='ConsRs.offset', index=1,
-      number=2, type=3, cpp_type=2, label=1,
-      has_default_value=False, default_value=0,
+      name='cluster', full_name='ProdAtomicRq.cluster', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='key_value', full_name='ConsRs.key_value', index=2,
-      number=3, type=12, cpp_type=9, label=1,
-      has_default_value=False, default_value=b"",
+      name='messages', full_name='ProdAtomicRq.messages', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=506,
+  serialized_end=565,
+)
+
+
+_PRODATOMICRS = _descriptor.Descriptor(
+  name='ProdAtomicRs',
+  full_name='ProdAtomicRs',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
     _descriptor.FieldDescriptor(
-      name='key_undefined', full_name='ConsRs.key_undefined', index=3,
-      number=4, type=8, cpp_type=7, label=1,
-      has_default_value=False, default_value=False,
+      name='results', full_name='ProdAtomicRs.results', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=567,
+  serialized_end=607,
+)
+
+
+_PRODBATCHRQ = _descriptor.Descriptor(
+  name='ProdBatchRq',
+  full_name='ProdBatchRq',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
     _descriptor.FieldDescriptor(
-      name='message', full_name='ConsRs.message', index=4,
-      number=5, type=12, cpp_type=9, label=1,
-      has_default_value=False, default_value=b"",
+      name='cluster', full_name='ProdBatchRq.cluster', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='headers', full_name='ConsRs.headers', index=5,
-      number=6, type=11, cpp_type=10, label=3,
+      name='messages', full_name='ProdBatchRq.messages', index=1,
+      number=2, type=11, cpp_type=10, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
@@ -313,51 +384,51 @@ _CONSRS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=402,
-  serialized_end=536,
+  serialized_start=609,
+  serialized_end=667,
 )
 
 
-_ACKRQ = _descriptor.Descriptor(
-  name='AckRq',
-  full_name='AckRq',
+_PRODBATCHRESULT = _descriptor.Descriptor(
+  name='ProdBatchResult',
+  full_name='ProdBatchResult',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='cluster', full_name='AckRq.cluster', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=b"".decode('utf-8'),
+      name='partition', full_name='ProdBatchResult.partition', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='topic', full_name='AckRq.topic', index=1,
-      number=2, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=b"".decode('utf-8'),
+      name='offset', full_name='ProdBatchResult.offset', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='group', full_name='AckRq.group', index=2,
-      number=3, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=b"".decode('utf-8'),
+      name='error_code', full_name='ProdBatchResult.error_code', index=2,
+      number=3, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='partition', full_name='AckRq.partition', index=3,
-      number=4, type=5, cpp_type=1, label=1,
-      has_default_value=False, default_value=0,
+      name='error', full_name='ProdBatchResult.error', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='offset', full_name='AckRq.offset', index=4,
-      number=5, type=3, cpp_type=2, label=1,
-      has_default_value=False, default_value=0,
+      name='cluster', full_name='ProdBatchResult.cluster', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
@@ -373,19 +444,26 @@ _ACKRQ = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=538,
-  serialized_end=627,
+  serialized_start=669,
+  serialized_end=773,
 )
 
 
-_ACKRS = _descriptor.Descriptor(
-  name='AckRs',
-  full_name='AckRs',
+_PRODBATCHRS = _descriptor.Descriptor(
+  name='ProdBatchRs',
+  full_name='ProdBatchRs',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='results', full_name='ProdBatchRs.results', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -398,71 +476,103 @@ _ACKRS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=629,
-  serialized_end=636,
+  serialized_start=775,
+  serialized_end=823,
 )
 
 
-_PARTITIONOFFSET = _descriptor.Descriptor(
-  name='PartitionOffset',
-  full_name='PartitionOffset',
+_PRODSTREAMRQ = _descriptor.Descriptor(
+  name='ProdStreamRq',
+  full_name='ProdStreamRq',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='partition', full_name='PartitionOffset.partition', index=0,
-      number=1, type=5, cpp_type=1, label=1,
-      has_default_value=False, default_value=0,
+      name='cluster', full_name='ProdStreamRq.cluster', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='begin', full_name='PartitionOffset.begin', index=1,
+      name='seq', full_name='ProdStreamRq.seq', index=1,
       number=2, type=3, cpp_type=2, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='end', full_name='PartitionOffset.end', index=2,
-      number=3, type=3, cpp_type=2, label=1,
+      name='message', full_name='ProdStreamRq.message', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=825,
+  serialized_end=896,
+)
+
+
+_PRODSTREAMRS = _descriptor.Descriptor(
+  name='ProdStreamRs',
+  full_name='ProdStreamRs',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='seq', full_name='ProdStreamRs.seq', index=0,
+      number=1, type=3, cpp_type=2, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='count', full_name='PartitionOffset.count', index=3,
-      number=4, type=3, cpp_type=2, label=1,
+      name='partition', full_name='ProdStreamRs.partition', index=1,
+      number=2, type=5, cpp_type=1, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='offset', full_name='PartitionOffset.offset', index=4,
-      number=5, type=3, cpp_type=2, label=1,
+      name='offset', full_name='ProdStreamRs.offset', index=2,
+      number=3, type=3, cpp_type=2, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='lag', full_name='PartitionOffset.lag', index=5,
-      number=6, type=3, cpp_type=2, label=1,
+      name='error_code', full_name='ProdStreamRs.error_code', index=3,
+      number=4, type=5, cpp_type=1, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='metadata', full_name='PartitionOffset.metadata', index=6,
-      number=7, type=9, cpp_type=9, label=1,
+      name='error', full_name='ProdStreamRs.error', index=4,
+      number=5, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='sparse_acks', full_name='PartitionOffset.sparse_acks', index=7,
-      number=8, type=9, cpp_type=9, label=1,
+      name='cluster', full_name='ProdStreamRs.cluster', index=5,
+      number=6, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
@@ -479,36 +589,82 @@ _PARTITIONOFFSET = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=639,
-  serialized_end=786,
+  serialized_start=898,
+  serialized_end=1012,
 )
 
 
-_GETOFFSETSRQ = _descriptor.Descriptor(
-  name='GetOffsetsRq',
-  full_name='GetOffsetsRq',
+_DELIVERYREPORTSRQ = _descriptor.Descriptor(
+  name='DeliveryReportsRq',
+  full_name='DeliveryReportsRq',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1014,
+  serialized_end=1033,
+)
+
+
+_DELIVERYREPORT = _descriptor.Descriptor(
+  name='DeliveryReport',
+  full_name='DeliveryReport',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='cluster', full_name='GetOffsetsRq.cluster', index=0,
+      name='correlation_id', full_name='DeliveryReport.correlation_id', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='topic', full_name='GetOffsetsRq.topic', index=1,
+      name='cluster', full_name='DeliveryReport.cluster', index=1,
       number=2, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='group', full_name='GetOffsetsRq.group', index=2,
-      number=3, type=9, cpp_type=9, label=1,
+      name='partition', full_name='DeliveryReport.partition', index=2,
+      number=3, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='offset', full_name='DeliveryReport.offset', index=3,
+      number=4, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='error_code', full_name='DeliveryReport.error_code', index=4,
+      number=5, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='error', full_name='DeliveryReport.error', index=5,
+      number=6, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
@@ -525,12 +681,379 @@ _GETOFFSETSRQ = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=788,
-  serialized_end=849,
+  serialized_start=1035,
+  serialized_end=1162,
 )
 
 
-_GETOFFSETSRS = _descriptor.Descriptor(
+_CONSNACKRQ = _descriptor.Descriptor(
+  name='ConsNAckRq',
+  full_name='ConsNAckRq',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='cluster', full_name='ConsNAckRq.cluster', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='topic', full_name='ConsNAckRq.topic', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='group', full_name='ConsNAckRq.group', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='no_ack', full_name='ConsNAckRq.no_ack', index=3,
+      number=4, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='auto_ack', full_name='ConsNAckRq.auto_ack', index=4,
+      number=5, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='ack_partition', full_name='ConsNAckRq.ack_partition', index=5,
+      number=6, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='ack_offset', full_name='ConsNAckRq.ack_offset', index=6,
+      number=7, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1165,
+  serialized_end=1301,
+)
+
+
+_CONSRS = _descriptor.Descriptor(
+  name='ConsRs',
+  full_name='ConsRs',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='partition', full_name='ConsRs.partition', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='offset', full_name='ConsRs.offset', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='key_value', full_name='ConsRs.key_value', index=2,
+      number=3, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"",
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='key_undefined', full_name='ConsRs.key_undefined', index=3,
+      number=4, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='message', full_name='ConsRs.message', index=4,
+      number=5, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"",
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='headers', full_name='ConsRs.headers', index=5,
+      number=6, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='timestamp', full_name='ConsRs.timestamp', index=6,
+      number=7, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='timestamp_type', full_name='ConsRs.timestamp_type', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1304,
+  serialized_end=1481,
+)
+
+
+_ACKRQ = _descriptor.Descriptor(
+  name='AckRq',
+  full_name='AckRq',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='cluster', full_name='AckRq.cluster', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='topic', full_name='AckRq.topic', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='group', full_name='AckRq.group', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='partition', full_name='AckRq.partition', index=3,
+      number=4, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='offset', full_name='AckRq.offset', index=4,
+      number=5, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1483,
+  serialized_end=1572,
+)
+
+
+_ACKRS = _descriptor.Descriptor(
+  name='AckRs',
+  full_name='AckRs',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1574,
+  serialized_end=1581,
+)
+
+
+_PARTITIONOFFSET = _descriptor.Descriptor(
+  name='PartitionOffset',
+  full_name='PartitionOffset',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='partition', full_name='PartitionOffset.partition', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='begin', full_name='PartitionOffset.begin', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='end', full_name='PartitionOffset.end', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='count', full_name='PartitionOffset.count', index=3,
+      number=4, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='offset', full_name='PartitionOffset.offset', index=4,
+      number=5, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='lag', full_name='PartitionOffset.lag', index=5,
+      number=6, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='metadata', full_name='PartitionOffset.metadata', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='sparse_acks', full_name='PartitionOffset.sparse_acks', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1584,
+  serialized_end=1731,
+)
+
+
+_GETOFFSETSRQ = _descriptor.Descriptor(
+  name='GetOffsetsRq',
+  full_name='GetOffsetsRq',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='cluster', full_name='GetOffsetsRq.cluster', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='topic', full_name='GetOffsetsRq.topic', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='group', full_name='GetOffsetsRq.group', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1733,
+  serialized_end=1794,
+)
+
+
+_GETOFFSETSRS = _descriptor.Descriptor(
   name='GetOffsetsRs',
   full_name='GetOffsetsRs',
   filename=None,
@@ -539,12 +1062,1076 @@ _GETOFFSETSRS = _descriptor.Descriptor(
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='offsets', full_name='GetOffsetsRs.offsets', index=0,
-      number=1, type=11, cpp_type=10, label=3,
+      name='offsets', full_name='GetOffsetsRs.offsets', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1796,
+  serialized_end=1845,
+)
+
+
+_PARTITIONMETADATA = _descriptor.Descriptor(
+  name='PartitionMetadata',
+  full_name='PartitionMetadata',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='partition', full_name='PartitionMetadata.partition', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='leader', full_name='PartitionMetadata.leader', index=1,
+      number=2, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='replicas', full_name='PartitionMetadata.replicas', index=2,
+      number=3, type=5, cpp_type=1, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='isr', full_name='PartitionMetadata.isr', index=3,
+      number=4, type=5, cpp_type=1, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1847,
+  serialized_end=1932,
+)
+
+
+_GETTOPICMETADATARQ = _descriptor.Descriptor(
+  name='GetTopicMetadataRq',
+  full_name='GetTopicMetadataRq',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='cluster', full_name='GetTopicMetadataRq.cluster', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='topic', full_name='GetTopicMetadataRq.topic', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='with_partitions', full_name='GetTopicMetadataRq.with_partitions', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1934,
+  serialized_end=2011,
+)
+
+
+_GETTOPICMETADATARS_CONFIGENTRY = _descriptor.Descriptor(
+  name='ConfigEntry',
+  full_name='GetTopicMetadataRs.ConfigEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='GetTopicMetadataRs.ConfigEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='GetTopicMetadataRs.ConfigEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=b'8\001',
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2142,
+  serialized_end=2187,
+)
+
+_GETTOPICMETADATARS = _descriptor.Descriptor(
+  name='GetTopicMetadataRs',
+  full_name='GetTopicMetadataRs',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='version', full_name='GetTopicMetadataRs.version', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='config', full_name='GetTopicMetadataRs.config', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='partitions', full_name='GetTopicMetadataRs.partitions', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[_GETTOPICMETADATARS_CONFIGENTRY, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2014,
+  serialized_end=2187,
+)
+
+
+_LISTTOPICRS_TOPICSENTRY = _descriptor.Descriptor(
+  name='TopicsEntry',
+  full_name='ListTopicRs.TopicsEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='ListTopicRs.TopicsEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='ListTopicRs.TopicsEntry.value', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=b'8\001',
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2246,
+  serialized_end=2312,
+)
+
+_LISTTOPICRS = _descriptor.Descriptor(
+  name='ListTopicRs',
+  full_name='ListTopicRs',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='topics', full_name='ListTopicRs.topics', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[_LISTTOPICRS_TOPICSENTRY, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2189,
+  serialized_end=2312,
+)
+
+
+_LISTTOPICRQ = _descriptor.Descriptor(
+  name='ListTopicRq',
+  full_name='ListTopicRq',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='cluster', full_name='ListTopicRq.cluster', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='with_partitions', full_name='ListTopicRq.with_partitions', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2314,
+  serialized_end=2369,
+)
+
+
+_LISTCONSUMERSRQ = _descriptor.Descriptor(
+  name='ListConsumersRq',
+  full_name='ListConsumersRq',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='cluster', full_name='ListConsumersRq.cluster', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='topic', full_name='ListConsumersRq.topic', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='group', full_name='ListConsumersRq.group', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2371,
+  serialized_end=2435,
+)
+
+
+_CONSUMERPARTITIONS = _descriptor.Descriptor(
+  name='ConsumerPartitions',
+  full_name='ConsumerPartitions',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='partitions', full_name='ConsumerPartitions.partitions', index=0,
+      number=1, type=5, cpp_type=1, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='client_id', full_name='ConsumerPartitions.client_id', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='client_host', full_name='ConsumerPartitions.client_host', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2437,
+  serialized_end=2517,
+)
+
+
+_CONSUMERGROUPS_CONSUMERSENTRY = _descriptor.Descriptor(
+  name='ConsumersEntry',
+  full_name='ConsumerGroups.ConsumersEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='ConsumerGroups.ConsumersEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='ConsumerGroups.ConsumersEntry.value', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=b'8\001',
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2653,
+  serialized_end=2722,
+)
+
+_CONSUMERGROUPS = _descriptor.Descriptor(
+  name='ConsumerGroups',
+  full_name='ConsumerGroups',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='consumers', full_name='ConsumerGroups.consumers', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='native', full_name='ConsumerGroups.native', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='state', full_name='ConsumerGroups.state', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='protocol', full_name='ConsumerGroups.protocol', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='mixed', full_name='ConsumerGroups.mixed', index=4,
+      number=5, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[_CONSUMERGROUPS_CONSUMERSENTRY, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2520,
+  serialized_end=2722,
+)
+
+
+_LISTCONSUMERSRS_GROUPSENTRY = _descriptor.Descriptor(
+  name='GroupsEntry',
+  full_name='ListConsumersRs.GroupsEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='ListConsumersRs.GroupsEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='ListConsumersRs.GroupsEntry.value', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=b'8\001',
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2789,
+  serialized_end=2851,
+)
+
+_LISTCONSUMERSRS = _descriptor.Descriptor(
+  name='ListConsumersRs',
+  full_name='ListConsumersRs',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='groups', full_name='ListConsumersRs.groups', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[_LISTCONSUMERSRS_GROUPSENTRY, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2724,
+  serialized_end=2851,
+)
+
+
+_SETOFFSETSRQ = _descriptor.Descriptor(
+  name='SetOffsetsRq',
+  full_name='SetOffsetsRq',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='cluster', full_name='SetOffsetsRq.cluster', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='topic', full_name='SetOffsetsRq.topic', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='group', full_name='SetOffsetsRq.group', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='offsets', full_name='SetOffsetsRq.offsets', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2853,
+  serialized_end=2949,
+)
+
+
+_SETOFFSETSRS = _descriptor.Descriptor(
+  name='SetOffsetsRs',
+  full_name='SetOffsetsRs',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2951,
+  serialized_end=2965,
+)
+
+
+_CREATETOPICRQ_CONFIGENTRY = _descriptor.Descriptor(
+  name='ConfigEntry',
+  full_name='CreateTopicRq.ConfigEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='CreateTopicRq.ConfigEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='CreateTopicRq.ConfigEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=b'8\001',
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3132,
+  serialized_end=3177,
+)
+
+_CREATETOPICRQ = _descriptor.Descriptor(
+  name='CreateTopicRq',
+  full_name='CreateTopicRq',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='cluster', full_name='CreateTopicRq.cluster', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='topic', full_name='CreateTopicRq.topic', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='partitions', full_name='CreateTopicRq.partitions', index=2,
+      number=3, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='replication_factor', full_name='CreateTopicRq.replication_factor', index=3,
+      number=4, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='config', full_name='CreateTopicRq.config', index=4,
+      number=5, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='validate_only', full_name='CreateTopicRq.validate_only', index=5,
+      number=6, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[_CREATETOPICRQ_CONFIGENTRY, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2968,
+  serialized_end=3177,
+)
+
+
+_CREATETOPICRS = _descriptor.Descriptor(
+  name='CreateTopicRs',
+  full_name='CreateTopicRs',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3179,
+  serialized_end=3194,
+)
+
+
+_DELETETOPICRQ = _descriptor.Descriptor(
+  name='DeleteTopicRq',
+  full_name='DeleteTopicRq',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='cluster', full_name='DeleteTopicRq.cluster', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='topic', full_name='DeleteTopicRq.topic', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3196,
+  serialized_end=3243,
+)
+
+
+_DELETETOPICRS = _descriptor.Descriptor(
+  name='DeleteTopicRs',
+  full_name='DeleteTopicRs',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3245,
+  serialized_end=3260,
+)
+
+
+_ALTERTOPICCONFIGRQ_SETENTRY = _descriptor.Descriptor(
+  name='SetEntry',
+  full_name='AlterTopicConfigRq.SetEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='AlterTopicConfigRq.SetEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='AlterTopicConfigRq.SetEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=b'8\001',
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3399,
+  serialized_end=3441,
+)
+
+_ALTERTOPICCONFIGRQ = _descriptor.Descriptor(
+  name='AlterTopicConfigRq',
+  full_name='AlterTopicConfigRq',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='cluster', full_name='AlterTopicConfigRq.cluster', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='topic', full_name='AlterTopicConfigRq.topic', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='set', full_name='AlterTopicConfigRq.set', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='delete', full_name='AlterTopicConfigRq.delete', index=3,
+      number=4, type=9, cpp_type=9, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='validate_only', full_name='AlterTopicConfigRq.validate_only', index=4,
+      number=5, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[_ALTERTOPICCONFIGRQ_SETENTRY, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3263,
+  serialized_end=3441,
+)
+
+
+_ALTERTOPICCONFIGRS = _descriptor.Descriptor(
+  name='AlterTopicConfigRs',
+  full_name='AlterTopicConfigRs',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3443,
+  serialized_end=3463,
+)
+
+
+_ADDPARTITIONSRQ = _descriptor.Descriptor(
+  name='AddPartitionsRq',
+  full_name='AddPartitionsRq',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='cluster', full_name='AddPartitionsRq.cluster', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='topic', full_name='AddPartitionsRq.topic', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='count', full_name='AddPartitionsRq.count', index=2,
+      number=3, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='validate_only', full_name='AddPartitionsRq.validate_only', index=3,
+      number=4, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3465,
+  serialized_end=3552,
+)
+
+
+_ADDPARTITIONSRS = _descriptor.Descriptor(
+  name='AddPartitionsRs',
+  full_name='AddPartitionsRs',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3554,
+  serialized_end=3571,
+)
+
+
+_TOPICPARTITION = _descriptor.Descriptor(
+  name='TopicPartition',
+  full_name='TopicPartition',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='topic', full_name='TopicPartition.topic', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='partition', full_name='TopicPartition.partition', index=1,
+      number=2, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -557,47 +2144,40 @@ _GETOFFSETSRS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=851,
-  serialized_end=900,
+  serialized_start=3573,
+  serialized_end=3623,
 )
 
 
-_PARTITIONMETADATA = _descriptor.Descriptor(
-  name='PartitionMetadata',
-  full_name='PartitionMetadata',
+_PARTITIONREASSIGNMENT = _descriptor.Descriptor(
+  name='PartitionReassignment',
+  full_name='PartitionReassignment',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='partition', full_name='PartitionMetadata.partition', index=0,
-      number=1, type=5, cpp_type=1, label=1,
-      has_default_value=False, default_value=0,
+      name='topic', full_name='PartitionReassignment.topic', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='leader', full_name='PartitionMetadata.leader', index=1,
+      name='partition', full_name='PartitionReassignment.partition', index=1,
       number=2, type=5, cpp_type=1, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='replicas', full_name='PartitionMetadata.replicas', index=2,
+      name='replicas', full_name='PartitionReassignment.replicas', index=2,
       number=3, type=5, cpp_type=1, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
-    _descriptor.FieldDescriptor(
-      name='isr', full_name='PartitionMetadata.isr', index=3,
-      number=4, type=5, cpp_type=1, label=3,
-      has_default_value=False, default_value=[],
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -610,36 +2190,64 @@ _PARTITIONMETADATA = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=902,
-  serialized_end=987,
+  serialized_start=3625,
+  serialized_end=3700,
 )
 
 
-_GETTOPICMETADATARQ = _descriptor.Descriptor(
-  name='GetTopicMetadataRq',
-  full_name='GetTopicMetadataRq',
+_PARTITIONPROGRESS = _descriptor.Descriptor(
+  name='PartitionProgress',
+  full_name='PartitionProgress',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='cluster', full_name='GetTopicMetadataRq.cluster', index=0,
+      name='topic', full_name='PartitionProgress.topic', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='topic', full_name='GetTopicMetadataRq.topic', index=1,
-      number=2, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=b"".decode('utf-8'),
+      name='partition', full_name='PartitionProgress.partition', index=1,
+      number=2, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='with_partitions', full_name='GetTopicMetadataRq.with_partitions', index=2,
-      number=3, type=8, cpp_type=7, label=1,
+      name='target', full_name='PartitionProgress.target', index=2,
+      number=3, type=5, cpp_type=1, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='leader', full_name='PartitionProgress.leader', index=3,
+      number=4, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='replicas', full_name='PartitionProgress.replicas', index=4,
+      number=5, type=5, cpp_type=1, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='isr', full_name='PartitionProgress.isr', index=5,
+      number=6, type=5, cpp_type=1, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='done', full_name='PartitionProgress.done', index=6,
+      number=7, type=8, cpp_type=7, label=1,
       has_default_value=False, default_value=False,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
@@ -656,30 +2264,30 @@ _GETTOPICMETADATARQ = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=989,
-  serialized_end=1066,
+  serialized_start=3703,
+  serialized_end=3833,
 )
 
 
-_GETTOPICMETADATARS_CONFIGENTRY = _descriptor.Descriptor(
-  name='ConfigEntry',
-  full_name='GetTopicMetadataRs.ConfigEntry',
+_ELECTPREFERREDLEADERSRQ = _descriptor.Descriptor(
+  name='ElectPreferredLeadersRq',
+  full_name='ElectPreferredLeadersRq',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='key', full_name='GetTopicMetadataRs.ConfigEntry.key', index=0,
+      name='cluster', full_name='ElectPreferredLeadersRq.cluster', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='value', full_name='GetTopicMetadataRs.ConfigEntry.value', index=1,
-      number=2, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=b"".decode('utf-8'),
+      name='partitions', full_name='ElectPreferredLeadersRq.partitions', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
@@ -689,41 +2297,28 @@ _GETTOPICMETADATARS_CONFIGENTRY = _descriptor.Descriptor(
   nested_types=[],
   enum_types=[
   ],
-  serialized_options=b'8\001',
+  serialized_options=None,
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1197,
-  serialized_end=1242,
+  serialized_start=3835,
+  serialized_end=3914,
 )
 
-_GETTOPICMETADATARS = _descriptor.Descriptor(
-  name='GetTopicMetadataRs',
-  full_name='GetTopicMetadataRs',
+
+_ELECTPREFERREDLEADERSRS = _descriptor.Descriptor(
+  name='ElectPreferredLeadersRs',
+  full_name='ElectPreferredLeadersRs',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='version', full_name='GetTopicMetadataRs.version', index=0,
-      number=1, type=5, cpp_type=1, label=1,
-      has_default_value=False, default_value=0,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
-    _descriptor.FieldDescriptor(
-      name='config', full_name='GetTopicMetadataRs.config', index=1,
-      number=2, type=11, cpp_type=10, label=3,
-      has_default_value=False, default_value=[],
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
-    _descriptor.FieldDescriptor(
-      name='partitions', full_name='GetTopicMetadataRs.partitions', index=2,
-      number=3, type=11, cpp_type=10, label=3,
+      name='partitions', full_name='ElectPreferredLeadersRs.partitions', index=0,
+      number=1, type=11, cpp_type=10, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
@@ -731,7 +2326,7 @@ _GETTOPICMETADATARS = _descriptor.Descriptor(
   ],
   extensions=[
   ],
-  nested_types=[_GETTOPICMETADATARS_CONFIGENTRY, ],
+  nested_types=[],
   enum_types=[
   ],
   serialized_options=None,
@@ -740,30 +2335,30 @@ _GETTOPICMETADATARS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1069,
-  serialized_end=1242,
+  serialized_start=3916,
+  serialized_end=3981,
 )
 
 
-_LISTTOPICRS_TOPICSENTRY = _descriptor.Descriptor(
-  name='TopicsEntry',
-  full_name='ListTopicRs.TopicsEntry',
+_REASSIGNPARTITIONSRQ = _descriptor.Descriptor(
+  name='ReassignPartitionsRq',
+  full_name='ReassignPartitionsRq',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='key', full_name='ListTopicRs.TopicsEntry.key', index=0,
+      name='cluster', full_name='ReassignPartitionsRq.cluster', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='value', full_name='ListTopicRs.TopicsEntry.value', index=1,
-      number=2, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='reassignments', full_name='ReassignPartitionsRq.reassignments', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
@@ -773,26 +2368,27 @@ _LISTTOPICRS_TOPICSENTRY = _descriptor.Descriptor(
   nested_types=[],
   enum_types=[
   ],
-  serialized_options=b'8\001',
+  serialized_options=None,
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1301,
-  serialized_end=1367,
+  serialized_start=3983,
+  serialized_end=4069,
 )
 
-_LISTTOPICRS = _descriptor.Descriptor(
-  name='ListTopicRs',
-  full_name='ListTopicRs',
+
+_REASSIGNPARTITIONSRS = _descriptor.Descriptor(
+  name='ReassignPartitionsRs',
+  full_name='ReassignPartitionsRs',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='topics', full_name='ListTopicRs.topics', index=0,
+      name='partitions', full_name='ReassignPartitionsRs.partitions', index=0,
       number=1, type=11, cpp_type=10, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
@@ -801,7 +2397,7 @@ _LISTTOPICRS = _descriptor.Descriptor(
   ],
   extensions=[
   ],
-  nested_types=[_LISTTOPICRS_TOPICSENTRY, ],
+  nested_types=[],
   enum_types=[
   ],
   serialized_options=None,
@@ -810,33 +2406,26 @@ _LISTTOPICRS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1244,
-  serialized_end=1367,
+  serialized_start=4071,
+  serialized_end=4133,
 )
 
 
-_LISTTOPICRQ = _descriptor.Descriptor(
-  name='ListTopicRq',
-  full_name='ListTopicRq',
+_GETREASSIGNMENTSRQ = _descriptor.Descriptor(
+  name='GetReassignmentsRq',
+  full_name='GetReassignmentsRq',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='cluster', full_name='ListTopicRq.cluster', index=0,
+      name='cluster', full_name='GetReassignmentsRq.cluster', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
-    _descriptor.FieldDescriptor(
-      name='with_partitions', full_name='ListTopicRq.with_partitions', index=1,
-      number=2, type=8, cpp_type=7, label=1,
-      has_default_value=False, default_value=False,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -849,37 +2438,23 @@ _LISTTOPICRQ = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1369,
-  serialized_end=1424,
+  serialized_start=4135,
+  serialized_end=4172,
 )
 
 
-_LISTCONSUMERSRQ = _descriptor.Descriptor(
-  name='ListConsumersRq',
-  full_name='ListConsumersRq',
+_GETREASSIGNMENTSRS = _descriptor.Descriptor(
+  name='GetReassignmentsRs',
+  full_name='GetReassignmentsRs',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='cluster', full_name='ListConsumersRq.cluster', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=b"".decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
-    _descriptor.FieldDescriptor(
-      name='topic', full_name='ListConsumersRq.topic', index=1,
-      number=2, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=b"".decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
-    _descriptor.FieldDescriptor(
-      name='group', full_name='ListConsumersRq.group', index=2,
-      number=3, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=b"".decode('utf-8'),
+      name='partitions', full_name='GetReassignmentsRs.partitions', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
@@ -895,23 +2470,51 @@ _LISTCONSUMERSRQ = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1426,
-  serialized_end=1490,
+  serialized_start=4174,
+  serialized_end=4234,
 )
 
 
-_CONSUMERPARTITIONS = _descriptor.Descriptor(
-  name='ConsumerPartitions',
-  full_name='ConsumerPartitions',
+_BROKERMETADATA = _descriptor.Descriptor(
+  name='BrokerMetadata',
+  full_name='BrokerMetadata',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='partitions', full_name='ConsumerPartitions.partitions', index=0,
-      number=1, type=5, cpp_type=1, label=3,
-      has_default_value=False, default_value=[],
+      name='id', full_name='BrokerMetadata.id', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='addr', full_name='BrokerMetadata.addr', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='rack', full_name='BrokerMetadata.rack', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='connected', full_name='BrokerMetadata.connected', index=3,
+      number=4, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='error', full_name='BrokerMetadata.error', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
@@ -927,68 +2530,83 @@ _CONSUMERPARTITIONS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1492,
-  serialized_end=1532,
+  serialized_start=4236,
+  serialized_end=4326,
 )
 
 
-_CONSUMERGROUPS_CONSUMERSENTRY = _descriptor.Descriptor(
-  name='ConsumersEntry',
-  full_name='ConsumerGroups.ConsumersEntry',
+_DESCRIBECLUSTERRQ = _descriptor.Descriptor(
+  name='DescribeClusterRq',
+  full_name='DescribeClusterRq',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='key', full_name='ConsumerGroups.ConsumersEntry.key', index=0,
+      name='cluster', full_name='DescribeClusterRq.cluster', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
-    _descriptor.FieldDescriptor(
-      name='value', full_name='ConsumerGroups.ConsumersEntry.value', index=1,
-      number=2, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
   nested_types=[],
   enum_types=[
   ],
-  serialized_options=b'8\001',
+  serialized_options=None,
   is_extendable=False,
   syntax='proto3',
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1604,
-  serialized_end=1673,
+  serialized_start=4328,
+  serialized_end=4364,
 )
 
-_CONSUMERGROUPS = _descriptor.Descriptor(
-  name='ConsumerGroups',
-  full_name='ConsumerGroups',
+
+_DESCRIBECLUSTERRS = _descriptor.Descriptor(
+  name='DescribeClusterRs',
+  full_name='DescribeClusterRs',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='consumers', full_name='ConsumerGroups.consumers', index=0,
-      number=1, type=11, cpp_type=10, label=3,
+      name='controller_id', full_name='DescribeClusterRs.controller_id', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='brokers', full_name='DescribeClusterRs.brokers', index=1,
+      number=2, type=11, cpp_type=10, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='under_replicated_partitions', full_name='DescribeClusterRs.under_replicated_partitions', index=2,
+      number=3, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='offline_partitions', full_name='DescribeClusterRs.offline_partitions', index=3,
+      number=4, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
-  nested_types=[_CONSUMERGROUPS_CONSUMERSENTRY, ],
+  nested_types=[],
   enum_types=[
   ],
   serialized_options=None,
@@ -997,68 +2615,58 @@ _CONSUMERGROUPS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1535,
-  serialized_end=1673,
+  serialized_start=4367,
+  serialized_end=4508,
 )
 
 
-_LISTCONSUMERSRS_GROUPSENTRY = _descriptor.Descriptor(
-  name='GroupsEntry',
-  full_name='ListConsumersRs.GroupsEntry',
+_BROWSEMESSAGESRQ = _descriptor.Descriptor(
+  name='BrowseMessagesRq',
+  full_name='BrowseMessagesRq',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='key', full_name='ListConsumersRs.GroupsEntry.key', index=0,
+      name='cluster', full_name='BrowseMessagesRq.cluster', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='value', full_name='ListConsumersRs.GroupsEntry.value', index=1,
-      number=2, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='topic', full_name='BrowseMessagesRq.topic', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
-  ],
-  extensions=[
-  ],
-  nested_types=[],
-  enum_types=[
-  ],
-  serialized_options=b'8\001',
-  is_extendable=False,
-  syntax='proto3',
-  extension_ranges=[],
-  oneofs=[
-  ],
-  serialized_start=1740,
-  serialized_end=1802,
-)
-
-_LISTCONSUMERSRS = _descriptor.Descriptor(
-  name='ListConsumersRs',
-  full_name='ListConsumersRs',
-  filename=None,
-  file=DESCRIPTOR,
-  containing_type=None,
-  create_key=_descriptor._internal_create_key,
-  fields=[
     _descriptor.FieldDescriptor(
-      name='groups', full_name='ListConsumersRs.groups', index=0,
-      number=1, type=11, cpp_type=10, label=3,
-      has_default_value=False, default_value=[],
+      name='partition', full_name='BrowseMessagesRq.partition', index=2,
+      number=3, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='from', full_name='BrowseMessagesRq.from', index=3,
+      number=4, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='count', full_name='BrowseMessagesRq.count', index=4,
+      number=5, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
-  nested_types=[_LISTCONSUMERSRS_GROUPSENTRY, ],
+  nested_types=[],
   enum_types=[
   ],
   serialized_options=None,
@@ -1067,47 +2675,75 @@ _LISTCONSUMERSRS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1675,
-  serialized_end=1802,
+  serialized_start=4510,
+  serialized_end=4608,
 )
 
 
-_SETOFFSETSRQ = _descriptor.Descriptor(
-  name='SetOffsetsRq',
-  full_name='SetOffsetsRq',
+_BROWSEDMESSAGE = _descriptor.Descriptor(
+  name='BrowsedMessage',
+  full_name='BrowsedMessage',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
     _descriptor.FieldDescriptor(
-      name='cluster', full_name='SetOffsetsRq.cluster', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=b"".decode('utf-8'),
+      name='offset', full_name='BrowsedMessage.offset', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='topic', full_name='SetOffsetsRq.topic', index=1,
-      number=2, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=b"".decode('utf-8'),
+      name='key_value', full_name='BrowsedMessage.key_value', index=1,
+      number=2, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"",
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='group', full_name='SetOffsetsRq.group', index=2,
-      number=3, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=b"".decode('utf-8'),
+      name='key_undefined', full_name='BrowsedMessage.key_undefined', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='offsets', full_name='SetOffsetsRq.offsets', index=3,
-      number=4, type=11, cpp_type=10, label=3,
+      name='message', full_name='BrowsedMessage.message', index=3,
+      number=4, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"",
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='headers', full_name='BrowsedMessage.headers', index=4,
+      number=5, type=11, cpp_type=10, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='timestamp', full_name='BrowsedMessage.timestamp', index=5,
+      number=6, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='timestamp_type', full_name='BrowsedMessage.timestamp_type', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='preview', full_name='BrowsedMessage.preview', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -1120,19 +2756,47 @@ _SETOFFSETSRQ = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1804,
-  serialized_end=1900,
+  serialized_start=4611,
+  serialized_end=4794,
 )
 
 
-_SETOFFSETSRS = _descriptor.Descriptor(
-  name='SetOffsetsRs',
-  full_name='SetOffsetsRs',
+_BROWSEMESSAGESRS = _descriptor.Descriptor(
+  name='BrowseMessagesRs',
+  full_name='BrowseMessagesRs',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   create_key=_descriptor._internal_create_key,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='messages', full_name='BrowseMessagesRs.messages', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='next_offset', full_name='BrowseMessagesRs.next_offset', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='begin', full_name='BrowseMessagesRs.begin', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='end', full_name='BrowseMessagesRs.end', index=3,
+      number=4, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -1145,11 +2809,17 @@ _SETOFFSETSRS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1902,
-  serialized_end=1916,
+  serialized_start=4796,
+  serialized_end=4898,
 )
 
 _PRODRQ.fields_by_name['headers'].message_type = _RECORDHEADER
+_PRODMSG.fields_by_name['headers'].message_type = _RECORDHEADER
+_PRODATOMICRQ.fields_by_name['messages'].message_type = _PRODMSG
+_PRODATOMICRS.fields_by_name['results'].message_type = _PRODRS
+_PRODBATCHRQ.fields_by_name['messages'].message_type = _PRODMSG
+_PRODBATCHRS.fields_by_name['results'].message_type = _PRODBATCHRESULT
+_PRODSTREAMRQ.fields_by_name['message'].message_type = _PRODMSG
 _CONSRS.fields_by_name['headers'].message_type = _RECORDHEADER
 _GETOFFSETSRS.fields_by_name['offsets'].message_type = _PARTITIONOFFSET
 _GETTOPICMETADATARS_CONFIGENTRY.containing_type = _GETTOPICMETADATARS
@@ -1165,9 +2835,31 @@ _LISTCONSUMERSRS_GROUPSENTRY.fields_by_name['value'].message_type = _CONSUMERGRO
 _LISTCONSUMERSRS_GROUPSENTRY.containing_type = _LISTCONSUMERSRS
 _LISTCONSUMERSRS.fields_by_name['groups'].message_type = _LISTCONSUMERSRS_GROUPSENTRY
 _SETOFFSETSRQ.fields_by_name['offsets'].message_type = _PARTITIONOFFSET
+_CREATETOPICRQ_CONFIGENTRY.containing_type = _CREATETOPICRQ
+_CREATETOPICRQ.fields_by_name['config'].message_type = _CREATETOPICRQ_CONFIGENTRY
+_ALTERTOPICCONFIGRQ_SETENTRY.containing_type = _ALTERTOPICCONFIGRQ
+_ALTERTOPICCONFIGRQ.fields_by_name['set'].message_type = _ALTERTOPICCONFIGRQ_SETENTRY
+_ELECTPREFERREDLEADERSRQ.fields_by_name['partitions'].message_type = _TOPICPARTITION
+_ELECTPREFERREDLEADERSRS.fields_by_name['partitions'].message_type = _PARTITIONPROGRESS
+_REASSIGNPARTITIONSRQ.fields_by_name['reassignments'].message_type = _PARTITIONREASSIGNMENT
+_REASSIGNPARTITIONSRS.fields_by_name['partitions'].message_type = _PARTITIONPROGRESS
+_GETREASSIGNMENTSRS.fields_by_name['partitions'].message_type = _PARTITIONPROGRESS
+_DESCRIBECLUSTERRS.fields_by_name['brokers'].message_type = _BROKERMETADATA
+_BROWSEDMESSAGE.fields_by_name['headers'].message_type = _RECORDHEADER
+_BROWSEMESSAGESRS.fields_by_name['messages'].message_type = _BROWSEDMESSAGE
 DESCRIPTOR.message_types_by_name['RecordHeader'] = _RECORDHEADER
 DESCRIPTOR.message_types_by_name['ProdRq'] = _PRODRQ
 DESCRIPTOR.message_types_by_name['ProdRs'] = _PRODRS
+DESCRIPTOR.message_types_by_name['ProdMsg'] = _PRODMSG
+DESCRIPTOR.message_types_by_name['ProdAtomicRq'] = _PRODATOMICRQ
+DESCRIPTOR.message_types_by_name['ProdAtomicRs'] = _PRODATOMICRS
+DESCRIPTOR.message_types_by_name['ProdBatchRq'] = _PRODBATCHRQ
+DESCRIPTOR.message_types_by_name['ProdBatchResult'] = _PRODBATCHRESULT
+DESCRIPTOR.message_types_by_name['ProdBatchRs'] = _PRODBATCHRS
+DESCRIPTOR.message_types_by_name['ProdStreamRq'] = _PRODSTREAMRQ
+DESCRIPTOR.message_types_by_name['ProdStreamRs'] = _PRODSTREAMRS
+DESCRIPTOR.message_types_by_name['DeliveryReportsRq'] = _DELIVERYREPORTSRQ
+DESCRIPTOR.message_types_by_name['DeliveryReport'] = _DELIVERYREPORT
 DESCRIPTOR.message_types_by_name['ConsNAckRq'] = _CONSNACKRQ
 DESCRIPTOR.message_types_by_name['ConsRs'] = _CONSRS
 DESCRIPTOR.message_types_by_name['AckRq'] = _ACKRQ
@@ -1186,6 +2878,29 @@ DESCRIPTOR.message_types_by_name['ConsumerGroups'] = _CONSUMERGROUPS
 DESCRIPTOR.message_types_by_name['ListConsumersRs'] = _LISTCONSUMERSRS
 DESCRIPTOR.message_types_by_name['SetOffsetsRq'] = _SETOFFSETSRQ
 DESCRIPTOR.message_types_by_name['SetOffsetsRs'] = _SETOFFSETSRS
+DESCRIPTOR.message_types_by_name['CreateTopicRq'] = _CREATETOPICRQ
+DESCRIPTOR.message_types_by_name['CreateTopicRs'] = _CREATETOPICRS
+DESCRIPTOR.message_types_by_name['DeleteTopicRq'] = _DELETETOPICRQ
+DESCRIPTOR.message_types_by_name['DeleteTopicRs'] = _DELETETOPICRS
+DESCRIPTOR.message_types_by_name['AlterTopicConfigRq'] = _ALTERTOPICCONFIGRQ
+DESCRIPTOR.message_types_by_name['AlterTopicConfigRs'] = _ALTERTOPICCONFIGRS
+DESCRIPTOR.message_types_by_name['AddPartitionsRq'] = _ADDPARTITIONSRQ
+DESCRIPTOR.message_types_by_name['AddPartitionsRs'] = _ADDPARTITIONSRS
+DESCRIPTOR.message_types_by_name['TopicPartition'] = _TOPICPARTITION
+DESCRIPTOR.message_types_by_name['PartitionReassignment'] = _PARTITIONREASSIGNMENT
+DESCRIPTOR.message_types_by_name['PartitionProgress'] = _PARTITIONPROGRESS
+DESCRIPTOR.message_types_by_name['ElectPreferredLeadersRq'] = _ELECTPREFERREDLEADERSRQ
+DESCRIPTOR.message_types_by_name['ElectPreferredLeadersRs'] = _ELECTPREFERREDLEADERSRS
+DESCRIPTOR.message_types_by_name['ReassignPartitionsRq'] = _REASSIGNPARTITIONSRQ
+DESCRIPTOR.message_types_by_name['ReassignPartitionsRs'] = _REASSIGNPARTITIONSRS
+DESCRIPTOR.message_types_by_name['GetReassignmentsRq'] = _GETREASSIGNMENTSRQ
+DESCRIPTOR.message_types_by_name['GetReassignmentsRs'] = _GETREASSIGNMENTSRS
+DESCRIPTOR.message_types_by_name['BrokerMetadata'] = _BROKERMETADATA
+DESCRIPTOR.message_types_by_name['DescribeClusterRq'] = _DESCRIBECLUSTERRQ
+DESCRIPTOR.message_types_by_name['DescribeClusterRs'] = _DESCRIBECLUSTERRS
+DESCRIPTOR.message_types_by_name['BrowseMessagesRq'] = _BROWSEMESSAGESRQ
+DESCRIPTOR.message_types_by_name['BrowsedMessage'] = _BROWSEDMESSAGE
+DESCRIPTOR.message_types_by_name['BrowseMessagesRs'] = _BROWSEMESSAGESRS
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
 RecordHeader = _reflection.GeneratedProtocolMessageType('RecordHeader', (_message.Message,), {
@@ -1209,6 +2924,76 @@ ProdRs = _reflection.GeneratedProtocolMessageType('ProdRs', (_message.Message,),
   })
 _sym_db.RegisterMessage(ProdRs)
 
+ProdMsg = _reflection.GeneratedProtocolMessageType('ProdMsg', (_message.Message,), {
+  'DESCRIPTOR' : _PRODMSG,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:ProdMsg)
+  })
+_sym_db.RegisterMessage(ProdMsg)
+
+ProdAtomicRq = _reflection.GeneratedProtocolMessageType('ProdAtomicRq', (_message.Message,), {
+  'DESCRIPTOR' : _PRODATOMICRQ,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:ProdAtomicRq)
+  })
+_sym_db.RegisterMessage(ProdAtomicRq)
+
+ProdAtomicRs = _reflection.GeneratedProtocolMessageType('ProdAtomicRs', (_message.Message,), {
+  'DESCRIPTOR' : _PRODATOMICRS,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:ProdAtomicRs)
+  })
+_sym_db.RegisterMessage(ProdAtomicRs)
+
+ProdBatchRq = _reflection.GeneratedProtocolMessageType('ProdBatchRq', (_message.Message,), {
+  'DESCRIPTOR' : _PRODBATCHRQ,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:ProdBatchRq)
+  })
+_sym_db.RegisterMessage(ProdBatchRq)
+
+ProdBatchResult = _reflection.GeneratedProtocolMessageType('ProdBatchResult', (_message.Message,), {
+  'DESCRIPTOR' : _PRODBATCHRESULT,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:ProdBatchResult)
+  })
+_sym_db.RegisterMessage(ProdBatchResult)
+
+ProdBatchRs = _reflection.GeneratedProtocolMessageType('ProdBatchRs', (_message.Message,), {
+  'DESCRIPTOR' : _PRODBATCHRS,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:ProdBatchRs)
+  })
+_sym_db.RegisterMessage(ProdBatchRs)
+
+ProdStreamRq = _reflection.GeneratedProtocolMessageType('ProdStreamRq', (_message.Message,), {
+  'DESCRIPTOR' : _PRODSTREAMRQ,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:ProdStreamRq)
+  })
+_sym_db.RegisterMessage(ProdStreamRq)
+
+ProdStreamRs = _reflection.GeneratedProtocolMessageType('ProdStreamRs', (_message.Message,), {
+  'DESCRIPTOR' : _PRODSTREAMRS,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:ProdStreamRs)
+  })
+_sym_db.RegisterMessage(ProdStreamRs)
+
+DeliveryReportsRq = _reflection.GeneratedProtocolMessageType('DeliveryReportsRq', (_message.Message,), {
+  'DESCRIPTOR' : _DELIVERYREPORTSRQ,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:DeliveryReportsRq)
+  })
+_sym_db.RegisterMessage(DeliveryReportsRq)
+
+DeliveryReport = _reflection.GeneratedProtocolMessageType('DeliveryReport', (_message.Message,), {
+  'DESCRIPTOR' : _DELIVERYREPORT,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:DeliveryReport)
+  })
+_sym_db.RegisterMessage(DeliveryReport)
+
 ConsNAckRq = _reflection.GeneratedProtocolMessageType('ConsNAckRq', (_message.Message,), {
   'DESCRIPTOR' : _CONSNACKRQ,
   '__module__' : 'kafkapixy_pb2'
@@ -1367,12 +3152,191 @@ SetOffsetsRs = _reflection.GeneratedProtocolMessageType('SetOffsetsRs', (_messag
   })
 _sym_db.RegisterMessage(SetOffsetsRs)
 
+CreateTopicRq = _reflection.GeneratedProtocolMessageType('CreateTopicRq', (_message.Message,), {
+
+  'ConfigEntry' : _reflection.GeneratedProtocolMessageType('ConfigEntry', (_message.Message,), {
+    'DESCRIPTOR' : _CREATETOPICRQ_CONFIGENTRY,
+    '__module__' : 'kafkapixy_pb2'
+    # @@protoc_insertion_point(class_scope:CreateTopicRq.ConfigEntry)
+    })
+  ,
+  'DESCRIPTOR' : _CREATETOPICRQ,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:CreateTopicRq)
+  })
+_sym_db.RegisterMessage(CreateTopicRq)
+_sym_db.RegisterMessage(CreateTopicRq.ConfigEntry)
+
+CreateTopicRs = _reflection.GeneratedProtocolMessageType('CreateTopicRs', (_message.Message,), {
+  'DESCRIPTOR' : _CREATETOPICRS,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:CreateTopicRs)
+  })
+_sym_db.RegisterMessage(CreateTopicRs)
+
+DeleteTopicRq = _reflection.GeneratedProtocolMessageType('DeleteTopicRq', (_message.Message,), {
+  'DESCRIPTOR' : _DELETETOPICRQ,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:DeleteTopicRq)
+  })
+_sym_db.RegisterMessage(DeleteTopicRq)
+
+DeleteTopicRs = _reflection.GeneratedProtocolMessageType('DeleteTopicRs', (_message.Message,), {
+  'DESCRIPTOR' : _DELETETOPICRS,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:DeleteTopicRs)
+  })
+_sym_db.RegisterMessage(DeleteTopicRs)
+
+AlterTopicConfigRq = _reflection.GeneratedProtocolMessageType('AlterTopicConfigRq', (_message.Message,), {
+
+  'SetEntry' : _reflection.GeneratedProtocolMessageType('SetEntry', (_message.Message,), {
+    'DESCRIPTOR' : _ALTERTOPICCONFIGRQ_SETENTRY,
+    '__module__' : 'kafkapixy_pb2'
+    # @@protoc_insertion_point(class_scope:AlterTopicConfigRq.SetEntry)
+    })
+  ,
+  'DESCRIPTOR' : _ALTERTOPICCONFIGRQ,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:AlterTopicConfigRq)
+  })
+_sym_db.RegisterMessage(AlterTopicConfigRq)
+_sym_db.RegisterMessage(AlterTopicConfigRq.SetEntry)
+
+AlterTopicConfigRs = _reflection.GeneratedProtocolMessageType('AlterTopicConfigRs', (_message.Message,), {
+  'DESCRIPTOR' : _ALTERTOPICCONFIGRS,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:AlterTopicConfigRs)
+  })
+_sym_db.RegisterMessage(AlterTopicConfigRs)
+
+AddPartitionsRq = _reflection.GeneratedProtocolMessageType('AddPartitionsRq', (_message.Message,), {
+  'DESCRIPTOR' : _ADDPARTITIONSRQ,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:AddPartitionsRq)
+  })
+_sym_db.RegisterMessage(AddPartitionsRq)
+
+AddPartitionsRs = _reflection.GeneratedProtocolMessageType('AddPartitionsRs', (_message.Message,), {
+  'DESCRIPTOR' : _ADDPARTITIONSRS,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:AddPartitionsRs)
+  })
+_sym_db.RegisterMessage(AddPartitionsRs)
+
+TopicPartition = _reflection.GeneratedProtocolMessageType('TopicPartition', (_message.Message,), {
+  'DESCRIPTOR' : _TOPICPARTITION,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:TopicPartition)
+  })
+_sym_db.RegisterMessage(TopicPartition)
+
+PartitionReassignment = _reflection.GeneratedProtocolMessageType('PartitionReassignment', (_message.Message,), {
+  'DESCRIPTOR' : _PARTITIONREASSIGNMENT,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:PartitionReassignment)
+  })
+_sym_db.RegisterMessage(PartitionReassignment)
+
+PartitionProgress = _reflection.GeneratedProtocolMessageType('PartitionProgress', (_message.Message,), {
+  'DESCRIPTOR' : _PARTITIONPROGRESS,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:PartitionProgress)
+  })
+_sym_db.RegisterMessage(PartitionProgress)
+
+ElectPreferredLeadersRq = _reflection.GeneratedProtocolMessageType('ElectPreferredLeadersRq', (_message.Message,), {
+  'DESCRIPTOR' : _ELECTPREFERREDLEADERSRQ,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:ElectPreferredLeadersRq)
+  })
+_sym_db.RegisterMessage(ElectPreferredLeadersRq)
+
+ElectPreferredLeadersRs = _reflection.GeneratedProtocolMessageType('ElectPreferredLeadersRs', (_message.Message,), {
+  'DESCRIPTOR' : _ELECTPREFERREDLEADERSRS,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:ElectPreferredLeadersRs)
+  })
+_sym_db.RegisterMessage(ElectPreferredLeadersRs)
+
+ReassignPartitionsRq = _reflection.GeneratedProtocolMessageType('ReassignPartitionsRq', (_message.Message,), {
+  'DESCRIPTOR' : _REASSIGNPARTITIONSRQ,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:ReassignPartitionsRq)
+  })
+_sym_db.RegisterMessage(ReassignPartitionsRq)
+
+ReassignPartitionsRs = _reflection.GeneratedProtocolMessageType('ReassignPartitionsRs', (_message.Message,), {
+  'DESCRIPTOR' : _REASSIGNPARTITIONSRS,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:ReassignPartitionsRs)
+  })
+_sym_db.RegisterMessage(ReassignPartitionsRs)
+
+GetReassignmentsRq = _reflection.GeneratedProtocolMessageType('GetReassignmentsRq', (_message.Message,), {
+  'DESCRIPTOR' : _GETREASSIGNMENTSRQ,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:GetReassignmentsRq)
+  })
+_sym_db.RegisterMessage(GetReassignmentsRq)
+
+GetReassignmentsRs = _reflection.GeneratedProtocolMessageType('GetReassignmentsRs', (_message.Message,), {
+  'DESCRIPTOR' : _GETREASSIGNMENTSRS,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:GetReassignmentsRs)
+  })
+_sym_db.RegisterMessage(GetReassignmentsRs)
+
+BrokerMetadata = _reflection.GeneratedProtocolMessageType('BrokerMetadata', (_message.Message,), {
+  'DESCRIPTOR' : _BROKERMETADATA,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:BrokerMetadata)
+  })
+_sym_db.RegisterMessage(BrokerMetadata)
+
+DescribeClusterRq = _reflection.GeneratedProtocolMessageType('DescribeClusterRq', (_message.Message,), {
+  'DESCRIPTOR' : _DESCRIBECLUSTERRQ,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:DescribeClusterRq)
+  })
+_sym_db.RegisterMessage(DescribeClusterRq)
+
+DescribeClusterRs = _reflection.GeneratedProtocolMessageType('DescribeClusterRs', (_message.Message,), {
+  'DESCRIPTOR' : _DESCRIBECLUSTERRS,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:DescribeClusterRs)
+  })
+_sym_db.RegisterMessage(DescribeClusterRs)
+
+BrowseMessagesRq = _reflection.GeneratedProtocolMessageType('BrowseMessagesRq', (_message.Message,), {
+  'DESCRIPTOR' : _BROWSEMESSAGESRQ,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:BrowseMessagesRq)
+  })
+_sym_db.RegisterMessage(BrowseMessagesRq)
+
+BrowsedMessage = _reflection.GeneratedProtocolMessageType('BrowsedMessage', (_message.Message,), {
+  'DESCRIPTOR' : _BROWSEDMESSAGE,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:BrowsedMessage)
+  })
+_sym_db.RegisterMessage(BrowsedMessage)
+
+BrowseMessagesRs = _reflection.GeneratedProtocolMessageType('BrowseMessagesRs', (_message.Message,), {
+  'DESCRIPTOR' : _BROWSEMESSAGESRS,
+  '__module__' : 'kafkapixy_pb2'
+  # @@protoc_insertion_point(class_scope:BrowseMessagesRs)
+  })
+_sym_db.RegisterMessage(BrowseMessagesRs)
+
 
 DESCRIPTOR._options = None
 _GETTOPICMETADATARS_CONFIGENTRY._options = None
 _LISTTOPICRS_TOPICSENTRY._options = None
 _CONSUMERGROUPS_CONSUMERSENTRY._options = None
 _LISTCONSUMERSRS_GROUPSENTRY._options = None
+_CREATETOPICRQ_CONFIGENTRY._options = None
+_ALTERTOPICCONFIGRQ_SETENTRY._options = None
 
 _KAFKAPIXY = _descriptor.ServiceDescriptor(
   name='KafkaPixy',
@@ -1381,8 +3345,8 @@ _KAFKAPIXY = _descriptor.ServiceDescriptor(
   index=0,
   serialized_options=None,
   create_key=_descriptor._internal_create_key,
-  serialized_start=1919,
-  serialized_end=2280,
+  serialized_start=4901,
+  serialized_end=6019,
   methods=[
   _descriptor.MethodDescriptor(
     name='Produce',
@@ -1394,10 +3358,50 @@ _KAFKAPIXY = _descriptor.ServiceDescriptor(
     serialized_options=None,
     create_key=_descriptor._internal_create_key,
   ),
+  _descriptor.MethodDescriptor(
+    name='ProduceAtomic',
+    full_name='KafkaPixy.ProduceAtomic',
+    index=1,
+    containing_service=None,
+    input_type=_PRODATOMICRQ,
+    output_type=_PRODATOMICRS,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='ProduceBatch',
+    full_name='KafkaPixy.ProduceBatch',
+    index=2,
+    containing_service=None,
+    input_type=_PRODBATCHRQ,
+    output_type=_PRODBATCHRS,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='ProduceStream',
+    full_name='KafkaPixy.ProduceStream',
+    index=3,
+    containing_service=None,
+    input_type=_PRODSTREAMRQ,
+    output_type=_PRODSTREAMRS,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='DeliveryReports',
+    full_name='KafkaPixy.DeliveryReports',
+    index=4,
+    containing_service=None,
+    input_type=_DELIVERYREPORTSRQ,
+    output_type=_DELIVERYREPORT,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
   _descriptor.MethodDescriptor(
     name='ConsumeNAck',
     full_name='KafkaPixy.ConsumeNAck',
-    index=1,
+    index=5,
     containing_service=None,
     input_type=_CONSNACKRQ,
     output_type=_CONSRS,
@@ -1407,7 +3411,7 @@ _KAFKAPIXY = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='Ack',
     full_name='KafkaPixy.Ack',
-    index=2,
+    index=6,
     containing_service=None,
     input_type=_ACKRQ,
     output_type=_ACKRS,
@@ -1417,7 +3421,7 @@ _KAFKAPIXY = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='GetOffsets',
     full_name='KafkaPixy.GetOffsets',
-    index=3,
+    index=7,
     containing_service=None,
     input_type=_GETOFFSETSRQ,
     output_type=_GETOFFSETSRS,
@@ -1427,7 +3431,7 @@ _KAFKAPIXY = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='SetOffsets',
     full_name='KafkaPixy.SetOffsets',
-    index=4,
+    index=8,
     containing_service=None,
     input_type=_SETOFFSETSRQ,
     output_type=_SETOFFSETSRS,
@@ -1437,7 +3441,7 @@ _KAFKAPIXY = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='ListTopics',
     full_name='KafkaPixy.ListTopics',
-    index=5,
+    index=9,
     containing_service=None,
     input_type=_LISTTOPICRQ,
     output_type=_LISTTOPICRS,
@@ -1447,7 +3451,7 @@ _KAFKAPIXY = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='ListConsumers',
     full_name='KafkaPixy.ListConsumers',
-    index=6,
+    index=10,
     containing_service=None,
     input_type=_LISTCONSUMERSRQ,
     output_type=_LISTCONSUMERSRS,
@@ -1457,13 +3461,103 @@ _KAFKAPIXY = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='GetTopicMetadata',
     full_name='KafkaPixy.GetTopicMetadata',
-    index=7,
+    index=11,
     containing_service=None,
     input_type=_GETTOPICMETADATARQ,
     output_type=_GETTOPICMETADATARS,
     serialized_options=None,
     create_key=_descriptor._internal_create_key,
   ),
+  _descriptor.MethodDescriptor(
+    name='DescribeCluster',
+    full_name='KafkaPixy.DescribeCluster',
+    index=12,
+    containing_service=None,
+    input_type=_DESCRIBECLUSTERRQ,
+    output_type=_DESCRIBECLUSTERRS,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='CreateTopic',
+    full_name='KafkaPixy.CreateTopic',
+    index=13,
+    containing_service=None,
+    input_type=_CREATETOPICRQ,
+    output_type=_CREATETOPICRS,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='DeleteTopic',
+    full_name='KafkaPixy.DeleteTopic',
+    index=14,
+    containing_service=None,
+    input_type=_DELETETOPICRQ,
+    output_type=_DELETETOPICRS,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='AlterTopicConfig',
+    full_name='KafkaPixy.AlterTopicConfig',
+    index=15,
+    containing_service=None,
+    input_type=_ALTERTOPICCONFIGRQ,
+    output_type=_ALTERTOPICCONFIGRS,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='AddPartitions',
+    full_name='KafkaPixy.AddPartitions',
+    index=16,
+    containing_service=None,
+    input_type=_ADDPARTITIONSRQ,
+    output_type=_ADDPARTITIONSRS,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='ElectPreferredLeaders',
+    full_name='KafkaPixy.ElectPreferredLeaders',
+    index=17,
+    containing_service=None,
+    input_type=_ELECTPREFERREDLEADERSRQ,
+    output_type=_ELECTPREFERREDLEADERSRS,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='ReassignPartitions',
+    full_name='KafkaPixy.ReassignPartitions',
+    index=18,
+    containing_service=None,
+    input_type=_REASSIGNPARTITIONSRQ,
+    output_type=_REASSIGNPARTITIONSRS,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetReassignments',
+    full_name='KafkaPixy.GetReassignments',
+    index=19,
+    containing_service=None,
+    input_type=_GETREASSIGNMENTSRQ,
+    output_type=_GETREASSIGNMENTSRS,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
+  _descriptor.MethodDescriptor(
+    name='BrowseMessages',
+    full_name='KafkaPixy.BrowseMessages',
+    index=20,
+    containing_service=None,
+    input_type=_BROWSEMESSAGESRQ,
+    output_type=_BROWSEMESSAGESRS,
+    serialized_options=None,
+    create_key=_descriptor._internal_create_key,
+  ),
 ])
 _sym_db.RegisterServiceDescriptor(_KAFKAPIXY)
 
@@ -19,6 +19,26 @@ class KafkaPixyStub(object):
                 request_serializer=kafkapixy__pb2.ProdRq.SerializeToString,
                 response_deserializer=kafkapixy__pb2.ProdRs.FromString,
                 )
+        self.ProduceAtomic = channel.unary_unary(
+                '/KafkaPixy/ProduceAtomic',
+                request_serializer=kafkapixy__pb2.ProdAtomicRq.SerializeToString,
+                response_deserializer=kafkapixy__pb2.ProdAtomicRs.FromString,
+                )
+        self.ProduceBatch = channel.unary_unary(
+                '/KafkaPixy/ProduceBatch',
+                request_serializer=kafkapixy__pb2.ProdBatchRq.SerializeToString,
+                response_deserializer=kafkapixy__pb2.ProdBatchRs.FromString,
+                )
+        self.ProduceStream = channel.stream_stream(
+                '/KafkaPixy/ProduceStream',
+                request_serializer=kafkapixy__pb2.ProdStreamRq.SerializeToString,
+                response_deserializer=kafkapixy__pb2.ProdStreamRs.FromString,
+                )
+        self.DeliveryReports = channel.unary_stream(
+                '/KafkaPixy/DeliveryReports',
+                request_serializer=kafkapixy__pb2.DeliveryReportsRq.SerializeToString,
+                response_deserializer=kafkapixy__pb2.DeliveryReport.FromString,
+                )
         self.ConsumeNAck = channel.unary_unary(
                 '/KafkaPixy/ConsumeNAck',
                 request_serializer=kafkapixy__pb2.ConsNAckRq.SerializeToString,
@@ -54,6 +74,51 @@ class KafkaPixyStub(object):
                 request_serializer=kafkapixy__pb2.GetTopicMetadataRq.SerializeToString,
                 response_deserializer=kafkapixy__pb2.GetTopicMetadataRs.FromString,
                 )
+        self.DescribeCluster = channel.unary_unary(
+                '/KafkaPixy/DescribeCluster',
+                request_serializer=kafkapixy__pb2.DescribeClusterRq.SerializeToString,
+                response_deserializer=kafkapixy__pb2.DescribeClusterRs.FromString,
+                )
+        self.CreateTopic = channel.unary_unary(
+                '/KafkaPixy/CreateTopic',
+                request_serializer=kafkapixy__pb2.CreateTopicRq.SerializeToString,
+                response_deserializer=kafkapixy__pb2.CreateTopicRs.FromString,
+                )
+        self.DeleteTopic = channel.unary_unary(
+                '/KafkaPixy/DeleteTopic',
+                request_serializer=kafkapixy__pb2.DeleteTopicRq.SerializeToString,
+                response_deserializer=kafkapixy__pb2.DeleteTopicRs.FromString,
+                )
+        self.AlterTopicConfig = channel.unary_unary(
+                '/KafkaPixy/AlterTopicConfig',
+                request_serializer=kafkapixy__pb2.AlterTopicConfigRq.SerializeToString,
+                response_deserializer=kafkapixy__pb2.AlterTopicConfigRs.FromString,
+                )
+        self.AddPartitions = channel.unary_unary(
+                '/KafkaPixy/AddPartitions',
+                request_serializer=kafkapixy__pb2.AddPartitionsRq.SerializeToString,
+                response_deserializer=kafkapixy__pb2.AddPartitionsRs.FromString,
+                )
+        self.ElectPreferredLeaders = channel.unary_unary(
+                '/KafkaPixy/ElectPreferredLeaders',
+                request_serializer=kafkapixy__pb2.ElectPreferredLeadersRq.SerializeToString,
+                response_deserializer=kafkapixy__pb2.ElectPreferredLeadersRs.FromString,
+                )
+        self.ReassignPartitions = channel.unary_unary(
+                '/KafkaPixy/ReassignPartitions',
+                request_serializer=kafkapixy__pb2.ReassignPartitionsRq.SerializeToString,
+                response_deserializer=kafkapixy__pb2.ReassignPartitionsRs.FromString,
+                )
+        self.GetReassignments = channel.unary_unary(
+                '/KafkaPixy/GetReassignments',
+                request_serializer=kafkapixy__pb2.GetReassignmentsRq.SerializeToString,
+                response_deserializer=kafkapixy__pb2.GetReassignmentsRs.FromString,
+                )
+        self.BrowseMessages = channel.unary_unary(
+                '/KafkaPixy/BrowseMessages',
+                request_serializer=kafkapixy__pb2.BrowseMessagesRq.SerializeToString,
+                response_deserializer=kafkapixy__pb2.BrowseMessagesRs.FromString,
+                )
 
 
 class KafkaPixyServicer(object):
@@ -77,7 +142,9 @@ class KafkaPixyServicer(object):
         ProdReq.key_undefined and ProdReq.key_value are left default, which is
         empty string and false respectively, then messages will be consitently
         written to a partiticular partition selected by the hash of an empty
-        string.
+        string. To write a message to a particular partition set
+        ProdReq.explicit_partition to true and ProdReq.partition to the
+        partition number.
 
         gRPC error codes:
         * Invalid Argument (3): see the status description for details;
@@ -88,6 +155,79 @@ class KafkaPixyServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def ProduceAtomic(self, request, context):
+        """ProduceAtomic writes several messages, possibly to different topics, as
+        a single Kafka transaction. Either all messages become visible to
+        consumers with read_committed isolation level or none does. Partitions
+        are selected for messages the same way as it is done by Produce.
+
+        The method is only available if
+        config.yaml:proxies.<cluster>.producer.transactional_id_prefix is set.
+        Requires Kafka version 0.11.0.0 or later.
+
+        gRPC error codes:
+        * Invalid Argument (3): see the status description for details;
+        * Internal (13): see the status description and logs for details;
+        * Unavailable (14): the service is shutting down or transactions are
+        disabled by configuration.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ProduceBatch(self, request, context):
+        """ProduceBatch writes many messages, possibly to different topics, in a
+        single call. All messages are submitted to the producer at once, so they
+        are batched together when written to Kafka. The call blocks until all
+        messages are either written or failed. Unlike ProduceAtomic, messages
+        succeed or fail individually, and the response contains a result for
+        every message in the order they were given in the request.
+
+        gRPC error codes:
+        * Invalid Argument (3): see the status description for details.
+
+        Errors of individual messages are reported in ProdBatchResult.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ProduceStream(self, request_iterator, context):
+        """ProduceStream opens a bidirectional stream to write messages to Kafka
+        continuously. For every message received on the stream a result is sent
+        back once the message is written to Kafka or failed. Results carry the
+        sequence number supplied by the client in ProdStreamRq.seq and are sent
+        in the order the messages were received.
+
+        When the producer buffer is filled up Kafka-Pixy stops reading from the
+        stream until the buffer is drained, so clients are slowed down by gRPC
+        flow control.
+
+        Errors of individual messages are reported in ProdStreamRs. The stream
+        is terminated with the following gRPC error codes:
+        * Invalid Argument (3): see the status description for details.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeliveryReports(self, request, context):
+        """DeliveryReports opens a stream of results of messages produced by the
+        Produce method in async mode with ProdRq.correlation_id set. A report is
+        sent once a message is either written to Kafka or failed. Reports are
+        sent to all streams opened by the client that produced the message, the
+        client being identified by a verified TLS client certificate. Anonymous
+        clients can neither open a stream nor set ProdRq.correlation_id, that
+        fails with Unauthenticated (16). Reports of messages resolved while the
+        client has no stream open, or while a stream is not keeping up with
+        reports, are dropped, so clients should open a stream before producing.
+        Dropped reports are counted in the delivery_reports_dropped metric at
+        /debug/vars.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def ConsumeNAck(self, request, context):
         """Consume reads a message from a topic and optionally acknowledges a
         message previously consumed from the same topic.
@@ -181,7 +321,9 @@ class KafkaPixyServicer(object):
         raise NotImplementedError('Method not implemented!')
 
     def ListConsumers(self, request, context):
-        """Lists all consumers of a topic
+        """Lists all consumers of a topic. That includes both consumer groups
+        coordinated by Kafka-Pixy via ZooKeeper and consumer groups coordinated
+        by Kafka.
 
         gRPC error codes:
         * Invalid Argument (3): If unable to find the cluster named in the request
@@ -203,6 +345,174 @@ class KafkaPixyServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def DescribeCluster(self, request, context):
+        """Returns brokers of a Kafka cluster along with their connectivity as
+        seen by this Kafka-Pixy instance, the controller ID, and the numbers of
+        under-replicated and offline partitions.
+
+        gRPC error codes:
+        * Invalid Argument (3): If unable to find the cluster named in the
+        request
+        * Internal (13): If Kafka returns an error on request
+        * Unavailable (14): If the service is shutting down
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def CreateTopic(self, request, context):
+        """Creates a topic. Only clients listed in
+        config.yaml:proxies.<cluster>.admin.topic_managers are allowed to do
+        that. Clients are identified by verified TLS client certificates, the
+        client ID metadata is never trusted for that.
+
+        gRPC error codes:
+        * Invalid Argument (3): If unable to find the cluster named in the
+        request, or if the topic parameters are rejected by Kafka
+        * Already Exists (6): If the topic already exists
+        * Permission Denied (7): If the client is not allowed to manage topics
+        * Internal (13): If Kafka returns an error on request
+        * Unavailable (14): If the service is shutting down
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteTopic(self, request, context):
+        """Deletes a topic. Only clients listed in
+        config.yaml:proxies.<cluster>.admin.topic_managers are allowed to do
+        that.
+
+        gRPC error codes:
+        * Invalid Argument (3): If unable to find the cluster named in the request
+        * NotFound (5): If the topic does not exist
+        * Permission Denied (7): If the client is not allowed to manage topics
+        * Failed Precondition (9): If topic deletion is disabled in Kafka
+        * Internal (13): If Kafka returns an error on request
+        * Unavailable (14): If the service is shutting down
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def AlterTopicConfig(self, request, context):
+        """Changes configuration overrides of a topic. Overrides that are not
+        mentioned in the request are left intact. Only clients listed in
+        config.yaml:proxies.<cluster>.admin.topic_managers are allowed to do
+        that.
+
+        gRPC error codes:
+        * Invalid Argument (3): If unable to find the cluster named in the
+        request, or if the configuration is rejected by Kafka
+        * NotFound (5): If the topic does not exist
+        * Permission Denied (7): If the client is not allowed to manage topics
+        * Internal (13): If Kafka returns an error on request
+        * Unavailable (14): If the service is shutting down
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def AddPartitions(self, request, context):
+        """Increases the number of partitions of a topic. Kafka does not allow to
+        decrease it. When partitions are added, consumer groups consuming the
+        topic via this Kafka-Pixy instance start consuming the new partitions
+        right away. Only clients listed in
+        config.yaml:proxies.<cluster>.admin.topic_managers are allowed to do
+        that.
+
+        gRPC error codes:
+        * Invalid Argument (3): If unable to find the cluster named in the
+        request, or if the partition count is rejected by Kafka
+        * NotFound (5): If the topic does not exist
+        * Permission Denied (7): If the client is not allowed to manage topics
+        * Internal (13): If Kafka returns an error on request
+        * Unavailable (14): If the service is shutting down
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ElectPreferredLeaders(self, request, context):
+        """Makes Kafka move leadership of partitions to their preferred replicas,
+        that are the first replicas in the partition replica lists. The election
+        is performed by Kafka asynchronously, the response reflects the state of
+        the partitions right after the election has been requested. Only clients
+        listed in config.yaml:proxies.<cluster>.admin.topic_managers are allowed
+        to do that.
+
+        gRPC error codes:
+        * Invalid Argument (3): If unable to find the cluster named in the
+        request, or if the partition list is empty or has duplicates
+        * NotFound (5): If a partition does not exist
+        * Permission Denied (7): If the client is not allowed to manage topics
+        * Failed Precondition (9): If a previously requested election is still
+        in progress
+        * Internal (13): If Kafka returns an error on request
+        * Unavailable (14): If the service is shutting down
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ReassignPartitions(self, request, context):
+        """Makes Kafka move partitions to new replicas, e.g. to move replicas off a
+        broker that is being retired. The reassignment is performed by Kafka
+        asynchronously, and can be monitored with GetReassignments. Only clients
+        listed in config.yaml:proxies.<cluster>.admin.topic_managers are allowed
+        to do that.
+
+        gRPC error codes:
+        * Invalid Argument (3): If unable to find the cluster named in the
+        request, or if the replica assignment is invalid
+        * NotFound (5): If a partition does not exist
+        * Permission Denied (7): If the client is not allowed to manage topics
+        * Failed Precondition (9): If a previously requested reassignment is
+        still in progress
+        * Internal (13): If Kafka returns an error on request
+        * Unavailable (14): If the service is shutting down
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetReassignments(self, request, context):
+        """Returns progress of partition reassignments that are in progress. Kafka
+        forgets about reassignments as soon as they are completed.
+
+        gRPC error codes:
+        * Invalid Argument (3): If unable to find the cluster named in the
+        request
+        * Internal (13): If Kafka returns an error on request
+        * Unavailable (14): If the service is shutting down
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def BrowseMessages(self, request, context):
+        """Reads a page of messages from a partition without joining a consumer
+        group. It never changes offsets committed by consumer groups, so it is
+        safe to use for investigation of incidents. The page size is limited by
+        config.yaml:proxies.<cluster>.browse.max_count and max_bytes, and the
+        rate of requests by config.yaml:proxies.<cluster>.browse.client_limit.
+
+        gRPC error codes:
+        * Invalid Argument (3): If unable to find the cluster named in the
+        request
+        * NotFound (5): If the partition does not exist
+        * Resource Exhausted (8): If the client exceeded the browse rate limit.
+        The status details include a google.rpc.RetryInfo telling how long
+        to wait before retrying.
+        * Out Of Range (11): If the offset to read from is not in the range
+        of offsets of the partition
+        * Internal (13): If Kafka returns an error on request
+        * Unavailable (14): If the service is shutting down
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_KafkaPixyServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -211,6 +521,26 @@ def add_KafkaPixyServicer_to_server(servicer, server):
                     request_deserializer=kafkapixy__pb2.ProdRq.FromString,
                     response_serializer=kafkapixy__pb2.ProdRs.SerializeToString,
             ),
+            'ProduceAtomic': grpc.unary_unary_rpc_method_handler(
+                    servicer.ProduceAtomic,
+                    request_deserializer=kafkapixy__pb2.ProdAtomicRq.FromString,
+                    response_serializer=kafkapixy__pb2.ProdAtomicRs.SerializeToString,
+            ),
+            'ProduceBatch': grpc.unary_unary_rpc_method_handler(
+                    servicer.ProduceBatch,
+                    request_deserializer=kafkapixy__pb2.ProdBatchRq.FromString,
+                    response_serializer=kafkapixy__pb2.ProdBatchRs.SerializeToString,
+            ),
+            'ProduceStream': grpc.stream_stream_rpc_method_handler(
+                    servicer.ProduceStream,
+                    request_deserializer=kafkapixy__pb2.ProdStreamRq.FromString,
+                    response_serializer=kafkapixy__pb2.ProdStreamRs.SerializeToString,
+            ),
+            'DeliveryReports': grpc.unary_stream_rpc_method_handler(
+                    servicer.DeliveryReports,
+                    request_deserializer=kafkapixy__pb2.DeliveryReportsRq.FromString,
+                    response_serializer=kafkapixy__pb2.DeliveryReport.SerializeToString,
+            ),
             'ConsumeNAck': grpc.unary_unary_rpc_method_handler(
                     servicer.ConsumeNAck,
                     request_deserializer=kafkapixy__pb2.ConsNAckRq.FromString,
@@ -246,6 +576,51 @@ def add_KafkaPixyServicer_to_server(servicer, server):
                     request_deserializer=kafkapixy__pb2.GetTopicMetadataRq.FromString,
                     response_serializer=kafkapixy__pb2.GetTopicMetadataRs.SerializeToString,
             ),
+            'DescribeCluster': grpc.unary_unary_rpc_method_handler(
+                    servicer.DescribeCluster,
+                    request_deserializer=kafkapixy__pb2.DescribeClusterRq.FromString,
+                    response_serializer=kafkapixy__pb2.DescribeClusterRs.SerializeToString,
+            ),
+            'CreateTopic': grpc.unary_unary_rpc_method_handler(
+                    servicer.CreateTopic,
+                    request_deserializer=kafkapixy__pb2.CreateTopicRq.FromString,
+                    response_serializer=kafkapixy__pb2.CreateTopicRs.SerializeToString,
+            ),
+            'DeleteTopic': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteTopic,
+                    request_deserializer=kafkapixy__pb2.DeleteTopicRq.FromString,
+                    response_serializer=kafkapixy__pb2.DeleteTopicRs.SerializeToString,
+            ),
+            'AlterTopicConfig': grpc.unary_unary_rpc_method_handler(
+                    servicer.AlterTopicConfig,
+                    request_deserializer=kafkapixy__pb2.AlterTopicConfigRq.FromString,
+                    response_serializer=kafkapixy__pb2.AlterTopicConfigRs.SerializeToString,
+            ),
+            'AddPartitions': grpc.unary_unary_rpc_method_handler(
+                    servicer.AddPartitions,
+                    request_deserializer=kafkapixy__pb2.AddPartitionsRq.FromString,
+                    response_serializer=kafkapixy__pb2.AddPartitionsRs.SerializeToString,
+            ),
+            'ElectPreferredLeaders': grpc.unary_unary_rpc_method_handler(
+                    servicer.ElectPreferredLeaders,
+                    request_deserializer=kafkapixy__pb2.ElectPreferredLeadersRq.FromString,
+                    response_serializer=kafkapixy__pb2.ElectPreferredLeadersRs.SerializeToString,
+            ),
+            'ReassignPartitions': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReassignPartitions,
+                    request_deserializer=kafkapixy__pb2.ReassignPartitionsRq.FromString,
+                    response_serializer=kafkapixy__pb2.ReassignPartitionsRs.SerializeToString,
+            ),
+            'GetReassignments': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetReassignments,
+                    request_deserializer=kafkapixy__pb2.GetReassignmentsRq.FromString,
+                    response_serializer=kafkapixy__pb2.GetReassignmentsRs.SerializeToString,
+            ),
+            'BrowseMessages': grpc.unary_unary_rpc_method_handler(
+                    servicer.BrowseMessages,
+                    request_deserializer=kafkapixy__pb2.BrowseMessagesRq.FromString,
+                    response_serializer=kafkapixy__pb2.BrowseMessagesRs.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'KafkaPixy', rpc_method_handlers)
@@ -273,6 +648,74 @@ class KafkaPixy(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def ProduceAtomic(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/KafkaPixy/ProduceAtomic',
+            kafkapixy__pb2.ProdAtomicRq.SerializeToString,
+            kafkapixy__pb2.ProdAtomicRs.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ProduceBatch(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/KafkaPixy/ProduceBatch',
+            kafkapixy__pb2.ProdBatchRq.SerializeToString,
+            kafkapixy__pb2.ProdBatchRs.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ProduceStream(request_iterator,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.stream_stream(request_iterator, target, '/KafkaPixy/ProduceStream',
+            kafkapixy__pb2.ProdStreamRq.SerializeToString,
+            kafkapixy__pb2.ProdStreamRs.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DeliveryReports(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/KafkaPixy/DeliveryReports',
+            kafkapixy__pb2.DeliveryReportsRq.SerializeToString,
+            kafkapixy__pb2.DeliveryReport.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def ConsumeNAck(request,
             target,
@@ -391,3 +834,156 @@ class KafkaPixy(object):
             kafkapixy__pb2.GetTopicMetadataRs.FromString,
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DescribeCluster(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/KafkaPixy/DescribeCluster',
+            kafkapixy__pb2.DescribeClusterRq.SerializeToString,
+            kafkapixy__pb2.DescribeClusterRs.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def CreateTopic(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/KafkaPixy/CreateTopic',
+            kafkapixy__pb2.CreateTopicRq.SerializeToString,
+            kafkapixy__pb2.CreateTopicRs.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DeleteTopic(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/KafkaPixy/DeleteTopic',
+            kafkapixy__pb2.DeleteTopicRq.SerializeToString,
+            kafkapixy__pb2.DeleteTopicRs.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def AlterTopicConfig(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/KafkaPixy/AlterTopicConfig',
+            kafkapixy__pb2.AlterTopicConfigRq.SerializeToString,
+            kafkapixy__pb2.AlterTopicConfigRs.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def AddPartitions(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/KafkaPixy/AddPartitions',
+            kafkapixy__pb2.AddPartitionsRq.SerializeToString,
+            kafkapixy__pb2.AddPartitionsRs.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ElectPreferredLeaders(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/KafkaPixy/ElectPreferredLeaders',
+            kafkapixy__pb2.ElectPreferredLeadersRq.SerializeToString,
+            kafkapixy__pb2.ElectPreferredLeadersRs.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ReassignPartitions(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/KafkaPixy/ReassignPartitions',
+            kafkapixy__pb2.ReassignPartitionsRq.SerializeToString,
+            kafkapixy__pb2.ReassignPartitionsRs.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetReassignments(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/KafkaPixy/GetReassignments',
+            kafkapixy__pb2.GetReassignmentsRq.SerializeToString,
+            kafkapixy__pb2.GetReassignmentsRs.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def BrowseMessages(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/KafkaPixy/BrowseMessages',
+            kafkapixy__pb2.BrowseMessagesRq.SerializeToString,
+            kafkapixy__pb2.BrowseMessagesRs.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
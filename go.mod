@@ -3,11 +3,13 @@ module github.com/mailgun/kafka-pixy
 go 1.16
 
 require (
-	github.com/Shopify/sarama v1.23.1
+	github.com/Shopify/sarama v1.30.1
 	github.com/davecgh/go-spew v1.1.1
 	github.com/fatih/structs v1.1.0 // indirect
 	github.com/go-ini/ini v1.46.0 // indirect
 	github.com/gorilla/mux v1.8.0
+	github.com/jhump/protoreflect v1.9.0
+	github.com/linkedin/goavro/v2 v2.9.8
 	github.com/mailgun/holster/v4 v4.0.0
 	github.com/mailru/easyjson v0.0.0-20190626092158-b2ccc519800e
 	github.com/onsi/ginkgo v1.9.0 // indirect
@@ -19,11 +21,13 @@ require (
 	github.com/spf13/cast v1.3.0 // indirect
 	github.com/stretchr/testify v1.7.0
 	github.com/thrawn01/args v0.3.0
-	golang.org/x/net v0.0.0-20210405180319-a5a99cb37ef4
+	github.com/xeipuuv/gojsonschema v1.2.0
+	golang.org/x/net v0.0.0-20210917221730-978cfadd31cf
+	golang.org/x/time v0.0.0-20210220033141-f8bda1e9f3ba
+	google.golang.org/genproto v0.0.0-20210602131652-f16073e35f0c
 	google.golang.org/grpc v1.39.0
 	google.golang.org/protobuf v1.27.1
-	gopkg.in/check.v1 v1.0.0-20190902080502-41f04d3bba15
+	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c
 	gopkg.in/ini.v1 v1.46.0 // indirect
-	gopkg.in/jcmturner/goidentity.v3 v3.0.0 // indirect
 	gopkg.in/yaml.v2 v2.4.0
 )
@@ -0,0 +1,124 @@
+// Package partitioner provides sarama partitioners compatible with those of
+// other Kafka client libraries, so that messages with the same key produced
+// via Kafka-Pixy and directly by those libraries end up in the same partition.
+package partitioner
+
+import (
+	"hash/crc32"
+	"math/rand"
+	"time"
+
+	"github.com/Shopify/sarama"
+)
+
+// murmur2 selects partitions for keyed messages exactly as the
+// `DefaultPartitioner` of the Java Kafka client does: murmur2 hash of the key
+// with the sign bit cleared modulo the number of partitions. Messages without
+// a key are written to a random partition.
+type murmur2 struct {
+	random sarama.Partitioner
+}
+
+// NewMurmur2 returns a Java `DefaultPartitioner` compatible partitioner.
+func NewMurmur2(topic string) sarama.Partitioner {
+	return &murmur2{random: sarama.NewRandomPartitioner(topic)}
+}
+
+// Partition implements sarama.Partitioner.
+func (p *murmur2) Partition(msg *sarama.ProducerMessage, numPartitions int32) (int32, error) {
+	if msg.Key == nil {
+		return p.random.Partition(msg, numPartitions)
+	}
+	key, err := msg.Key.Encode()
+	if err != nil {
+		return -1, err
+	}
+	return int32(Murmur2(key)&0x7fffffff) % numPartitions, nil
+}
+
+// RequiresConsistency implements sarama.Partitioner.
+func (p *murmur2) RequiresConsistency() bool {
+	return true
+}
+
+// MessageRequiresConsistency implements sarama.DynamicConsistencyPartitioner.
+func (p *murmur2) MessageRequiresConsistency(msg *sarama.ProducerMessage) bool {
+	return msg.Key != nil
+}
+
+// consistentRandom selects partitions the same way as the librdkafka
+// `consistent_random` partitioner does: CRC32 of the key modulo the number
+// of partitions. Messages with an empty or no key are written to a random
+// partition.
+type consistentRandom struct {
+	generator *rand.Rand
+}
+
+// NewConsistentRandom returns a librdkafka `consistent_random` compatible
+// partitioner.
+func NewConsistentRandom(topic string) sarama.Partitioner {
+	return &consistentRandom{generator: rand.New(rand.NewSource(time.Now().UTC().UnixNano()))}
+}
+
+// Partition implements sarama.Partitioner.
+func (p *consistentRandom) Partition(msg *sarama.ProducerMessage, numPartitions int32) (int32, error) {
+	var key []byte
+	if msg.Key != nil {
+		var err error
+		if key, err = msg.Key.Encode(); err != nil {
+			return -1, err
+		}
+	}
+	if len(key) == 0 {
+		return int32(p.generator.Intn(int(numPartitions))), nil
+	}
+	return int32(crc32.ChecksumIEEE(key) % uint32(numPartitions)), nil
+}
+
+// RequiresConsistency implements sarama.Partitioner.
+func (p *consistentRandom) RequiresConsistency() bool {
+	return true
+}
+
+// MessageRequiresConsistency implements sarama.DynamicConsistencyPartitioner.
+func (p *consistentRandom) MessageRequiresConsistency(msg *sarama.ProducerMessage) bool {
+	return msg.Key != nil && msg.Key.Length() > 0
+}
+
+// Murmur2 returns the murmur2 hash of data as computed by the Java Kafka
+// client `Utils.murmur2` function.
+func Murmur2(data []byte) uint32 {
+	const (
+		seed = 0x9747b28c
+		m    = 0x5bd1e995
+		r    = 24
+	)
+	length := len(data)
+	h := uint32(seed) ^ uint32(length)
+	length4 := length / 4
+	for i := 0; i < length4; i++ {
+		i4 := i * 4
+		k := uint32(data[i4]) | uint32(data[i4+1])<<8 | uint32(data[i4+2])<<16 | uint32(data[i4+3])<<24
+		k *= m
+		k ^= k >> r
+		k *= m
+		h *= m
+		h ^= k
+	}
+	tail := data[length&^3:]
+	switch len(tail) {
+	case 3:
+		h ^= uint32(tail[2]) << 16
+		fallthrough
+	case 2:
+		h ^= uint32(tail[1]) << 8
+		fallthrough
+	case 1:
+		h ^= uint32(tail[0])
+		h *= m
+	}
+	h ^= h >> 13
+	h *= m
+	h ^= h >> 15
+	return h
+}
@@ -0,0 +1,106 @@
+package partitioner
+
+import (
+	"testing"
+
+	"github.com/Shopify/sarama"
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+type PartitionerSuite struct{}
+
+var _ = Suite(&PartitionerSuite{})
+
+// Hashes match those expected by the Java Kafka client tests.
+func (s *PartitionerSuite) TestMurmur2(c *C) {
+	for i, tc := range []struct {
+		data string
+		hash int32
+	}{
+		{data: "21", hash: -973932308},
+		{data: "foobar", hash: -790332482},
+		{data: "a-little-bit-long-string", hash: -985981536},
+		{data: "a-little-bit-longer-string", hash: -1486304829},
+		{data: "lkjh234lh9fiuh90y23oiuhsafujhadof229phr9h19h89h8", hash: -58897971},
+		{data: "abc", hash: 479470107},
+	} {
+		c.Check(int32(Murmur2([]byte(tc.data))), Equals, tc.hash, Commentf("case #%d", i))
+	}
+}
+
+func (s *PartitionerSuite) TestMurmur2Partition(c *C) {
+	p := NewMurmur2("foo")
+	for i, tc := range []struct {
+		key       string
+		partition int32
+	}{
+		{key: "21", partition: (-973932308 & 0x7fffffff) % 10},
+		{key: "foobar", partition: (-790332482 & 0x7fffffff) % 10},
+		{key: "abc", partition: 479470107 % 10},
+	} {
+		msg := &sarama.ProducerMessage{Key: sarama.StringEncoder(tc.key)}
+
+		// When
+		partition, err := p.Partition(msg, 10)
+
+		// Then
+		c.Check(err, IsNil)
+		c.Check(partition, Equals, tc.partition, Commentf("case #%d", i))
+	}
+	c.Check(p.(sarama.DynamicConsistencyPartitioner).MessageRequiresConsistency(
+		&sarama.ProducerMessage{Key: sarama.StringEncoder("")}), Equals, true)
+	c.Check(p.(sarama.DynamicConsistencyPartitioner).MessageRequiresConsistency(
+		&sarama.ProducerMessage{}), Equals, false)
+}
+
+func (s *PartitionerSuite) TestMurmur2NilKey(c *C) {
+	p := NewMurmur2("foo")
+	hits := make(map[int32]bool)
+	for i := 0; i < 100; i++ {
+		partition, err := p.Partition(&sarama.ProducerMessage{}, 3)
+		c.Assert(err, IsNil)
+		hits[partition] = true
+	}
+	c.Check(len(hits), Equals, 3)
+}
+
+func (s *PartitionerSuite) TestConsistentRandom(c *C) {
+	p := NewConsistentRandom("foo")
+	for i, tc := range []struct {
+		key       string
+		partition int32
+	}{
+		// CRC32 values computed by zlib crc32().
+		{key: "foobar", partition: 0x9ef61f95 % 7},
+		{key: "abc", partition: 0x352441c2 % 7},
+	} {
+		msg := &sarama.ProducerMessage{Key: sarama.StringEncoder(tc.key)}
+
+		// When
+		partition, err := p.Partition(msg, 7)
+
+		// Then
+		c.Check(err, IsNil)
+		c.Check(partition, Equals, tc.partition, Commentf("case #%d", i))
+	}
+}
+
+// Messages with an empty key are distributed randomly between partitions.
+func (s *PartitionerSuite) TestConsistentRandomEmptyKey(c *C) {
+	p := NewConsistentRandom("foo")
+	for _, key := range []sarama.Encoder{nil, sarama.StringEncoder("")} {
+		hits := make(map[int32]bool)
+		for i := 0; i < 100; i++ {
+			partition, err := p.Partition(&sarama.ProducerMessage{Key: key}, 3)
+			c.Assert(err, IsNil)
+			hits[partition] = true
+		}
+		c.Check(len(hits), Equals, 3)
+		c.Check(p.(sarama.DynamicConsistencyPartitioner).MessageRequiresConsistency(
+			&sarama.ProducerMessage{Key: key}), Equals, false)
+	}
+}
@@ -0,0 +1,97 @@
+package producer
+
+import (
+	"expvar"
+	"time"
+
+	"github.com/Shopify/sarama"
+	. "gopkg.in/check.v1"
+)
+
+type BufferSuite struct{}
+
+var _ = Suite(&BufferSuite{})
+
+// If the producer buffer stays full for the buffer timeout, then produce
+// fails with `ErrBufferFull`.
+func (s *BufferSuite) TestBufferFull(c *C) {
+	p := &T{
+		defaultProducer: newTestSaramaProducer(2),
+		bufferTimeout:   50 * time.Millisecond,
+	}
+	rejectsBefore := bufferFullRejectCount("foo")
+	p.AsyncProduce("foo", nil, sarama.StringEncoder("1"), nil)
+	p.AsyncProduce("foo", nil, sarama.StringEncoder("2"), nil)
+	buffered, capacity := p.BufferUsage()
+	c.Assert(buffered, Equals, 2)
+	c.Assert(capacity, Equals, 2)
+	c.Assert(p.BufferFill(), Equals, 1.0)
+
+	// When
+	begin := time.Now()
+	rs := <-p.AsyncProduce("foo", nil, sarama.StringEncoder("3"), nil)
+	err := p.AsyncProduceDurable("foo", AnyPartition, nil, sarama.StringEncoder("4"), nil, time.Time{})
+
+	// Then
+	c.Assert(rs.Err, Equals, ErrBufferFull)
+	c.Assert(err, Equals, ErrBufferFull)
+	c.Assert(time.Since(begin) >= 100*time.Millisecond, Equals, true)
+	c.Assert(bufferFullRejectCount("foo"), Equals, rejectsBefore+2)
+}
+
+// A produce request waits for room in the buffer while it is full.
+func (s *BufferSuite) TestBufferWait(c *C) {
+	p := &T{
+		defaultProducer: newTestSaramaProducer(1),
+		bufferTimeout:   3 * time.Second,
+	}
+	p.AsyncProduce("foo", nil, sarama.StringEncoder("1"), nil)
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		<-p.defaultProducer.dispatcherCh
+	}()
+
+	// When
+	p.AsyncProduce("foo", nil, sarama.StringEncoder("2"), nil)
+
+	// Then
+	prodMsg := <-p.defaultProducer.dispatcherCh
+	c.Assert(prodMsg.Value, Equals, sarama.StringEncoder("2"))
+}
+
+// Messages to a topic with a dedicated producer are buffered separately, so
+// if its buffer is full, then messages to other topics are still accepted.
+func (s *BufferSuite) TestTopicBufferFull(c *C) {
+	p := &T{
+		defaultProducer: newTestSaramaProducer(1),
+		topicProducers:  map[string]*saramaProducer{"bar": newTestSaramaProducer(1)},
+		bufferTimeout:   50 * time.Millisecond,
+	}
+	p.AsyncProduce("bar", nil, sarama.StringEncoder("1"), nil)
+
+	// When
+	begin := time.Now()
+	barRs := <-p.AsyncProduce("bar", nil, sarama.StringEncoder("2"), nil)
+	fooErr := p.AsyncProduceDurable("foo", AnyPartition, nil, sarama.StringEncoder("3"), nil, time.Time{})
+
+	// Then
+	c.Assert(barRs.Err, Equals, ErrBufferFull)
+	c.Assert(fooErr, IsNil)
+	c.Assert(time.Since(begin) < 100*time.Millisecond, Equals, true)
+	buffered, capacity := p.BufferUsage()
+	c.Assert(buffered, Equals, 2)
+	c.Assert(capacity, Equals, 2)
+	prodMsg := <-p.defaultProducer.dispatcherCh
+	c.Assert(prodMsg.Value, Equals, sarama.StringEncoder("3"))
+}
+
+func newTestSaramaProducer(bufferSize int) *saramaProducer {
+	return &saramaProducer{dispatcherCh: make(chan *sarama.ProducerMessage, bufferSize)}
+}
+
+func bufferFullRejectCount(topic string) int64 {
+	if count, ok := bufferFullRejects.Get(topic).(*expvar.Int); ok {
+		return count.Value()
+	}
+	return 0
+}
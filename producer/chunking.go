@@ -0,0 +1,156 @@
+package producer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/mailgun/kafka-pixy/chunking"
+	"github.com/pkg/errors"
+)
+
+// chunkedMsg is used as `sarama.ProducerMessage.Metadata` for chunks of a
+// message produced with a reply channel or a reporter. It collects results of
+// all chunks and replies once when all of them are known.
+type chunkedMsg struct {
+	mu      sync.Mutex
+	reply   Reporter
+	msg     *sarama.ProducerMessage
+	pending int
+	err     error
+}
+
+// isChunkingNeeded tells whether a message is too large to be produced as is
+// and should be split into chunks.
+func (p *T) isChunkingNeeded(message sarama.Encoder) bool {
+	return p.chunkSize > 0 && message != nil && message.Length() > p.chunkSize
+}
+
+// splitIntoChunks splits the value of a message into chunks. All chunks are
+// directed to the same partition, selected by the configured partitioner if
+// the message partition is `AnyPartition`.
+func (p *T) splitIntoChunks(msg *sarama.ProducerMessage) ([]*sarama.ProducerMessage, error) {
+	if msg.Value.Length() > p.chunkedMaxMessageBytes {
+		return nil, sarama.ErrMessageSizeTooLarge
+	}
+	value, err := msg.Value.Encode()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode message")
+	}
+	if msg.Partition == AnyPartition {
+		if msg.Partition, err = p.selectPartition(msg); err != nil {
+			return nil, err
+		}
+	}
+	values := chunking.Split(value, p.chunkSize)
+	id := chunking.NewID()
+	chunks := make([]*sarama.ProducerMessage, len(values))
+	for i, chunkValue := range values {
+		chunks[i] = &sarama.ProducerMessage{
+			Topic:     msg.Topic,
+			Partition: msg.Partition,
+			Key:       msg.Key,
+			Value:     sarama.ByteEncoder(chunkValue),
+			Headers:   chunking.Headers(msg.Headers, chunking.Info{ID: id, Index: i, Count: len(values)}),
+			Timestamp: msg.Timestamp,
+		}
+	}
+	return chunks, nil
+}
+
+// selectPartition selects a partition for a message the same way
+// `sarama.AsyncProducer` does it using the configured partitioner.
+func (p *T) selectPartition(msg *sarama.ProducerMessage) (int32, error) {
+	saramaProducer := p.producerOf(msg.Topic)
+	p.partitionersMu.Lock()
+	defer p.partitionersMu.Unlock()
+	partitioner := p.partitioners[msg.Topic]
+	if partitioner == nil {
+		partitioner = saramaProducer.partitionerCtor(msg.Topic)
+		p.partitioners[msg.Topic] = partitioner
+	}
+	requiresConsistency := partitioner.RequiresConsistency()
+	if dcp, ok := partitioner.(sarama.DynamicConsistencyPartitioner); ok {
+		requiresConsistency = dcp.MessageRequiresConsistency(msg)
+	}
+	var partitions []int32
+	var err error
+	if requiresConsistency {
+		partitions, err = saramaProducer.client.Partitions(msg.Topic)
+	} else {
+		partitions, err = saramaProducer.client.WritablePartitions(msg.Topic)
+	}
+	if err != nil {
+		return AnyPartition, err
+	}
+	if len(partitions) == 0 {
+		return AnyPartition, sarama.ErrLeaderNotAvailable
+	}
+	i, err := partitioner.Partition(msg, int32(len(partitions)))
+	if err != nil {
+		return AnyPartition, err
+	}
+	if i < 0 || int(i) >= len(partitions) {
+		return AnyPartition, sarama.ErrInvalidPartition
+	}
+	return partitions[i], nil
+}
+
+// asyncProduceChunked splits a message into chunks and submits them for
+// production. A response is passed to `reply` when results of all chunks are
+// known. It contains the original message with the partition and the offset
+// of the last chunk, and an error if any chunk failed.
+func (p *T) asyncProduceChunked(msg *sarama.ProducerMessage, reply Reporter) {
+	chunks, err := p.splitIntoChunks(msg)
+	if err != nil {
+		reply(Response{Msg: msg, Err: err})
+		return
+	}
+	chunked := &chunkedMsg{reply: reply, msg: msg, pending: len(chunks)}
+	for i, chunk := range chunks {
+		chunk.Metadata = chunked
+		if err := p.enqueue(chunk); err != nil {
+			// Chunks that were not submitted are failed right away.
+			for _, chunk := range chunks[i:] {
+				p.handleChunkResult(Response{Msg: chunk, Err: err}, chunked)
+			}
+			break
+		}
+	}
+}
+
+// handleChunkResult records a produce result of a chunk, and replies to the
+// caller if results of all chunks of the message are known.
+func (p *T) handleChunkResult(result Response, chunked *chunkedMsg) {
+	chunked.mu.Lock()
+	defer chunked.mu.Unlock()
+	if result.Err != nil && chunked.err == nil {
+		chunked.err = result.Err
+	}
+	if result.Err == nil && result.Msg.Offset >= chunked.msg.Offset {
+		chunked.msg.Partition = result.Msg.Partition
+		chunked.msg.Offset = result.Msg.Offset
+		chunked.msg.Timestamp = result.Msg.Timestamp
+	}
+	chunked.pending--
+	if chunked.pending == 0 {
+		chunked.reply(Response{Msg: chunked.msg, Err: chunked.err})
+	}
+}
+
+// newProducerMsgs returns chunks of a fire-and-forget message if it is
+// too large to be produced as is, or the message itself otherwise.
+func (p *T) newProducerMsgs(topic string, partition int32, key, message sarama.Encoder, headers []sarama.RecordHeader, timestamp time.Time) ([]*sarama.ProducerMessage, error) {
+	msg := &sarama.ProducerMessage{
+		Topic:     topic,
+		Partition: partition,
+		Key:       key,
+		Value:     message,
+		Headers:   headers,
+		Timestamp: timestamp,
+	}
+	if !p.isChunkingNeeded(message) {
+		return []*sarama.ProducerMessage{msg}, nil
+	}
+	return p.splitIntoChunks(msg)
+}
@@ -0,0 +1,222 @@
+package producer
+
+import (
+	"encoding/json"
+	"expvar"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/mailgun/kafka-pixy/actor"
+	"github.com/mailgun/kafka-pixy/config"
+	"github.com/pkg/errors"
+)
+
+const (
+	deadLetterHdrError     = "kafka-pixy-error"
+	deadLetterHdrTopic     = "kafka-pixy-topic"
+	deadLetterHdrPartition = "kafka-pixy-partition"
+
+	deadLetterOutcomeTopic   = "topic"
+	deadLetterOutcomeFile    = "file"
+	deadLetterOutcomeDropped = "dropped"
+
+	deadLetterReasonConfig      = "configuration"
+	deadLetterReasonUnavailable = "unavailable"
+	deadLetterReasonOther       = "other"
+)
+
+var (
+	// Number of failed messages by the way they were handled: written to the
+	// fallback topic, appended to the file, or dropped. A message written to
+	// both the topic and the file is counted by both outcomes.
+	deadMsgCounts = expvar.NewMap("producer_dead_messages")
+	// Number of failed messages by the failure reason. The set of reasons is
+	// fixed, see deadLetterReason.
+	deadMsgReasonCounts = expvar.NewMap("producer_dead_message_reasons")
+)
+
+// deadLetterer handles messages that the producer failed to write to Kafka.
+// Depending on configuration it writes them to a fallback topic, appends
+// them to a local NDJSON file, or does both. It runs in a dedicated goroutine so
+// that the dispatcher is never blocked by dead message handling.
+type deadLetterer struct {
+	actDesc      *actor.Descriptor
+	topic        string
+	syncProducer sarama.SyncProducer
+	file         *os.File
+	msgsCh       chan Response
+	wg           sync.WaitGroup
+}
+
+// deadMsgRecord is a line of the dead letter file.
+type deadMsgRecord struct {
+	Time      time.Time       `json:"time"`
+	Topic     string          `json:"topic"`
+	Partition int32           `json:"partition"`
+	Key       []byte          `json:"key"`
+	Value     []byte          `json:"value"`
+	Headers   []deadMsgHeader `json:"headers,omitempty"`
+	Error     string          `json:"error"`
+}
+
+type deadMsgHeader struct {
+	Key   string `json:"key"`
+	Value []byte `json:"value"`
+}
+
+// spawnDeadLetterer creates a dead letter handler and starts its goroutine.
+// The fallback topic is written to using a producer built on top of the
+// given client.
+func spawnDeadLetterer(actDesc *actor.Descriptor, cfg *config.Proxy, client sarama.Client) (*deadLetterer, error) {
+	dl := deadLetterer{
+		actDesc: actDesc,
+		topic:   cfg.Producer.DeadLetter.Topic,
+		msgsCh:  make(chan Response, cfg.Producer.ChannelBufferSize),
+	}
+	if dl.topic != "" {
+		var err error
+		if dl.syncProducer, err = sarama.NewSyncProducerFromClient(client); err != nil {
+			return nil, errors.Wrap(err, "failed to create dead letter producer")
+		}
+	}
+	if cfg.Producer.DeadLetter.File != "" {
+		var err error
+		dl.file, err = os.OpenFile(cfg.Producer.DeadLetter.File, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+		if err != nil {
+			if dl.syncProducer != nil {
+				dl.syncProducer.Close()
+			}
+			return nil, errors.Wrap(err, "failed to open dead letter file")
+		}
+	}
+	actor.Spawn(dl.actDesc, &dl.wg, dl.run)
+	return &dl, nil
+}
+
+// handle submits a failed produce result for dead letter processing. It never
+// blocks, if the dead letter queue is full the message is dropped.
+func (dl *deadLetterer) handle(result Response) {
+	select {
+	case dl.msgsCh <- result:
+	default:
+		deadMsgReasonCounts.Add(deadLetterReason(result.Err), 1)
+		deadMsgCounts.Add(deadLetterOutcomeDropped, 1)
+		dl.actDesc.Log().WithError(result.Err).Errorf("Dead letter queue is full, message dropped: topic=%s", result.Msg.Topic)
+	}
+}
+
+// stop processes all queued messages and releases all resources.
+func (dl *deadLetterer) stop() {
+	close(dl.msgsCh)
+	dl.wg.Wait()
+	if dl.syncProducer != nil {
+		if err := dl.syncProducer.Close(); err != nil {
+			dl.actDesc.Log().WithError(err).Error("Failed to close dead letter producer")
+		}
+	}
+	if dl.file != nil {
+		if err := dl.file.Close(); err != nil {
+			dl.actDesc.Log().WithError(err).Error("Failed to close dead letter file")
+		}
+	}
+}
+
+func (dl *deadLetterer) run() {
+	for result := range dl.msgsCh {
+		dl.process(result)
+	}
+}
+
+// process writes a failed message to every configured sink. If it could not
+// be written to any of them, then it is counted as dropped.
+func (dl *deadLetterer) process(result Response) {
+	deadMsgReasonCounts.Add(deadLetterReason(result.Err), 1)
+	written := false
+	if dl.syncProducer != nil {
+		if err := dl.produceToTopic(result); err != nil {
+			dl.actDesc.Log().WithError(err).Errorf("Failed to write to dead letter topic: topic=%s", dl.topic)
+		} else {
+			deadMsgCounts.Add(deadLetterOutcomeTopic, 1)
+			written = true
+		}
+	}
+	if dl.file != nil {
+		if err := dl.appendToFile(result); err != nil {
+			dl.actDesc.Log().WithError(err).Error("Failed to write to dead letter file")
+		} else {
+			deadMsgCounts.Add(deadLetterOutcomeFile, 1)
+			written = true
+		}
+	}
+	if !written {
+		deadMsgCounts.Add(deadLetterOutcomeDropped, 1)
+	}
+}
+
+func (dl *deadLetterer) produceToTopic(result Response) error {
+	headers := make([]sarama.RecordHeader, 0, len(result.Msg.Headers)+3)
+	headers = append(headers, result.Msg.Headers...)
+	headers = append(headers,
+		sarama.RecordHeader{Key: []byte(deadLetterHdrError), Value: []byte(result.Err.Error())},
+		sarama.RecordHeader{Key: []byte(deadLetterHdrTopic), Value: []byte(result.Msg.Topic)},
+		sarama.RecordHeader{Key: []byte(deadLetterHdrPartition), Value: []byte(strconv.Itoa(int(result.Msg.Partition)))})
+	_, _, err := dl.syncProducer.SendMessage(&sarama.ProducerMessage{
+		Topic:     dl.topic,
+		Partition: AnyPartition,
+		Key:       result.Msg.Key,
+		Value:     result.Msg.Value,
+		Headers:   headers,
+	})
+	return err
+}
+
+func (dl *deadLetterer) appendToFile(result Response) error {
+	rec := deadMsgRecord{
+		Time:      time.Now().UTC(),
+		Topic:     result.Msg.Topic,
+		Partition: result.Msg.Partition,
+		Error:     result.Err.Error(),
+	}
+	var err error
+	if result.Msg.Key != nil {
+		if rec.Key, err = result.Msg.Key.Encode(); err != nil {
+			return errors.Wrap(err, "failed to encode key")
+		}
+	}
+	if result.Msg.Value != nil {
+		if rec.Value, err = result.Msg.Value.Encode(); err != nil {
+			return errors.Wrap(err, "failed to encode value")
+		}
+	}
+	for _, header := range result.Msg.Headers {
+		rec.Headers = append(rec.Headers, deadMsgHeader{Key: string(header.Key), Value: header.Value})
+	}
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal record")
+	}
+	_, err = dl.file.Write(append(line, '\n'))
+	return err
+}
+
+// deadLetterReason returns a metric key for a produce error. Kafka errors are
+// keyed by their description, that is bounded by the set of Kafka error
+// codes, all other errors are put into a few fixed buckets, so that error
+// messages with variable details do not make the metric grow unboundedly.
+func deadLetterReason(err error) string {
+	switch cause := errors.Cause(err).(type) {
+	case sarama.KError:
+		return cause.Error()
+	case sarama.ConfigurationError:
+		return deadLetterReasonConfig
+	}
+	switch errors.Cause(err) {
+	case sarama.ErrOutOfBrokers, sarama.ErrNotConnected, sarama.ErrClosedClient,
+		sarama.ErrShuttingDown, sarama.ErrIncompleteResponse:
+		return deadLetterReasonUnavailable
+	}
+	return deadLetterReasonOther
+}
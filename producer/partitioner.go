@@ -0,0 +1,52 @@
+package producer
+
+import (
+	"github.com/Shopify/sarama"
+)
+
+// AnyPartition is a partition value that indicates that a message partition
+// should be selected by the configured partitioner.
+const AnyPartition int32 = -1
+
+// explicitPartitioner writes messages with an explicitly specified partition
+// to that partition bypassing the configured partitioner, that is used to
+// select partitions for all other messages.
+type explicitPartitioner struct {
+	partitioner sarama.Partitioner
+}
+
+func newExplicitPartitionerConstructor(partitionerCtor sarama.PartitionerConstructor) sarama.PartitionerConstructor {
+	return func(topic string) sarama.Partitioner {
+		return &explicitPartitioner{partitioner: partitionerCtor(topic)}
+	}
+}
+
+// Partition implements sarama.Partitioner.
+func (ep *explicitPartitioner) Partition(msg *sarama.ProducerMessage, numPartitions int32) (int32, error) {
+	if msg.Partition == AnyPartition {
+		return ep.partitioner.Partition(msg, numPartitions)
+	}
+	if msg.Partition < 0 || msg.Partition >= numPartitions {
+		return -1, sarama.ErrInvalidPartition
+	}
+	return msg.Partition, nil
+}
+
+// RequiresConsistency implements sarama.Partitioner.
+func (ep *explicitPartitioner) RequiresConsistency() bool {
+	return ep.partitioner.RequiresConsistency()
+}
+
+// MessageRequiresConsistency implements sarama.DynamicConsistencyPartitioner.
+//
+// An explicit partition has to be selected among all topic partitions, not
+// just writable ones, for the partition index to be equal to the partition ID.
+func (ep *explicitPartitioner) MessageRequiresConsistency(msg *sarama.ProducerMessage) bool {
+	if msg.Partition != AnyPartition {
+		return true
+	}
+	if dcp, ok := ep.partitioner.(sarama.DynamicConsistencyPartitioner); ok {
+		return dcp.MessageRequiresConsistency(msg)
+	}
+	return ep.partitioner.RequiresConsistency()
+}
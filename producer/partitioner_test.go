@@ -0,0 +1,58 @@
+package producer
+
+import (
+	"github.com/Shopify/sarama"
+	. "gopkg.in/check.v1"
+)
+
+type PartitionerSuite struct{}
+
+var _ = Suite(&PartitionerSuite{})
+
+// Messages with an explicit partition are written to that partition
+// regardless of their key.
+func (s *PartitionerSuite) TestExplicitPartition(c *C) {
+	p := newExplicitPartitionerConstructor(sarama.NewHashPartitioner)("foo")
+	for partition := int32(0); partition < 4; partition++ {
+		msg := &sarama.ProducerMessage{Partition: partition, Key: sarama.StringEncoder("bar")}
+
+		// When
+		selected, err := p.Partition(msg, 4)
+
+		// Then
+		c.Check(err, IsNil)
+		c.Check(selected, Equals, partition)
+		c.Check(p.(sarama.DynamicConsistencyPartitioner).MessageRequiresConsistency(msg), Equals, true)
+	}
+}
+
+func (s *PartitionerSuite) TestExplicitPartitionOutOfRange(c *C) {
+	p := newExplicitPartitionerConstructor(sarama.NewHashPartitioner)("foo")
+	msg := &sarama.ProducerMessage{Partition: 4, Key: sarama.StringEncoder("bar")}
+
+	// When
+	_, err := p.Partition(msg, 4)
+
+	// Then
+	c.Check(err, Equals, sarama.ErrInvalidPartition)
+}
+
+// If a partition is not specified, then the wrapped partitioner is used.
+func (s *PartitionerSuite) TestAnyPartition(c *C) {
+	p := newExplicitPartitionerConstructor(sarama.NewHashPartitioner)("foo")
+	hp := sarama.NewHashPartitioner("foo")
+	for _, key := range []string{"a", "b", "c", "d", "e"} {
+		msg := &sarama.ProducerMessage{Partition: AnyPartition, Key: sarama.StringEncoder(key)}
+		expected, err := hp.Partition(msg, 4)
+		c.Assert(err, IsNil)
+
+		// When
+		selected, err := p.Partition(msg, 4)
+
+		// Then
+		c.Check(err, IsNil)
+		c.Check(selected, Equals, expected)
+	}
+	msg := &sarama.ProducerMessage{Partition: AnyPartition}
+	c.Check(p.(sarama.DynamicConsistencyPartitioner).MessageRequiresConsistency(msg), Equals, false)
+}
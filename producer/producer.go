@@ -1,6 +1,7 @@
 package producer
 
 import (
+	"expvar"
 	"fmt"
 	"sync"
 	"time"
@@ -8,6 +9,8 @@ import (
 	"github.com/Shopify/sarama"
 	"github.com/mailgun/kafka-pixy/actor"
 	"github.com/mailgun/kafka-pixy/config"
+	"github.com/mailgun/kafka-pixy/none"
+	"github.com/mailgun/kafka-pixy/producer/spool"
 	"github.com/pkg/errors"
 )
 
@@ -15,23 +18,48 @@ const (
 	maxEncoderReprLength = 4096
 )
 
+var (
+	// ErrBufferFull is returned if a message could not be put to the
+	// producer buffer within the configured timeout.
+	ErrBufferFull = errors.New("producer buffer is full")
+
+	// Number of messages rejected because the producer buffer was full by
+	// topic.
+	bufferFullRejects = expvar.NewMap("producer_buffer_full_rejects")
+)
+
 // T builds on top of `sarama.AsyncProducer` to improve the shutdown handling.
 // The problem it solves is that `sarama.AsyncProducer` drops all buffered
 // messages as soon as it is ordered to shutdown. On the contrary, when `T` is
 // ordered to stop it allows some time for the buffered messages to be
 // committed to the Kafka cluster, and only when that time has elapsed it drops
-// uncommitted messages.
-//
-// TODO Consider implementing some sort of dead message processing.
+// uncommitted messages. Messages that could not be written to Kafka are
+// handed over to the dead letter handler.
 type T struct {
 	mergActDesc     *actor.Descriptor
 	dispActDesc     *actor.Descriptor
-	saramaClient    sarama.Client
-	saramaProducer  sarama.AsyncProducer
+	defaultProducer *saramaProducer
+	topicProducers  map[string]*saramaProducer
 	shutdownTimeout time.Duration
-	dispatcherCh    chan *sarama.ProducerMessage
-	responseCh      chan Response
+	bufferTimeout   time.Duration
+	txnIDPoolCh     chan string
+	txnTimeout      time.Duration
 	wg              sync.WaitGroup
+	deadLetterer    *deadLetterer
+
+	spool              *spool.T
+	spoolActDesc       *actor.Descriptor
+	spoolRetryBackoff  time.Duration
+	spoolRetryMu       sync.Mutex
+	spoolRetries       []spoolRetry
+	spoolRetrySignalCh chan none.T
+	spoolStopCh        chan none.T
+	spoolWG            sync.WaitGroup
+
+	chunkSize              int
+	chunkedMaxMessageBytes int
+	partitionersMu         sync.Mutex
+	partitioners           map[string]sarama.Partitioner
 
 	// To be used in tests only
 	testDroppedMsgCh chan<- *sarama.ProducerMessage
@@ -42,39 +70,123 @@ type Response struct {
 	Err error
 }
 
+// Reporter is a function that a produce result is passed to. It is called by
+// a dispatcher goroutine, therefore it must not block.
+type Reporter func(Response)
+
+// saramaProducer is a `sarama.AsyncProducer` along with its own client. The
+// default one produces to all topics but those with overridden producer
+// parameters, each of which gets a dedicated one.
+//
+// Every sarama producer has its own buffer and dispatcher goroutine, so that
+// a producer that does not accept messages, e.g. because leaders of its topic
+// are unavailable, does not hold up messages to other producers.
+type saramaProducer struct {
+	client          sarama.Client
+	producer        sarama.AsyncProducer
+	partitionerCtor sarama.PartitionerConstructor
+	dispatcherCh    chan *sarama.ProducerMessage
+	responseCh      chan Response
+}
+
 // Spawn creates a producer instance and starts its internal goroutines.
 func Spawn(parentActDesc *actor.Descriptor, cfg *config.Proxy) (*T, error) {
-	saramaCfg := cfg.SaramaProducerCfg()
-	saramaCfg.Producer.Return.Successes = true
-	saramaCfg.Producer.Return.Errors = true
+	var spl *spool.T
+	spoolActDesc := parentActDesc.NewChild("prod_spool")
+	if cfg.Producer.Spool.Dir != "" {
+		var err error
+		if spl, err = spool.Open(spoolActDesc, cfg); err != nil {
+			return nil, errors.Wrap(err, "failed to open spool")
+		}
+	}
+	var saramaProducers []*saramaProducer
+	cleanup := func() {
+		for _, sp := range saramaProducers {
+			sp.close()
+		}
+		if spl != nil {
+			spl.Close()
+		}
+	}
 
-	saramaClient, err := sarama.NewClient(cfg.Kafka.SeedPeers, saramaCfg)
+	defaultProducer, err := newSaramaProducer(cfg.Kafka.SeedPeers, cfg.SaramaProducerCfg(), cfg.Producer.ChannelBufferSize)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to create sarama.Client")
+		cleanup()
+		return nil, err
 	}
-	saramaProducer, err := sarama.NewAsyncProducerFromClient(saramaClient)
+	saramaProducers = append(saramaProducers, defaultProducer)
+	topicProducers := make(map[string]*saramaProducer, len(cfg.Producer.Topics))
+	for topic := range cfg.Producer.Topics {
+		topicProducer, err := newSaramaProducer(cfg.Kafka.SeedPeers, cfg.SaramaTopicProducerCfg(topic), cfg.Producer.ChannelBufferSize)
+		if err != nil {
+			cleanup()
+			return nil, errors.Wrapf(err, "topic %s", topic)
+		}
+		saramaProducers = append(saramaProducers, topicProducer)
+		topicProducers[topic] = topicProducer
+	}
+	deadLetterer, err := spawnDeadLetterer(parentActDesc.NewChild("prod_dead"), cfg, defaultProducer.client)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to create sarama.Producer")
+		cleanup()
+		return nil, err
 	}
 
 	p := &T{
 		mergActDesc:     parentActDesc.NewChild("prod_merg"),
 		dispActDesc:     parentActDesc.NewChild("prod_disp"),
-		saramaClient:    saramaClient,
-		saramaProducer:  saramaProducer,
+		defaultProducer: defaultProducer,
+		topicProducers:  topicProducers,
+		deadLetterer:    deadLetterer,
 		shutdownTimeout: cfg.Producer.ShutdownTimeout,
-		dispatcherCh:    make(chan *sarama.ProducerMessage, cfg.Producer.ChannelBufferSize),
-		responseCh:      make(chan Response, cfg.Producer.ChannelBufferSize),
+		bufferTimeout:   cfg.Producer.BufferFullTimeout,
+		txnTimeout:      cfg.Producer.TransactionTimeout,
+
+		spool:              spl,
+		spoolActDesc:       spoolActDesc,
+		spoolRetryBackoff:  cfg.Producer.RetryBackoff,
+		spoolRetrySignalCh: make(chan none.T, 1),
+		spoolStopCh:        make(chan none.T),
+
+		partitioners: make(map[string]sarama.Partitioner),
+	}
+	if cfg.Producer.Chunking.Enabled {
+		p.chunkSize = cfg.Producer.Chunking.ChunkSize
+		p.chunkedMaxMessageBytes = cfg.Producer.Chunking.MaxMessageBytes
+	}
+	if cfg.Producer.TransactionalIDPrefix != "" {
+		p.txnIDPoolCh = make(chan string, cfg.Producer.MaxConcurrentTxns)
+		for _, txnID := range txnIDs(cfg.Producer.TransactionalIDPrefix, cfg.ClientID, cfg.Producer.MaxConcurrentTxns) {
+			p.txnIDPoolCh <- txnID
+		}
+	}
+	p.spawnDispatcher(p.mergActDesc, p.dispActDesc, defaultProducer)
+	for topic, topicProducer := range topicProducers {
+		p.spawnDispatcher(p.mergActDesc.NewChild(topic), p.dispActDesc.NewChild(topic), topicProducer)
+	}
+	if p.spool != nil {
+		actor.Spawn(p.spoolActDesc, &p.spoolWG, p.runSpoolRetrier)
 	}
-	actor.Spawn(p.mergActDesc, &p.wg, p.runMerger)
-	actor.Spawn(p.dispActDesc, &p.wg, p.runDispatcher)
 	return p, nil
 }
 
 // Stop shuts down all producer goroutines and releases all resources.
 func (p *T) Stop() {
-	close(p.dispatcherCh)
+	// The spool retrier has to be stopped first, since it sends messages to
+	// the dispatcher channels.
+	close(p.spoolStopCh)
+	p.spoolWG.Wait()
+	for _, sp := range p.saramaProducers() {
+		close(sp.dispatcherCh)
+	}
 	p.wg.Wait()
+	p.deadLetterer.stop()
+	p.defaultProducer.client.Close()
+	for _, topicProducer := range p.topicProducers {
+		topicProducer.client.Close()
+	}
+	if p.spool != nil {
+		p.spool.Close()
+	}
 }
 
 // Produce submits a message to the specified `topic` of the Kafka cluster
@@ -92,30 +204,137 @@ func (p *T) Produce(topic string, key, message sarama.Encoder, headers []sarama.
 
 // AsyncProduce is an asynchronously counterpart of the `Produce` function.
 // Errors are silently ignored.
+//
+// If the producer buffer is full, then it waits for the configured timeout
+// for room in the buffer, and then fails with `ErrBufferFull`.
 func (p *T) AsyncProduce(topic string, key, message sarama.Encoder, headers []sarama.RecordHeader) <-chan Response {
-	responseCh := make(chan Response, 1)
+	return p.AsyncProduceToPartition(topic, AnyPartition, key, message, headers, time.Time{})
+}
+
+// AsyncProduceToPartition is like `AsyncProduce`, but if `partition` is not
+// `AnyPartition`, then the message is written to that partition bypassing the
+// configured partitioner. If the topic does not have such partition, then
+// `sarama.ErrInvalidPartition` is returned. If `timestamp` is not zero, then
+// it is used as the message timestamp, otherwise the current time is used.
+//
+// If chunking is enabled and the message is larger than the chunk size, then
+// it is split into chunks that are written to the same partition, and the
+// response is sent when all chunks are written.
+func (p *T) AsyncProduceToPartition(topic string, partition int32, key, message sarama.Encoder, headers []sarama.RecordHeader, timestamp time.Time) <-chan Response {
 	prodMsg := &sarama.ProducerMessage{
-		Topic:    topic,
-		Key:      key,
-		Value:    message,
-		Headers:  headers,
-		Metadata: responseCh,
+		Topic:     topic,
+		Partition: partition,
+		Key:       key,
+		Value:     message,
+		Headers:   headers,
+		Timestamp: timestamp,
+	}
+	responseCh := make(chan Response, 1)
+	if p.isChunkingNeeded(message) {
+		p.asyncProduceChunked(prodMsg, func(rs Response) { responseCh <- rs })
+		return responseCh
+	}
+	prodMsg.Metadata = responseCh
+	if err := p.enqueue(prodMsg); err != nil {
+		responseCh <- Response{Msg: prodMsg, Err: err}
 	}
-	p.dispatcherCh <- prodMsg
 	return responseCh
 }
 
+// AsyncProduceReported is like `AsyncProduceToPartition`, but rather than
+// sending the response to a channel, it passes it to `report` once the
+// message is either written to Kafka or failed. If the message cannot be put
+// to the producer buffer, then the error is returned right away. The message
+// is not persisted in the spool, and if it fails it is not passed to the dead
+// letter handler, for the failure is reported.
+func (p *T) AsyncProduceReported(topic string, partition int32, key, message sarama.Encoder, headers []sarama.RecordHeader, timestamp time.Time, report Reporter) error {
+	prodMsg := &sarama.ProducerMessage{
+		Topic:     topic,
+		Partition: partition,
+		Key:       key,
+		Value:     message,
+		Headers:   headers,
+		Timestamp: timestamp,
+	}
+	if p.isChunkingNeeded(message) {
+		p.asyncProduceChunked(prodMsg, report)
+		return nil
+	}
+	prodMsg.Metadata = report
+	return p.enqueue(prodMsg)
+}
+
+// BufferFill returns the fraction of the producer buffer capacity occupied by
+// messages waiting to be submitted to Kafka. It is in range [0, 1].
+func (p *T) BufferFill() float64 {
+	buffered, capacity := p.BufferUsage()
+	return float64(buffered) / float64(capacity)
+}
+
+// BufferUsage returns the number of messages waiting in the producer buffer
+// to be submitted to Kafka, and the buffer capacity. Both are totals of the
+// buffers of the default and topic producers.
+func (p *T) BufferUsage() (int, int) {
+	var buffered, capacity int
+	for _, sp := range p.saramaProducers() {
+		buffered += len(sp.dispatcherCh)
+		capacity += cap(sp.dispatcherCh)
+	}
+	return buffered, capacity
+}
+
+// RefreshMetadata refreshes metadata of a topic cached by the Kafka client of
+// the sarama producer that the topic messages are submitted to, so that newly
+// added partitions are used right away.
+func (p *T) RefreshMetadata(topic string) error {
+	return p.producerOf(topic).client.RefreshMetadata(topic)
+}
+
+// enqueue puts a message to the buffer of the sarama producer of its topic.
+// If the buffer is full, then it waits for room in the buffer for the
+// configured timeout, and then gives up returning `ErrBufferFull`.
+func (p *T) enqueue(prodMsg *sarama.ProducerMessage) error {
+	dispatcherCh := p.producerOf(prodMsg.Topic).dispatcherCh
+	select {
+	case dispatcherCh <- prodMsg:
+		return nil
+	default:
+	}
+	timeout := time.NewTimer(p.bufferTimeout)
+	defer timeout.Stop()
+	select {
+	case dispatcherCh <- prodMsg:
+		return nil
+	case <-timeout.C:
+		bufferFullRejects.Add(prodMsg.Topic, 1)
+		return ErrBufferFull
+	}
+}
+
+// spawnDispatcher starts the merger and the dispatcher goroutines of a sarama
+// producer.
+func (p *T) spawnDispatcher(mergActDesc, dispActDesc *actor.Descriptor, sp *saramaProducer) {
+	actor.Spawn(mergActDesc, &p.wg, func() {
+		p.runMerger(sp)
+		// Close the result channel to notify the `dispatcher` goroutine that
+		// all pending messages have been processed.
+		close(sp.responseCh)
+	})
+	actor.Spawn(dispActDesc, &p.wg, func() {
+		p.runDispatcher(dispActDesc, sp)
+	})
+}
+
 // merge receives both message acknowledgements and producer errors from the
 // respective `sarama.AsyncProducer` channels, constructs `ProducerResult`s out
 // of them and sends the constructed `ProducerResult` instances to `responseCh`
 // to be further inspected by the `dispatcher` goroutine.
 //
 // It keeps running until both `sarama.AsyncProducer` output channels are
-// closed. Then it closes the `responseCh` to notify the `dispatcher` goroutine
-// that all pending messages have been processed and exits.
-func (p *T) runMerger() {
-	nilOrProdSuccessesCh := p.saramaProducer.Successes()
-	nilOrProdErrorsCh := p.saramaProducer.Errors()
+// closed.
+func (p *T) runMerger(sp *saramaProducer) {
+	nilOrProdSuccessesCh := sp.producer.Successes()
+	nilOrProdErrorsCh := sp.producer.Errors()
 mergeLoop:
 	for channelsOpened := 2; channelsOpened > 0; {
 		select {
@@ -125,29 +344,26 @@ mergeLoop:
 				nilOrProdSuccessesCh = nil
 				continue mergeLoop
 			}
-			p.responseCh <- Response{Msg: ackedMsg}
+			sp.responseCh <- Response{Msg: ackedMsg}
 		case prodErr, ok := <-nilOrProdErrorsCh:
 			if !ok {
 				channelsOpened -= 1
 				nilOrProdErrorsCh = nil
 				continue mergeLoop
 			}
-			p.responseCh <- Response{Msg: prodErr.Msg, Err: prodErr.Err}
+			sp.responseCh <- Response{Msg: prodErr.Msg, Err: prodErr.Err}
 		}
 	}
-	// Close the result channel to notify the `dispatcher` goroutine that all
-	// pending messages have been processed.
-	close(p.responseCh)
 }
 
 // dispatch implements message processing and graceful shutdown. It receives
-// messages from `dispatchedCh` where they are send to by `Produce` method and
-// submits them to the embedded `sarama.AsyncProducer`. The dispatcher main
-// purpose is to prevent loss of messages during shutdown. It achieves that by
-// allowing some graceful period after it stops receiving messages and stopping
-// the embedded `sarama.AsyncProducer`.
-func (p *T) runDispatcher() {
-	nilOrDispatcherCh := p.dispatcherCh
+// messages from `dispatchedCh` of a sarama producer where they are send to by
+// `Produce` method and submits them to the `sarama.AsyncProducer`. The
+// dispatcher main purpose is to prevent loss of messages during shutdown. It
+// achieves that by allowing some graceful period after it stops receiving
+// messages and stopping the `sarama.AsyncProducer`.
+func (p *T) runDispatcher(actDesc *actor.Descriptor, sp *saramaProducer) {
+	nilOrDispatcherCh := sp.dispatcherCh
 	var nilOrProdInputCh chan<- *sarama.ProducerMessage
 	pendingMsgCount := 0
 	// The normal operation loop is implemented as two-stroke machine. On the
@@ -164,53 +380,121 @@ func (p *T) runDispatcher() {
 			}
 			pendingMsgCount += 1
 			nilOrDispatcherCh = nil
-			nilOrProdInputCh = p.saramaProducer.Input()
+			nilOrProdInputCh = sp.producer.Input()
 		case nilOrProdInputCh <- prodMsg:
-			nilOrDispatcherCh = p.dispatcherCh
+			nilOrDispatcherCh = sp.dispatcherCh
 			nilOrProdInputCh = nil
-		case prodResult := <-p.responseCh:
+		case prodResult := <-sp.responseCh:
 			pendingMsgCount -= 1
 			p.handleProduceResult(prodResult)
 		}
 	}
 gracefulShutdown:
 	// Give the `sarama.AsyncProducer` some time to commit buffered messages.
-	p.dispActDesc.Log().Infof("About to stop producer: pendingMsgCount=%d", pendingMsgCount)
+	actDesc.Log().Infof("About to stop producer: pendingMsgCount=%d", pendingMsgCount)
 	shutdownTimeoutCh := time.After(p.shutdownTimeout)
 	for pendingMsgCount > 0 {
 		select {
 		case <-shutdownTimeoutCh:
 			goto shutdownNow
-		case prodResult := <-p.responseCh:
+		case prodResult := <-sp.responseCh:
 			pendingMsgCount -= 1
 			p.handleProduceResult(prodResult)
 		}
 	}
 shutdownNow:
-	p.dispActDesc.Log().Infof("Stopping producer: pendingMsgCount=%d", pendingMsgCount)
-	p.saramaProducer.AsyncClose()
-	for prodResult := range p.responseCh {
+	actDesc.Log().Infof("Stopping producer: pendingMsgCount=%d", pendingMsgCount)
+	sp.producer.AsyncClose()
+	for prodResult := range sp.responseCh {
 		p.handleProduceResult(prodResult)
 	}
 }
 
 // handleProduceResult inspects a production results and if it is an error
-// then logs it.
+// then logs it and passes fire-and-forget messages to the dead letter handler.
 func (p *T) handleProduceResult(result Response) {
-	if replyCh, ok := result.Msg.Metadata.(chan Response); ok {
+	replyCh, sync := result.Msg.Metadata.(chan Response)
+	if sync {
 		replyCh <- result
 	}
+	if report, ok := result.Msg.Metadata.(Reporter); ok {
+		report(result)
+		sync = true
+	}
+	if chunked, ok := result.Msg.Metadata.(*chunkedMsg); ok {
+		p.handleChunkResult(result, chunked)
+		sync = true
+	}
+	if spooled, ok := result.Msg.Metadata.(*spooledMsg); ok {
+		if p.handleSpooledResult(result, spooled) {
+			return
+		}
+	}
 	if result.Err == nil {
 		return
 	}
 	prodMsgRepr := fmt.Sprintf(`{Topic: "%s", Key: "%s", Value: "%s"}`,
 		result.Msg.Topic, encoderRepr(result.Msg.Key), encoderRepr(result.Msg.Value))
 	p.dispActDesc.Log().WithError(result.Err).Errorf("Failed to submit message: msg=%v", prodMsgRepr)
+	// Failures of messages that have a reply channel are reported to the
+	// caller, so only fire-and-forget messages are considered dead.
+	if !sync {
+		p.deadLetterer.handle(result)
+	}
 	if p.testDroppedMsgCh != nil {
 		p.testDroppedMsgCh <- result.Msg
 	}
 }
 
+// saramaProducers returns the default and all topic sarama producers.
+func (p *T) saramaProducers() []*saramaProducer {
+	sps := make([]*saramaProducer, 0, 1+len(p.topicProducers))
+	sps = append(sps, p.defaultProducer)
+	for _, topicProducer := range p.topicProducers {
+		sps = append(sps, topicProducer)
+	}
+	return sps
+}
+
+// producerOf returns a sarama producer that messages to a topic should be
+// submitted to.
+func (p *T) producerOf(topic string) *saramaProducer {
+	if topicProducer := p.topicProducers[topic]; topicProducer != nil {
+		return topicProducer
+	}
+	return p.defaultProducer
+}
+
+func newSaramaProducer(seedPeers []string, saramaCfg *sarama.Config, bufferSize int) (*saramaProducer, error) {
+	saramaCfg.Producer.Return.Successes = true
+	saramaCfg.Producer.Return.Errors = true
+	partitionerCtor := saramaCfg.Producer.Partitioner
+	saramaCfg.Producer.Partitioner = newExplicitPartitionerConstructor(partitionerCtor)
+
+	saramaClient, err := sarama.NewClient(seedPeers, saramaCfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create sarama.Client")
+	}
+	producer, err := sarama.NewAsyncProducerFromClient(saramaClient)
+	if err != nil {
+		saramaClient.Close()
+		return nil, errors.Wrap(err, "failed to create sarama.Producer")
+	}
+	return &saramaProducer{
+		client:          saramaClient,
+		producer:        producer,
+		partitionerCtor: partitionerCtor,
+		dispatcherCh:    make(chan *sarama.ProducerMessage, bufferSize),
+		responseCh:      make(chan Response, bufferSize),
+	}, nil
+}
+
+// close closes the sarama producer and its client.
+func (sp *saramaProducer) close() {
+	sp.producer.Close()
+	sp.client.Close()
+}
+
 // encoderRepr returns the string representation of an encoder value. The value
 // is truncated to `maxEncoderReprLength`.
 func encoderRepr(e sarama.Encoder) string {
@@ -1,8 +1,14 @@
 package producer
 
 import (
+	"encoding/json"
+	"expvar"
+	"io/ioutil"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/Shopify/sarama"
 	"github.com/mailgun/kafka-pixy/actor"
@@ -103,6 +109,101 @@ func (s *ProducerSuite) TestProduceInvalidTopic(c *C) {
 	p.Stop()
 }
 
+// If a partition is explicitly specified, then a message is written to it
+// regardless of the key.
+func (s *ProducerSuite) TestProduceToPartition(c *C) {
+	p, _ := Spawn(s.ns, s.cfg)
+	offsetsBefore := s.kh.GetNewestOffsets("test.4")
+
+	// When
+	rs := <-p.AsyncProduceToPartition("test.4", 3, sarama.StringEncoder("1"), sarama.StringEncoder("Foo"), nil, time.Time{})
+
+	// Then
+	c.Assert(rs.Err, IsNil)
+	c.Check(rs.Msg.Partition, Equals, int32(3))
+	c.Check(rs.Msg.Offset, Equals, offsetsBefore[3])
+	offsetsAfter := s.kh.GetNewestOffsets("test.4")
+	c.Check(offsetsAfter[0], Equals, offsetsBefore[0])
+	c.Check(offsetsAfter[3], Equals, offsetsBefore[3]+1)
+
+	// Cleanup
+	p.Stop()
+}
+
+func (s *ProducerSuite) TestProduceToPartitionOutOfRange(c *C) {
+	p, _ := Spawn(s.ns, s.cfg)
+
+	// When
+	rs := <-p.AsyncProduceToPartition("test.4", 4, sarama.StringEncoder("1"), sarama.StringEncoder("Foo"), nil, time.Time{})
+
+	// Then
+	c.Assert(rs.Err, Equals, sarama.ErrInvalidPartition)
+
+	// Cleanup
+	p.Stop()
+}
+
+// Results of messages produced with a reporter are passed to the reporter.
+func (s *ProducerSuite) TestAsyncProduceReported(c *C) {
+	p, _ := Spawn(s.ns, s.cfg)
+	offsetsBefore := s.kh.GetNewestOffsets("test.4")
+	reportCh := make(chan Response, 2)
+	report := func(rs Response) { reportCh <- rs }
+
+	// When
+	err1 := p.AsyncProduceReported("test.4", 2, sarama.StringEncoder("1"), sarama.StringEncoder("Foo"), nil, time.Time{}, report)
+	err2 := p.AsyncProduceReported("test.4", 4, sarama.StringEncoder("1"), sarama.StringEncoder("Bar"), nil, time.Time{}, report)
+
+	// Then
+	c.Assert(err1, IsNil)
+	c.Assert(err2, IsNil)
+	rs1, rs2 := <-reportCh, <-reportCh
+	if rs1.Err != nil {
+		rs1, rs2 = rs2, rs1
+	}
+	c.Check(rs1.Err, IsNil)
+	c.Check(rs1.Msg.Partition, Equals, int32(2))
+	c.Check(rs1.Msg.Offset, Equals, offsetsBefore[2])
+	c.Check(rs2.Err, Equals, sarama.ErrInvalidPartition)
+
+	// Cleanup
+	p.Stop()
+}
+
+// Messages that failed to be produced asynchronously are appended to the
+// dead letter file along with the failure reason.
+func (s *ProducerSuite) TestDeadLetterFile(c *C) {
+	s.cfg.Producer.DeadLetter.File = filepath.Join(c.MkDir(), "dead.ndjson")
+	p, _ := Spawn(s.ns, s.cfg)
+	countBefore := deadMsgCount(deadLetterOutcomeFile)
+
+	// When
+	err := p.AsyncProduceDurable("test.4", 4, sarama.StringEncoder("1"), sarama.StringEncoder("Foo"), nil, time.Time{})
+	c.Assert(err, IsNil)
+	p.Stop()
+
+	// Then
+	data, err := ioutil.ReadFile(s.cfg.Producer.DeadLetter.File)
+	c.Assert(err, IsNil)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	c.Assert(lines, HasLen, 1)
+	var rec deadMsgRecord
+	c.Assert(json.Unmarshal([]byte(lines[0]), &rec), IsNil)
+	c.Check(rec.Topic, Equals, "test.4")
+	c.Check(string(rec.Key), Equals, "1")
+	c.Check(string(rec.Value), Equals, "Foo")
+	c.Check(rec.Error, Equals, sarama.ErrInvalidPartition.Error())
+	c.Check(deadMsgCount(deadLetterOutcomeFile), Equals, countBefore+1)
+}
+
+// Dead message reasons are taken from a fixed set regardless of error details.
+func (s *ProducerSuite) TestDeadLetterReason(c *C) {
+	c.Check(deadLetterReason(errors.Wrap(sarama.ErrInvalidPartition, "foo/4")), Equals, sarama.ErrInvalidPartition.Error())
+	c.Check(deadLetterReason(sarama.ConfigurationError("bad key")), Equals, deadLetterReasonConfig)
+	c.Check(deadLetterReason(errors.Wrap(sarama.ErrOutOfBrokers, "retries exhausted")), Equals, deadLetterReasonUnavailable)
+	c.Check(deadLetterReason(errors.New("failed to encode message 12345")), Equals, deadLetterReasonOther)
+}
+
 // If `key` is not `nil` then produced messages are deterministically
 // distributed between partitions based on the `key` hash.
 func (s *ProducerSuite) TestAsyncProduce(c *C) {
@@ -214,3 +315,10 @@ func (s *ProducerSuite) failedMessages() []string {
 done:
 	return b
 }
+
+func deadMsgCount(outcome string) int64 {
+	if count, ok := deadMsgCounts.Get(outcome).(*expvar.Int); ok {
+		return count.Value()
+	}
+	return 0
+}
@@ -0,0 +1,616 @@
+// Package spool implements a write-ahead log that producer uses to persist
+// messages produced in async mode until they are confirmed by Kafka.
+//
+// The spool is a sequence of segment files. Messages are appended to the last
+// segment, and when it grows over the configured size a new one is started.
+// A segment file is deleted as soon as all messages in it are acknowledged.
+// Until then acknowledgements are appended to an ack file kept next to the
+// segment file, so that when a spool is opened only messages that have not
+// been acknowledged are replayed. Ack files are not synced to disk, hence
+// messages acknowledged shortly before an operating system crash or a power
+// loss can still be replayed.
+package spool
+
+import (
+	"bufio"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/mailgun/kafka-pixy/actor"
+	"github.com/mailgun/kafka-pixy/config"
+	"github.com/mailgun/kafka-pixy/none"
+	"github.com/pkg/errors"
+)
+
+const (
+	segmentFileExt    = ".spool"
+	ackFileExt        = ".acks"
+	ackEntrySize      = 4
+	recordHeaderSize  = 8
+	maxRecordSize     = 1 << 30
+	fsyncPolicyAlways = "always"
+	fsyncPolicyPeriod = "interval"
+)
+
+var (
+	ErrFull   = errors.New("producer spool is full")
+	ErrClosed = errors.New("producer spool is closed")
+)
+
+// Record is a message persisted in the spool.
+type Record struct {
+	Topic     string
+	Partition int32
+	Key       []byte
+	Value     []byte
+	Headers   []sarama.RecordHeader
+	Timestamp time.Time
+}
+
+// Ref identifies a record by the segment that it was appended to and its
+// index in the segment. It has to be passed to `Ack` when the record is
+// confirmed by Kafka.
+type Ref struct {
+	seq   uint64
+	index uint32
+}
+
+// T is a producer spool instance.
+type T struct {
+	actDesc     *actor.Descriptor
+	dir         string
+	segmentSize int64
+	maxSize     int64
+	fsyncPolicy string
+	stopCh      chan none.T
+	wg          sync.WaitGroup
+
+	mu         sync.Mutex
+	segments   map[uint64]*segment
+	active     *segment
+	activeFile *os.File
+	dirty      bool
+	totalSize  int64
+	nextSeq    uint64
+	replaySeqs []uint64
+	closed     bool
+}
+
+type segment struct {
+	seq     uint64
+	path    string
+	size    int64
+	count   uint32
+	pending int
+	sealed  bool
+	// Indexes of records acknowledged by a previous spool instance. It is
+	// only set for segments to be replayed.
+	acked   map[uint32]bool
+	ackFile *os.File
+}
+
+// Open opens a spool in the directory specified in the config creating the
+// directory if it does not exist. Segments left in the directory are sealed,
+// and their records can be read with `Replay`.
+func Open(actDesc *actor.Descriptor, cfg *config.Proxy) (*T, error) {
+	spoolCfg := cfg.Producer.Spool
+	if err := os.MkdirAll(spoolCfg.Dir, 0755); err != nil {
+		return nil, errors.Wrap(err, "failed to create spool directory")
+	}
+	s := &T{
+		actDesc:     actDesc,
+		dir:         spoolCfg.Dir,
+		segmentSize: spoolCfg.SegmentSize,
+		maxSize:     spoolCfg.MaxSize,
+		fsyncPolicy: spoolCfg.Fsync,
+		stopCh:      make(chan none.T),
+		segments:    make(map[uint64]*segment),
+	}
+	if err := s.loadSegments(); err != nil {
+		return nil, err
+	}
+	if s.fsyncPolicy == fsyncPolicyPeriod {
+		actor.Spawn(s.actDesc, &s.wg, func() {
+			s.runSyncer(spoolCfg.FsyncInterval)
+		})
+	}
+	return s, nil
+}
+
+// Append persists a record in the spool and returns a reference that should
+// be used to acknowledge the record. If the total size of segment files would
+// exceed the configured maximum, then `ErrFull` is returned.
+func (s *T) Append(rec Record) (Ref, error) {
+	data := encodeRecord(rec)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return Ref{}, ErrClosed
+	}
+	if s.totalSize+int64(len(data)) > s.maxSize {
+		return Ref{}, ErrFull
+	}
+	if s.active != nil && s.active.size > 0 && (s.active.size+int64(len(data)) > s.segmentSize || s.active.count == math.MaxUint32) {
+		if err := s.sealActive(); err != nil {
+			return Ref{}, err
+		}
+	}
+	if s.active == nil {
+		if err := s.startSegment(); err != nil {
+			return Ref{}, err
+		}
+	}
+	if _, err := s.activeFile.Write(data); err != nil {
+		return Ref{}, errors.Wrap(err, "failed to write spool segment")
+	}
+	ref := Ref{seq: s.active.seq, index: s.active.count}
+	s.active.size += int64(len(data))
+	s.active.count += 1
+	s.active.pending += 1
+	s.totalSize += int64(len(data))
+	switch s.fsyncPolicy {
+	case fsyncPolicyAlways:
+		if err := s.activeFile.Sync(); err != nil {
+			return Ref{}, errors.Wrap(err, "failed to sync spool segment")
+		}
+	case fsyncPolicyPeriod:
+		s.dirty = true
+	}
+	return ref, nil
+}
+
+// Ack acknowledges a record, meaning that it does not need to be replayed
+// anymore. When all records of a segment are acknowledged its file is deleted,
+// otherwise the acknowledgement is recorded in the segment ack file.
+func (s *T) Ack(ref Ref) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	seg := s.segments[ref.seq]
+	if seg == nil {
+		return
+	}
+	seg.pending -= 1
+	if seg.pending > 0 {
+		if err := s.writeAck(seg, ref.index); err != nil {
+			s.actDesc.Log().WithError(err).Errorf("Failed to write spool ack: %s", seg.path)
+		}
+		return
+	}
+	if seg == s.active {
+		// It is closed here, so that a spool that is emptied does not leave
+		// acknowledged records behind to be replayed on restart.
+		if err := s.sealActive(); err != nil {
+			s.actDesc.Log().WithError(err).Errorf("Failed to seal spool segment: %s", seg.path)
+		}
+		return
+	}
+	s.removeSegment(seg)
+}
+
+// Replay reads records from the segments left in the spool directory by a
+// previous spool instance and calls `fn` for each of them, skipping records
+// that were acknowledged. It stops if `fn` returns false. Replayed records
+// should be acknowledged as usual.
+func (s *T) Replay(fn func(ref Ref, rec Record) bool) error {
+	s.mu.Lock()
+	replaySeqs := s.replaySeqs
+	s.replaySeqs = nil
+	acked := make(map[uint64]map[uint32]bool, len(replaySeqs))
+	for _, seq := range replaySeqs {
+		if seg := s.segments[seq]; seg != nil {
+			acked[seq] = seg.acked
+			seg.acked = nil
+		}
+	}
+	s.mu.Unlock()
+
+	for _, seq := range replaySeqs {
+		recs, err := readSegment(s.segmentPath(seq))
+		if err != nil {
+			return errors.Wrapf(err, "failed to read spool segment %d", seq)
+		}
+		for i, rec := range recs {
+			if acked[seq][uint32(i)] {
+				continue
+			}
+			if !fn(Ref{seq: seq, index: uint32(i)}, rec) {
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// Size returns the total size of all spool segment files.
+func (s *T) Size() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.totalSize
+}
+
+// Close syncs and closes the active segment file. Segments with records that
+// have not been acknowledged are left on disk along with their ack files, so
+// that the next spool instance replays only the records that have not been
+// acknowledged.
+func (s *T) Close() {
+	close(s.stopCh)
+	s.wg.Wait()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	if seg := s.active; seg != nil {
+		if err := s.sealActive(); err != nil {
+			s.actDesc.Log().WithError(err).Errorf("Failed to seal spool segment: %s", seg.path)
+		}
+	}
+	for _, seg := range s.segments {
+		s.closeAckFile(seg)
+	}
+}
+
+func (s *T) runSyncer(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.mu.Lock()
+			if s.dirty && s.activeFile != nil {
+				if err := s.activeFile.Sync(); err != nil {
+					s.actDesc.Log().WithError(err).Errorf("Failed to sync spool segment: %s", s.active.path)
+				}
+				s.dirty = false
+			}
+			s.mu.Unlock()
+		case <-s.stopCh:
+			return
+		}
+	}
+}
+
+// loadSegments registers segment files found in the spool directory as
+// sealed segments to be replayed. Segments with no valid records that have
+// not been acknowledged are deleted, and so are ack files of missing segments.
+func (s *T) loadSegments() error {
+	fileInfos, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return errors.Wrap(err, "failed to list spool directory")
+	}
+	var seqs []uint64
+	ackFileSeqs := make(map[uint64]bool)
+	for _, fileInfo := range fileInfos {
+		name := fileInfo.Name()
+		if fileInfo.IsDir() {
+			continue
+		}
+		ext := filepath.Ext(name)
+		if ext != segmentFileExt && ext != ackFileExt {
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, ext), 10, 64)
+		if err != nil {
+			continue
+		}
+		if ext == ackFileExt {
+			ackFileSeqs[seq] = true
+			continue
+		}
+		seqs = append(seqs, seq)
+	}
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+
+	for _, seq := range seqs {
+		delete(ackFileSeqs, seq)
+		path := s.segmentPath(seq)
+		recs, err := readSegment(path)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read spool segment %d", seq)
+		}
+		acked, err := readAcks(s.ackPath(seq), len(recs))
+		if err != nil {
+			return errors.Wrapf(err, "failed to read spool acks %d", seq)
+		}
+		seg := &segment{seq: seq, path: path, count: uint32(len(recs)), pending: len(recs) - len(acked), sealed: true, acked: acked}
+		if fileInfo, err := os.Stat(path); err == nil {
+			seg.size = fileInfo.Size()
+		}
+		if seq >= s.nextSeq {
+			s.nextSeq = seq + 1
+		}
+		if seg.pending == 0 {
+			s.removeSegment(seg)
+			continue
+		}
+		s.segments[seq] = seg
+		s.totalSize += seg.size
+		s.replaySeqs = append(s.replaySeqs, seq)
+	}
+	for seq := range ackFileSeqs {
+		if err := os.Remove(s.ackPath(seq)); err != nil && !os.IsNotExist(err) {
+			s.actDesc.Log().WithError(err).Errorf("Failed to remove spool ack file: %s", s.ackPath(seq))
+		}
+	}
+	if len(s.replaySeqs) > 0 {
+		s.actDesc.Log().Infof("Spool segments to replay: count=%d, size=%d", len(s.replaySeqs), s.totalSize)
+	}
+	return nil
+}
+
+func (s *T) startSegment() error {
+	seg := &segment{seq: s.nextSeq, path: s.segmentPath(s.nextSeq)}
+	file, err := os.OpenFile(seg.path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return errors.Wrap(err, "failed to create spool segment")
+	}
+	s.nextSeq += 1
+	s.segments[seg.seq] = seg
+	s.active = seg
+	s.activeFile = file
+	return nil
+}
+
+// sealActive closes the active segment file. If all records of the segment
+// have already been acknowledged then the file is deleted.
+func (s *T) sealActive() error {
+	seg := s.active
+	err := s.activeFile.Sync()
+	if closeErr := s.activeFile.Close(); err == nil {
+		err = closeErr
+	}
+	s.active = nil
+	s.activeFile = nil
+	s.dirty = false
+	seg.sealed = true
+	if seg.pending <= 0 {
+		s.removeSegment(seg)
+	}
+	return err
+}
+
+func (s *T) removeSegment(seg *segment) {
+	// The segment file is removed first, for an ack file without a segment
+	// is ignored, while a segment without its ack file is replayed entirely.
+	if err := os.Remove(seg.path); err != nil && !os.IsNotExist(err) {
+		s.actDesc.Log().WithError(err).Errorf("Failed to remove spool segment: %s", seg.path)
+	}
+	s.closeAckFile(seg)
+	if err := os.Remove(s.ackPath(seg.seq)); err != nil && !os.IsNotExist(err) {
+		s.actDesc.Log().WithError(err).Errorf("Failed to remove spool ack file: %s", s.ackPath(seg.seq))
+	}
+	if _, ok := s.segments[seg.seq]; ok {
+		delete(s.segments, seg.seq)
+		s.totalSize -= seg.size
+	}
+}
+
+// writeAck appends the index of an acknowledged record to the segment ack
+// file, opening the file if necessary.
+func (s *T) writeAck(seg *segment, index uint32) error {
+	if seg.ackFile == nil {
+		file, err := os.OpenFile(s.ackPath(seg.seq), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			return err
+		}
+		seg.ackFile = file
+	}
+	var entry [ackEntrySize]byte
+	binary.BigEndian.PutUint32(entry[:], index)
+	_, err := seg.ackFile.Write(entry[:])
+	return err
+}
+
+func (s *T) closeAckFile(seg *segment) {
+	if seg.ackFile == nil {
+		return
+	}
+	if err := seg.ackFile.Close(); err != nil {
+		s.actDesc.Log().WithError(err).Errorf("Failed to close spool ack file: %s", s.ackPath(seg.seq))
+	}
+	seg.ackFile = nil
+}
+
+func (s *T) segmentPath(seq uint64) string {
+	return filepath.Join(s.dir, strconv.FormatUint(seq, 10)+segmentFileExt)
+}
+
+func (s *T) ackPath(seq uint64) string {
+	return filepath.Join(s.dir, strconv.FormatUint(seq, 10)+ackFileExt)
+}
+
+// readAcks returns the set of record indexes listed in an ack file. Indexes
+// that are out of the segment record range, and a truncated trailing entry
+// are ignored. A missing ack file means that no records were acknowledged.
+func readAcks(path string, recCount int) (map[uint32]bool, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	acked := make(map[uint32]bool)
+	for ; len(data) >= ackEntrySize; data = data[ackEntrySize:] {
+		if index := binary.BigEndian.Uint32(data); int(index) < recCount {
+			acked[index] = true
+		}
+	}
+	return acked, nil
+}
+
+// readSegment reads all valid records from a segment file. A truncated or
+// corrupted record and everything after it is ignored, since it can only be
+// the result of a crash in the middle of a write.
+func readSegment(path string) ([]Record, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var recs []Record
+	r := bufio.NewReader(file)
+	var header [recordHeaderSize]byte
+	for {
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			return recs, nil
+		}
+		size := binary.BigEndian.Uint32(header[:4])
+		checksum := binary.BigEndian.Uint32(header[4:])
+		if size > maxRecordSize {
+			return recs, nil
+		}
+		payload := make([]byte, size)
+		if _, err := io.ReadFull(r, payload); err != nil {
+			return recs, nil
+		}
+		if crc32.ChecksumIEEE(payload) != checksum {
+			return recs, nil
+		}
+		rec, err := decodeRecord(payload)
+		if err != nil {
+			return recs, nil
+		}
+		recs = append(recs, rec)
+	}
+}
+
+// encodeRecord returns a record binary representation prefixed with its size
+// and checksum.
+func encodeRecord(rec Record) []byte {
+	buf := make([]byte, recordHeaderSize, recordHeaderSize+len(rec.Topic)+len(rec.Key)+len(rec.Value)+64)
+	buf = appendBytes(buf, []byte(rec.Topic))
+	buf = appendVarint(buf, int64(rec.Partition))
+	if rec.Key == nil {
+		buf = append(buf, 0)
+	} else {
+		buf = append(buf, 1)
+		buf = appendBytes(buf, rec.Key)
+	}
+	buf = appendBytes(buf, rec.Value)
+	buf = appendUvarint(buf, uint64(len(rec.Headers)))
+	for _, h := range rec.Headers {
+		buf = appendBytes(buf, h.Key)
+		buf = appendBytes(buf, h.Value)
+	}
+	var timestamp int64
+	if !rec.Timestamp.IsZero() {
+		timestamp = rec.Timestamp.UnixNano()
+	}
+	buf = appendVarint(buf, timestamp)
+	payload := buf[recordHeaderSize:]
+	binary.BigEndian.PutUint32(buf[:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(buf[4:8], crc32.ChecksumIEEE(payload))
+	return buf
+}
+
+func decodeRecord(payload []byte) (Record, error) {
+	d := decoder{buf: payload}
+	rec := Record{Topic: string(d.bytes())}
+	rec.Partition = int32(d.varint())
+	if d.byte() != 0 {
+		rec.Key = d.bytes()
+	}
+	rec.Value = d.bytes()
+	headerCount := d.uvarint()
+	if d.err == nil && headerCount > uint64(len(payload)) {
+		d.err = errors.New("bad header count")
+	}
+	for i := uint64(0); i < headerCount && d.err == nil; i++ {
+		rec.Headers = append(rec.Headers, sarama.RecordHeader{Key: d.bytes(), Value: d.bytes()})
+	}
+	if timestamp := d.varint(); timestamp != 0 {
+		rec.Timestamp = time.Unix(0, timestamp)
+	}
+	return rec, d.err
+}
+
+func appendUvarint(buf []byte, v uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], v)
+	return append(buf, tmp[:n]...)
+}
+
+func appendVarint(buf []byte, v int64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(tmp[:], v)
+	return append(buf, tmp[:n]...)
+}
+
+func appendBytes(buf, b []byte) []byte {
+	buf = appendUvarint(buf, uint64(len(b)))
+	return append(buf, b...)
+}
+
+// decoder reads fields from a record payload. Once an error occurs all
+// subsequent reads return zero values.
+type decoder struct {
+	buf []byte
+	err error
+}
+
+func (d *decoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.buf)
+	if n <= 0 {
+		d.err = errors.New("bad uvarint")
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *decoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.buf)
+	if n <= 0 {
+		d.err = errors.New("bad varint")
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *decoder) byte() byte {
+	if d.err != nil {
+		return 0
+	}
+	if len(d.buf) < 1 {
+		d.err = errors.New("unexpected end of record")
+		return 0
+	}
+	b := d.buf[0]
+	d.buf = d.buf[1:]
+	return b
+}
+
+func (d *decoder) bytes() []byte {
+	size := d.uvarint()
+	if d.err != nil {
+		return nil
+	}
+	if size > uint64(len(d.buf)) {
+		d.err = errors.New("unexpected end of record")
+		return nil
+	}
+	b := make([]byte, size)
+	copy(b, d.buf[:size])
+	d.buf = d.buf[size:]
+	return b
+}
@@ -0,0 +1,246 @@
+package spool
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/mailgun/kafka-pixy/actor"
+	"github.com/mailgun/kafka-pixy/config"
+	"github.com/mailgun/kafka-pixy/testhelpers"
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+type SpoolSuite struct {
+	ns  *actor.Descriptor
+	cfg *config.Proxy
+}
+
+var _ = Suite(&SpoolSuite{})
+
+func (s *SpoolSuite) SetUpSuite(c *C) {
+	testhelpers.InitLogging()
+}
+
+func (s *SpoolSuite) SetUpTest(c *C) {
+	s.ns = actor.Root().NewChild("T")
+	s.cfg = config.DefaultProxy()
+	s.cfg.Producer.Spool.Dir = c.MkDir()
+	s.cfg.Producer.Spool.Fsync = "always"
+}
+
+// Records that have not been acknowledged are replayed by a spool opened in
+// the same directory, preserving all record fields.
+func (s *SpoolSuite) TestReplay(c *C) {
+	sp, err := Open(s.ns, s.cfg)
+	c.Assert(err, IsNil)
+	recs := []Record{
+		{Topic: "foo", Partition: -1, Key: []byte("bar"), Value: []byte("msg1"), Timestamp: time.Unix(0, 1614834367008000000)},
+		{Topic: "foo", Partition: 3, Key: nil, Value: []byte("msg2")},
+		{Topic: "foo", Partition: 5, Key: nil, Value: []byte("msg3")},
+		{Topic: "bar", Partition: -1, Key: []byte{}, Value: []byte{},
+			Headers: []sarama.RecordHeader{{Key: []byte("h1"), Value: []byte("v1")}, {Key: []byte("h2"), Value: nil}}},
+	}
+	var refs []Ref
+	for _, rec := range recs {
+		ref, err := sp.Append(rec)
+		c.Assert(err, IsNil)
+		refs = append(refs, ref)
+	}
+	sp.Ack(refs[2])
+	sp.Close()
+
+	// When
+	sp, err = Open(s.ns, s.cfg)
+	c.Assert(err, IsNil)
+	defer sp.Close()
+	var replayed []Record
+	err = sp.Replay(func(ref Ref, rec Record) bool {
+		replayed = append(replayed, rec)
+		return true
+	})
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Assert(len(replayed), Equals, 3)
+	c.Check(replayed[0], DeepEquals, recs[0])
+	c.Check(replayed[1].Key, IsNil)
+	c.Check(replayed[1].Partition, Equals, int32(3))
+	c.Check(replayed[2].Key, DeepEquals, []byte{})
+	c.Check(replayed[2].Headers, DeepEquals, []sarama.RecordHeader{
+		{Key: []byte("h1"), Value: []byte("v1")}, {Key: []byte("h2"), Value: []byte{}}})
+}
+
+// Acknowledgements are persisted, so records acknowledged by any of previous
+// spool instances are not replayed again.
+func (s *SpoolSuite) TestReplaySkipsAcked(c *C) {
+	sp, err := Open(s.ns, s.cfg)
+	c.Assert(err, IsNil)
+	var refs []Ref
+	for _, value := range []string{"msg1", "msg2", "msg3", "msg4"} {
+		ref, err := sp.Append(Record{Topic: "foo", Value: []byte(value)})
+		c.Assert(err, IsNil)
+		refs = append(refs, ref)
+	}
+	sp.Ack(refs[1])
+	sp.Close()
+	sp, err = Open(s.ns, s.cfg)
+	c.Assert(err, IsNil)
+	err = sp.Replay(func(ref Ref, rec Record) bool {
+		if string(rec.Value) == "msg3" {
+			sp.Ack(ref)
+		}
+		return true
+	})
+	c.Assert(err, IsNil)
+	sp.Close()
+
+	// When
+	sp, err = Open(s.ns, s.cfg)
+	c.Assert(err, IsNil)
+	defer sp.Close()
+	var replayed []string
+	var replayedRefs []Ref
+	err = sp.Replay(func(ref Ref, rec Record) bool {
+		replayed = append(replayed, string(rec.Value))
+		replayedRefs = append(replayedRefs, ref)
+		return true
+	})
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Check(replayed, DeepEquals, []string{"msg1", "msg4"})
+	for _, ref := range replayedRefs {
+		sp.Ack(ref)
+	}
+	c.Check(segmentFiles(c, s.cfg.Producer.Spool.Dir), HasLen, 0)
+}
+
+// When all records are acknowledged no segment files are left behind.
+func (s *SpoolSuite) TestAckRemovesSegments(c *C) {
+	s.cfg.Producer.Spool.SegmentSize = 64
+	sp, err := Open(s.ns, s.cfg)
+	c.Assert(err, IsNil)
+	var refs []Ref
+	for i := 0; i < 10; i++ {
+		ref, err := sp.Append(Record{Topic: "foo", Value: []byte("0123456789012345")})
+		c.Assert(err, IsNil)
+		refs = append(refs, ref)
+	}
+	c.Check(len(segmentFiles(c, s.cfg.Producer.Spool.Dir)) > 1, Equals, true)
+
+	// When
+	for _, ref := range refs {
+		sp.Ack(ref)
+	}
+
+	// Then
+	c.Check(segmentFiles(c, s.cfg.Producer.Spool.Dir), HasLen, 0)
+	c.Check(sp.Size(), Equals, int64(0))
+	sp.Close()
+	c.Check(segmentFiles(c, s.cfg.Producer.Spool.Dir), HasLen, 0)
+}
+
+// Only segments that have pending records are kept on disk.
+func (s *SpoolSuite) TestAckPartially(c *C) {
+	s.cfg.Producer.Spool.SegmentSize = 64
+	sp, err := Open(s.ns, s.cfg)
+	c.Assert(err, IsNil)
+	var refs []Ref
+	for i := 0; i < 10; i++ {
+		ref, err := sp.Append(Record{Topic: "foo", Value: []byte("0123456789012345")})
+		c.Assert(err, IsNil)
+		refs = append(refs, ref)
+	}
+
+	// When
+	for _, ref := range refs[:9] {
+		sp.Ack(ref)
+	}
+	sp.Close()
+
+	// Then
+	sp, err = Open(s.ns, s.cfg)
+	c.Assert(err, IsNil)
+	defer sp.Close()
+	count := 0
+	sp.Replay(func(ref Ref, rec Record) bool {
+		count++
+		return true
+	})
+	c.Check(count, Equals, 1)
+}
+
+// When the maximum spool size is reached new records are rejected.
+func (s *SpoolSuite) TestFull(c *C) {
+	s.cfg.Producer.Spool.SegmentSize = 64
+	s.cfg.Producer.Spool.MaxSize = 128
+	sp, err := Open(s.ns, s.cfg)
+	c.Assert(err, IsNil)
+	defer sp.Close()
+	var refs []Ref
+	for {
+		ref, err := sp.Append(Record{Topic: "foo", Value: []byte("0123456789012345")})
+		if err != nil {
+			c.Check(err, Equals, ErrFull)
+			break
+		}
+		refs = append(refs, ref)
+	}
+	c.Check(sp.Size() <= 128, Equals, true)
+
+	// When
+	sp.Ack(refs[0])
+	sp.Ack(refs[1])
+
+	// Then
+	_, err = sp.Append(Record{Topic: "foo", Value: []byte("0123456789012345")})
+	c.Check(err, IsNil)
+}
+
+// A record truncated by a crash is ignored on replay.
+func (s *SpoolSuite) TestTruncatedRecord(c *C) {
+	sp, err := Open(s.ns, s.cfg)
+	c.Assert(err, IsNil)
+	_, err = sp.Append(Record{Topic: "foo", Value: []byte("msg1")})
+	c.Assert(err, IsNil)
+	_, err = sp.Append(Record{Topic: "foo", Value: []byte("msg2")})
+	c.Assert(err, IsNil)
+	sp.Close()
+	files := segmentFiles(c, s.cfg.Producer.Spool.Dir)
+	c.Assert(files, HasLen, 1)
+	fileInfo, err := os.Stat(files[0])
+	c.Assert(err, IsNil)
+	c.Assert(os.Truncate(files[0], fileInfo.Size()-2), IsNil)
+
+	// When
+	sp, err = Open(s.ns, s.cfg)
+	c.Assert(err, IsNil)
+	defer sp.Close()
+	var replayed []Record
+	sp.Replay(func(ref Ref, rec Record) bool {
+		replayed = append(replayed, rec)
+		return true
+	})
+
+	// Then
+	c.Assert(len(replayed), Equals, 1)
+	c.Check(string(replayed[0].Value), Equals, "msg1")
+}
+
+func segmentFiles(c *C, dir string) []string {
+	fileInfos, err := ioutil.ReadDir(dir)
+	c.Assert(err, IsNil)
+	var files []string
+	for _, fileInfo := range fileInfos {
+		files = append(files, filepath.Join(dir, fileInfo.Name()))
+	}
+	return files
+}
@@ -0,0 +1,208 @@
+package producer
+
+import (
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/mailgun/kafka-pixy/none"
+	"github.com/mailgun/kafka-pixy/producer/spool"
+	"github.com/pkg/errors"
+)
+
+// spooledMsg is used as `sarama.ProducerMessage.Metadata` for messages that
+// are persisted in the spool.
+type spooledMsg struct {
+	ref       spool.Ref
+	partition int32
+}
+
+// spoolRetry is a spooled message scheduled for resubmission after a
+// retriable produce failure.
+type spoolRetry struct {
+	msg   *sarama.ProducerMessage
+	dueAt time.Time
+}
+
+// AsyncProduceDurable submits a message for asynchronous production. If the
+// producer spool is configured, then the message is persisted to disk before
+// this function returns, and it is resubmitted to Kafka until it is either
+// acknowledged or rejected with a permanent error, surviving Kafka-Pixy
+// restarts. `spool.ErrFull` is returned if the spool has reached its maximum
+// size. If the spool is not configured, then the message is submitted
+// without persisting, and `ErrBufferFull` is returned if the producer buffer
+// stays full for the configured timeout. Either way messages that fail
+// permanently are passed to the dead letter handler.
+func (p *T) AsyncProduceDurable(topic string, partition int32, key, message sarama.Encoder, headers []sarama.RecordHeader, timestamp time.Time) error {
+	msgs, err := p.newProducerMsgs(topic, partition, key, message, headers, timestamp)
+	if err != nil {
+		return err
+	}
+	if p.spool == nil {
+		for _, msg := range msgs {
+			if err := p.enqueue(msg); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// Chunks of a large message are spooled as separate messages.
+	for _, msg := range msgs {
+		if err := p.spoolAndDispatch(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *T) spoolAndDispatch(msg *sarama.ProducerMessage) error {
+	rec := spool.Record{
+		Topic:     msg.Topic,
+		Partition: msg.Partition,
+		Headers:   msg.Headers,
+		Timestamp: msg.Timestamp,
+	}
+	var err error
+	if msg.Key != nil {
+		if rec.Key, err = msg.Key.Encode(); err != nil {
+			return errors.Wrap(err, "failed to encode key")
+		}
+		if rec.Key == nil {
+			rec.Key = []byte{}
+		}
+	}
+	if msg.Value != nil {
+		if rec.Value, err = msg.Value.Encode(); err != nil {
+			return errors.Wrap(err, "failed to encode message")
+		}
+	}
+	ref, err := p.spool.Append(rec)
+	if err != nil {
+		return err
+	}
+	spooledMsg := newSpooledProducerMsg(ref, rec)
+	if err := p.enqueue(spooledMsg); err != nil {
+		// The message is safe in the spool, so rather than failing it is
+		// resubmitted later.
+		p.scheduleSpoolRetry(spooledMsg)
+	}
+	return nil
+}
+
+// handleSpooledResult acknowledges a spooled message in the spool if it was
+// either successfully produced or failed permanently. Otherwise it schedules
+// the message for resubmission. It returns true if the result should not be
+// reported as a failure.
+func (p *T) handleSpooledResult(result Response, spooled *spooledMsg) bool {
+	if result.Err == nil || isPermanentProduceError(result.Err) {
+		p.spool.Ack(spooled.ref)
+		return false
+	}
+	p.dispActDesc.Log().WithError(result.Err).Warnf(
+		"Spooled message will be retried: topic=%s", result.Msg.Topic)
+	p.scheduleSpoolRetry(&sarama.ProducerMessage{
+		Topic:     result.Msg.Topic,
+		Partition: spooled.partition,
+		Key:       result.Msg.Key,
+		Value:     result.Msg.Value,
+		Headers:   result.Msg.Headers,
+		Timestamp: result.Msg.Timestamp,
+		Metadata:  spooled,
+	})
+	return true
+}
+
+// scheduleSpoolRetry schedules a spooled message for resubmission after the
+// retry backoff.
+func (p *T) scheduleSpoolRetry(retryMsg *sarama.ProducerMessage) {
+	p.spoolRetryMu.Lock()
+	p.spoolRetries = append(p.spoolRetries, spoolRetry{msg: retryMsg, dueAt: time.Now().Add(p.spoolRetryBackoff)})
+	p.spoolRetryMu.Unlock()
+	select {
+	case p.spoolRetrySignalCh <- none.V:
+	default:
+	}
+}
+
+// runSpoolRetrier resubmits messages left in the spool by a previous producer
+// instance, and then keeps resubmitting spooled messages that failed with a
+// retriable error until the producer is stopped.
+func (p *T) runSpoolRetrier() {
+	err := p.spool.Replay(func(ref spool.Ref, rec spool.Record) bool {
+		spooledMsg := newSpooledProducerMsg(ref, rec)
+		select {
+		case p.producerOf(spooledMsg.Topic).dispatcherCh <- spooledMsg:
+			return true
+		case <-p.spoolStopCh:
+			return false
+		}
+	})
+	if err != nil {
+		p.spoolActDesc.Log().WithError(err).Error("Failed to replay spool")
+	}
+	var nilOrTimeoutCh <-chan time.Time
+	for {
+		select {
+		case <-p.spoolRetrySignalCh:
+		case <-nilOrTimeoutCh:
+		case <-p.spoolStopCh:
+			return
+		}
+		nilOrTimeoutCh = nil
+		now := time.Now()
+		p.spoolRetryMu.Lock()
+		var due []*sarama.ProducerMessage
+		pending := p.spoolRetries[:0]
+		var nextDueAt time.Time
+		for _, retry := range p.spoolRetries {
+			if !retry.dueAt.After(now) {
+				due = append(due, retry.msg)
+				continue
+			}
+			pending = append(pending, retry)
+			if nextDueAt.IsZero() || retry.dueAt.Before(nextDueAt) {
+				nextDueAt = retry.dueAt
+			}
+		}
+		p.spoolRetries = pending
+		p.spoolRetryMu.Unlock()
+
+		for _, msg := range due {
+			select {
+			case p.producerOf(msg.Topic).dispatcherCh <- msg:
+			case <-p.spoolStopCh:
+				return
+			}
+		}
+		if !nextDueAt.IsZero() {
+			nilOrTimeoutCh = time.After(nextDueAt.Sub(now))
+		}
+	}
+}
+
+func newSpooledProducerMsg(ref spool.Ref, rec spool.Record) *sarama.ProducerMessage {
+	msg := &sarama.ProducerMessage{
+		Topic:     rec.Topic,
+		Partition: rec.Partition,
+		Value:     sarama.ByteEncoder(rec.Value),
+		Headers:   rec.Headers,
+		Timestamp: rec.Timestamp,
+		Metadata:  &spooledMsg{ref: ref, partition: rec.Partition},
+	}
+	if rec.Key != nil {
+		msg.Key = sarama.ByteEncoder(rec.Key)
+	}
+	return msg
+}
+
+// isPermanentProduceError tells whether a produce error cannot be fixed by
+// resubmitting the message.
+func isPermanentProduceError(err error) bool {
+	switch errors.Cause(err) {
+	case sarama.ErrUnknownTopicOrPartition, sarama.ErrInvalidPartition,
+		sarama.ErrMessageSizeTooLarge, sarama.ErrInvalidMessage,
+		sarama.ErrInvalidTopic, sarama.ErrMessageSetSizeTooLarge:
+		return true
+	}
+	_, ok := errors.Cause(err).(sarama.ConfigurationError)
+	return ok
+}
@@ -0,0 +1,406 @@
+package producer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/pkg/errors"
+)
+
+const (
+	txnRetryBackoff = 100 * time.Millisecond
+)
+
+// ErrTxnDisabled is returned by `ProduceAtomic` if transactional IDs are not
+// configured for the producer.
+var ErrTxnDisabled = errors.New("transactional produce is disabled by configuration")
+
+// ProduceAtomic writes all the given messages to Kafka in a single
+// transaction, so either all of them become visible to `read_committed`
+// consumers or none does. Messages can be addressed to different topics. A
+// partition for every message is selected by the configured partitioner the
+// same way as it is done by `Produce`. On success the `Partition` and `Offset`
+// fields of all messages are updated to reflect where they were written to.
+//
+// The sarama version that we use does not support transactions, so the Kafka
+// transactional protocol is implemented here using sarama low level requests.
+func (p *T) ProduceAtomic(msgs []*sarama.ProducerMessage) error {
+	if p.txnIDPoolCh == nil {
+		return ErrTxnDisabled
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	txnID := <-p.txnIDPoolCh
+	defer func() { p.txnIDPoolCh <- txnID }()
+
+	t := txn{
+		id:      txnID,
+		client:  p.defaultProducer.client,
+		cfg:     p.defaultProducer.client.Config(),
+		timeout: p.txnTimeout,
+		partitionerCtor: func(topic string) sarama.Partitioner {
+			return p.producerOf(topic).partitionerCtor(topic)
+		},
+	}
+	defer t.closeCoordinator()
+	return t.run(msgs)
+}
+
+// txn represents a single Kafka transaction. A new producer epoch is
+// initialized for every transaction, therefore sequence numbers of all record
+// batches start from 0.
+type txn struct {
+	id      string
+	client  sarama.Client
+	cfg     *sarama.Config
+	timeout time.Duration
+	// A dedicated connection to the transaction coordinator owned by the
+	// transaction. Connections of the shared client are never used here,
+	// for they must not be closed on errors.
+	coordinator *sarama.Broker
+
+	// Selects partitions the same way as they are selected for messages
+	// produced to the same topics non atomically.
+	partitionerCtor sarama.PartitionerConstructor
+	producerID      int64
+	producerEpoch   int16
+}
+
+func (t *txn) run(msgs []*sarama.ProducerMessage) error {
+	if err := t.assignPartitions(msgs); err != nil {
+		return errors.Wrap(err, "failed to assign partitions")
+	}
+	batches, offsetDeltas, err := t.buildBatches(msgs)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode messages")
+	}
+	if err := t.initProducerID(); err != nil {
+		return errors.Wrap(err, "failed to init producer ID")
+	}
+	if err := t.addPartitions(batches); err != nil {
+		t.abort()
+		return errors.Wrap(err, "failed to add partitions to transaction")
+	}
+	baseOffsets, err := t.produce(batches)
+	if err != nil {
+		t.abort()
+		return errors.Wrap(err, "failed to produce")
+	}
+	if err := t.end(true); err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+	for i, msg := range msgs {
+		msg.Offset = baseOffsets[msg.Topic][msg.Partition] + offsetDeltas[i]
+	}
+	return nil
+}
+
+// assignPartitions selects a partition for every message using the configured
+// partitioner.
+func (t *txn) assignPartitions(msgs []*sarama.ProducerMessage) error {
+	partitioners := make(map[string]sarama.Partitioner)
+	for _, msg := range msgs {
+		partitioner := partitioners[msg.Topic]
+		if partitioner == nil {
+			partitioner = t.partitionerCtor(msg.Topic)
+			partitioners[msg.Topic] = partitioner
+		}
+		msg.Partition = AnyPartition
+		requiresConsistency := partitioner.RequiresConsistency()
+		if dcp, ok := partitioner.(sarama.DynamicConsistencyPartitioner); ok {
+			requiresConsistency = dcp.MessageRequiresConsistency(msg)
+		}
+		var partitions []int32
+		var err error
+		if requiresConsistency {
+			partitions, err = t.client.Partitions(msg.Topic)
+		} else {
+			partitions, err = t.client.WritablePartitions(msg.Topic)
+		}
+		if err != nil {
+			return err
+		}
+		if len(partitions) == 0 {
+			return sarama.ErrLeaderNotAvailable
+		}
+		idx, err := partitioner.Partition(msg, int32(len(partitions)))
+		if err != nil {
+			return err
+		}
+		if idx < 0 || idx >= int32(len(partitions)) {
+			return sarama.ErrInvalidPartition
+		}
+		msg.Partition = partitions[idx]
+	}
+	return nil
+}
+
+// buildBatches groups messages into record batches by topic-partition. It
+// also returns the offset of every message relative to the beginning of its
+// batch.
+func (t *txn) buildBatches(msgs []*sarama.ProducerMessage) (map[string]map[int32]*sarama.RecordBatch, []int64, error) {
+	now := time.Now()
+	batches := make(map[string]map[int32]*sarama.RecordBatch)
+	offsetDeltas := make([]int64, len(msgs))
+	for i, msg := range msgs {
+		var key, val []byte
+		var err error
+		if msg.Key != nil {
+			if key, err = msg.Key.Encode(); err != nil {
+				return nil, nil, err
+			}
+		}
+		if msg.Value != nil {
+			if val, err = msg.Value.Encode(); err != nil {
+				return nil, nil, err
+			}
+		}
+		topicBatches := batches[msg.Topic]
+		if topicBatches == nil {
+			topicBatches = make(map[int32]*sarama.RecordBatch)
+			batches[msg.Topic] = topicBatches
+		}
+		timestamp := msg.Timestamp
+		if timestamp.IsZero() {
+			timestamp = now
+		}
+		batch := topicBatches[msg.Partition]
+		if batch == nil {
+			batch = &sarama.RecordBatch{
+				FirstTimestamp:   timestamp,
+				MaxTimestamp:     timestamp,
+				Version:          2,
+				Codec:            t.cfg.Producer.Compression,
+				CompressionLevel: t.cfg.Producer.CompressionLevel,
+				IsTransactional:  true,
+			}
+			topicBatches[msg.Partition] = batch
+		}
+		if timestamp.After(batch.MaxTimestamp) {
+			batch.MaxTimestamp = timestamp
+		}
+		rec := &sarama.Record{
+			Key:            key,
+			Value:          val,
+			OffsetDelta:    int64(len(batch.Records)),
+			TimestampDelta: timestamp.Sub(batch.FirstTimestamp),
+		}
+		for j := range msg.Headers {
+			rec.Headers = append(rec.Headers, &msg.Headers[j])
+		}
+		offsetDeltas[i] = rec.OffsetDelta
+		batch.Records = append(batch.Records, rec)
+		batch.LastOffsetDelta = int32(rec.OffsetDelta)
+	}
+	return batches, offsetDeltas, nil
+}
+
+func (t *txn) initProducerID() error {
+	return t.withRetries(func(coordinator *sarama.Broker) error {
+		rs, err := coordinator.InitProducerID(&sarama.InitProducerIDRequest{
+			TransactionalID:    &t.id,
+			TransactionTimeout: t.timeout,
+		})
+		if err != nil {
+			return err
+		}
+		if rs.Err != sarama.ErrNoError {
+			return rs.Err
+		}
+		t.producerID = rs.ProducerID
+		t.producerEpoch = rs.ProducerEpoch
+		return nil
+	})
+}
+
+func (t *txn) addPartitions(batches map[string]map[int32]*sarama.RecordBatch) error {
+	topicPartitions := make(map[string][]int32, len(batches))
+	for topic, topicBatches := range batches {
+		for partition := range topicBatches {
+			topicPartitions[topic] = append(topicPartitions[topic], partition)
+		}
+	}
+	return t.withRetries(func(coordinator *sarama.Broker) error {
+		rs, err := coordinator.AddPartitionsToTxn(&sarama.AddPartitionsToTxnRequest{
+			TransactionalID: t.id,
+			ProducerID:      t.producerID,
+			ProducerEpoch:   t.producerEpoch,
+			TopicPartitions: topicPartitions,
+		})
+		if err != nil {
+			return err
+		}
+		for _, partitionErrors := range rs.Errors {
+			for _, partitionError := range partitionErrors {
+				if partitionError.Err != sarama.ErrNoError {
+					return partitionError.Err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// produce sends record batches to partition leaders, and returns the offsets
+// that the batches were written at.
+func (t *txn) produce(batches map[string]map[int32]*sarama.RecordBatch) (map[string]map[int32]int64, error) {
+	type topicPartition struct {
+		topic     string
+		partition int32
+	}
+	leaders := make(map[int32]*sarama.Broker)
+	requests := make(map[int32]*sarama.ProduceRequest)
+	requestPartitions := make(map[int32][]topicPartition)
+	for topic, topicBatches := range batches {
+		for partition, batch := range topicBatches {
+			batch.ProducerID = t.producerID
+			batch.ProducerEpoch = t.producerEpoch
+			leader, err := t.client.Leader(topic, partition)
+			if err != nil {
+				return nil, err
+			}
+			req := requests[leader.ID()]
+			if req == nil {
+				req = &sarama.ProduceRequest{
+					TransactionalID: &t.id,
+					RequiredAcks:    sarama.WaitForAll,
+					Timeout:         int32(t.cfg.Producer.Timeout / time.Millisecond),
+					Version:         3,
+				}
+				leaders[leader.ID()] = leader
+				requests[leader.ID()] = req
+			}
+			req.AddBatch(topic, partition, batch)
+			requestPartitions[leader.ID()] = append(requestPartitions[leader.ID()], topicPartition{topic, partition})
+		}
+	}
+	baseOffsets := make(map[string]map[int32]int64, len(batches))
+	for brokerID, req := range requests {
+		rs, err := leaders[brokerID].Produce(req)
+		if err != nil {
+			return nil, err
+		}
+		for _, tp := range requestPartitions[brokerID] {
+			block := rs.GetBlock(tp.topic, tp.partition)
+			if block == nil {
+				return nil, sarama.ErrIncompleteResponse
+			}
+			if block.Err != sarama.ErrNoError {
+				if block.Err == sarama.ErrNotLeaderForPartition {
+					_ = t.client.RefreshMetadata(tp.topic)
+				}
+				return nil, errors.Wrapf(block.Err, "%s/%d", tp.topic, tp.partition)
+			}
+			if baseOffsets[tp.topic] == nil {
+				baseOffsets[tp.topic] = make(map[int32]int64)
+			}
+			baseOffsets[tp.topic][tp.partition] = block.Offset
+		}
+	}
+	return baseOffsets, nil
+}
+
+func (t *txn) end(commit bool) error {
+	return t.withRetries(func(coordinator *sarama.Broker) error {
+		rs, err := coordinator.EndTxn(&sarama.EndTxnRequest{
+			TransactionalID:   t.id,
+			ProducerID:        t.producerID,
+			ProducerEpoch:     t.producerEpoch,
+			TransactionResult: commit,
+		})
+		if err != nil {
+			return err
+		}
+		if rs.Err != sarama.ErrNoError {
+			return rs.Err
+		}
+		return nil
+	})
+}
+
+// abort makes a best effort attempt to abort the transaction. If it fails,
+// then the transaction is aborted either by the coordinator on timeout, or
+// on the next producer ID initialization with the same transactional ID.
+func (t *txn) abort() {
+	_ = t.end(false)
+}
+
+// withRetries calls f with the transaction coordinator broker, retrying
+// errors that are known to be transient up to `Producer.Retry.Max` times.
+func (t *txn) withRetries(f func(coordinator *sarama.Broker) error) error {
+	for attempt := 0; ; attempt++ {
+		coordinator, err := t.getCoordinator()
+		if err == nil {
+			if err = f(coordinator); err == nil {
+				return nil
+			}
+		}
+		switch err {
+		case sarama.ErrConcurrentTransactions, sarama.ErrOffsetsLoadInProgress:
+		case sarama.ErrConsumerCoordinatorNotAvailable, sarama.ErrNotCoordinatorForConsumer:
+			t.closeCoordinator()
+		default:
+			if _, ok := err.(sarama.KError); ok {
+				return err
+			}
+			// It is a network error, so the connection to the coordinator
+			// has to be reestablished.
+			t.closeCoordinator()
+		}
+		if attempt >= t.cfg.Producer.Retry.Max {
+			return err
+		}
+		time.Sleep(txnRetryBackoff)
+	}
+}
+
+// getCoordinator returns a connection to the transaction coordinator of the
+// transactional ID. The connection is opened on the first call and is reused
+// until closed by closeCoordinator.
+func (t *txn) getCoordinator() (*sarama.Broker, error) {
+	if t.coordinator != nil {
+		return t.coordinator, nil
+	}
+	controller, err := t.client.Controller()
+	if err != nil {
+		return nil, err
+	}
+	rs, err := controller.FindCoordinator(&sarama.FindCoordinatorRequest{
+		Version:         1,
+		CoordinatorKey:  t.id,
+		CoordinatorType: sarama.CoordinatorTransaction,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rs.Err != sarama.ErrNoError {
+		return nil, rs.Err
+	}
+	coordinator := sarama.NewBroker(rs.Coordinator.Addr())
+	if err := coordinator.Open(t.cfg); err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to coordinator %s", coordinator.Addr())
+	}
+	t.coordinator = coordinator
+	return coordinator, nil
+}
+
+// closeCoordinator closes the transaction coordinator connection if it is
+// open, so that the next getCoordinator call looks the coordinator up again.
+func (t *txn) closeCoordinator() {
+	if t.coordinator == nil {
+		return
+	}
+	_ = t.coordinator.Close()
+	t.coordinator = nil
+}
+
+// txnIDs returns a pool of transactional IDs unique for the given client ID.
+func txnIDs(prefix, clientID string, count int) []string {
+	ids := make([]string, count)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("%s%s_%d", prefix, clientID, i)
+	}
+	return ids
+}
@@ -1,8 +1,11 @@
 package proxy
 
 import (
+	"expvar"
+	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/Shopify/sarama"
 	"github.com/mailgun/kafka-pixy/actor"
@@ -10,21 +13,38 @@ import (
 	"github.com/mailgun/kafka-pixy/config"
 	"github.com/mailgun/kafka-pixy/consumer"
 	"github.com/mailgun/kafka-pixy/consumer/consumerimpl"
+	"github.com/mailgun/kafka-pixy/none"
 	"github.com/mailgun/kafka-pixy/offsetmgr"
 	"github.com/mailgun/kafka-pixy/producer"
+	"github.com/mailgun/kafka-pixy/ratelimit"
+	"github.com/mailgun/kafka-pixy/schema"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 )
 
 const (
 	initEventsChMapCapacity = 256
+
+	// Time to wait before metadata is refreshed again, when it does not show
+	// partitions that have just been added yet.
+	partitionsRefreshBackoff = 250 * time.Millisecond
+
+	// Number of messages returned by a browse request that does not specify
+	// the count.
+	defaultBrowseCount = 10
 )
 
 var (
 	ErrUnavailable        = errors.New("service is shutting down")
 	ErrDisabled           = errors.New("service is disabled by configuration")
+	ErrForbidden          = errors.New("client is not allowed to manage topics")
+	ErrBatchTooLarge      = errors.New("too many messages in batch")
 	ErrHeadersUnsupported = errors.New("headers are not supported with this version of Kafka. Consider changing `kafka.version` (https://github.com/mailgun/kafka-pixy/blob/master/default.yaml#L35)")
 
+	// Fraction of the producer buffer capacity occupied by messages waiting
+	// to be submitted to Kafka by cluster.
+	producerBufferFill = expvar.NewMap("producer_buffer_fill")
+
 	noAck   = Ack{partition: -1}
 	autoAck = Ack{partition: -2}
 )
@@ -35,6 +55,12 @@ type T struct {
 	cfg        *config.Proxy
 	kafkaClt   sarama.Client
 	offsetMgrF offsetmgr.Factory
+	schemas    *schema.T
+	validator  *schema.Validator
+	limiter    *ratelimit.T
+	browseLim  *ratelimit.PerClient
+	stopCh     chan none.T
+	refreshWG  sync.WaitGroup
 
 	adminMu sync.RWMutex
 	admin   *admin.T
@@ -82,6 +108,25 @@ func AutoAck() Ack {
 	return autoAck
 }
 
+// BrowsedMessage is a message read from a partition by `BrowseMessages`.
+type BrowsedMessage struct {
+	consumer.Message
+	// Human readable representation of the message value, see
+	// `previewMessage`.
+	Preview string
+}
+
+// MessagePage is a range of messages read from a partition by
+// `BrowseMessages`.
+type MessagePage struct {
+	Messages []BrowsedMessage
+	// Offset that the next page starts with.
+	NextOffset int64
+	// Offset range of the partition at the time the page was read.
+	Begin int64
+	End   int64
+}
+
 type eventsChID struct {
 	group     string
 	topic     string
@@ -93,10 +138,19 @@ func Spawn(parentActDesc *actor.Descriptor, name string, cfg *config.Proxy) (*T,
 	p := T{
 		actDesc:     parentActDesc.NewChild(name),
 		cfg:         cfg,
+		limiter:     ratelimit.New(cfg),
+		browseLim:   ratelimit.NewPerClient(cfg.Browse.ClientLimit, cfg.Browse.TotalLimit, "browse"),
+		stopCh:      make(chan none.T),
 		eventsChMap: make(map[eventsChID]chan<- consumer.Event, initEventsChMapCapacity),
 	}
 	var err error
 
+	if p.schemas, err = schema.New(cfg); err != nil {
+		return nil, errors.Wrap(err, "failed to initialize schemas")
+	}
+	if p.validator, err = schema.SpawnValidator(p.actDesc, cfg); err != nil {
+		return nil, errors.Wrap(err, "failed to spawn JSON schema validator")
+	}
 	if p.kafkaClt, err = sarama.NewClient(cfg.Kafka.SeedPeers, cfg.SaramaClientCfg()); err != nil {
 		return nil, errors.Wrap(err, "failed to create Kafka client")
 	}
@@ -104,6 +158,9 @@ func Spawn(parentActDesc *actor.Descriptor, name string, cfg *config.Proxy) (*T,
 	if p.producer, err = producer.Spawn(p.actDesc, cfg); err != nil {
 		return nil, errors.Wrap(err, "failed to spawn producer")
 	}
+	producerBufferFill.Set(name, expvar.Func(func() interface{} {
+		return p.ProducerBufferFill()
+	}))
 	if !cfg.Consumer.Disabled {
 		if p.consumer, err = consumerimpl.Spawn(p.actDesc, cfg, p.offsetMgrF); err != nil {
 			return nil, errors.Wrap(err, "failed to spawn consumer")
@@ -117,6 +174,9 @@ func Spawn(parentActDesc *actor.Descriptor, name string, cfg *config.Proxy) (*T,
 
 // Stop terminates the proxy instances synchronously.
 func (p *T) Stop() {
+	close(p.stopCh)
+	p.refreshWG.Wait()
+
 	var wg sync.WaitGroup
 
 	p.producerMu.RLock()
@@ -138,6 +198,9 @@ func (p *T) Stop() {
 	p.adminMu.RUnlock()
 
 	wg.Wait()
+	if p.validator != nil {
+		p.validator.Stop()
+	}
 	if p.offsetMgrF != nil {
 		p.offsetMgrF.Stop()
 	}
@@ -174,41 +237,212 @@ func (p *T) stopAdmin() {
 // using `key` to identify a destination partition. The exact algorithm used to
 // map keys to partitions is implementation specific but it is guaranteed that
 // it returns consistent results. If `key` is `nil`, then the message is placed
-// into a random partition.
+// into a random partition. If `partition` is not `producer.AnyPartition`, then
+// the message is written to that partition regardless of `key`. If `timestamp`
+// is not zero, then it is used as the message timestamp.
 //
 // Errors usually indicate a catastrophic failure of the Kafka cluster, or
 // missing topic if there cluster is not configured to auto create topics.
-func (p *T) Produce(topic string, key, message sarama.Encoder, headers []sarama.RecordHeader) (*sarama.ProducerMessage, error) {
+func (p *T) Produce(topic string, partition int32, key, message sarama.Encoder, headers []sarama.RecordHeader, timestamp time.Time) (*sarama.ProducerMessage, error) {
 	if len(headers) > 0 && !p.cfg.Kafka.Version.IsAtLeast(sarama.V0_11_0_0) {
 		return nil, ErrHeadersUnsupported
 	}
+	message, err := p.encodeMessage(topic, message)
+	if err != nil {
+		return nil, err
+	}
 
 	p.producerMu.RLock()
 	if p.producer == nil {
 		p.producerMu.RUnlock()
 		return nil, ErrUnavailable
 	}
-	responseCh := p.producer.AsyncProduce(topic, key, message, headers)
+	responseCh := p.producer.AsyncProduceToPartition(topic, partition, key, message, headers, timestamp)
 	p.producerMu.RUnlock()
 
 	rs := <-responseCh
 	return rs.Msg, rs.Err
 }
 
+// AsyncProduceWithResult submits a message to the producer and returns a
+// channel that the production result is sent to once the message is either
+// written to Kafka or failed.
+func (p *T) AsyncProduceWithResult(topic string, key, message sarama.Encoder, headers []sarama.RecordHeader, timestamp time.Time) <-chan producer.Response {
+	if len(headers) > 0 && !p.cfg.Kafka.Version.IsAtLeast(sarama.V0_11_0_0) {
+		return failedProduce(ErrHeadersUnsupported)
+	}
+	message, err := p.encodeMessage(topic, message)
+	if err != nil {
+		return failedProduce(err)
+	}
+
+	p.producerMu.RLock()
+	defer p.producerMu.RUnlock()
+	if p.producer == nil {
+		return failedProduce(ErrUnavailable)
+	}
+	return p.producer.AsyncProduceToPartition(topic, producer.AnyPartition, key, message, headers, timestamp)
+}
+
+// AllowProduce checks whether messages produced by a client fit into the
+// produce rate limits of their topics and of the client. If they do, then the
+// messages are accounted against the limits, otherwise
+// `*ratelimit.ThrottledError` is returned and none of the messages is
+// accounted, even if they are produced to several topics.
+func (p *T) AllowProduce(client string, msgs ...*sarama.ProducerMessage) error {
+	if p.limiter == nil {
+		return nil
+	}
+	var usages []ratelimit.Usage
+	topicIndexes := make(map[string]int)
+	for _, msg := range msgs {
+		i, ok := topicIndexes[msg.Topic]
+		if !ok {
+			i = len(usages)
+			topicIndexes[msg.Topic] = i
+			usages = append(usages, ratelimit.Usage{Topic: msg.Topic})
+		}
+		usages[i].MsgCount++
+		if msg.Key != nil {
+			usages[i].ByteCount += msg.Key.Length()
+		}
+		if msg.Value != nil {
+			usages[i].ByteCount += msg.Value.Length()
+		}
+	}
+	return p.limiter.AllowTopics(client, usages)
+}
+
+// CheckBatchSize returns an error wrapping `ErrBatchTooLarge` if a batch or
+// atomic produce request with the given number of messages exceeds the
+// configured maximum.
+func (p *T) CheckBatchSize(msgCount int) error {
+	if msgCount > p.cfg.Producer.MaxBatchMessages {
+		return errors.Wrapf(ErrBatchTooLarge, "%d > %d", msgCount, p.cfg.Producer.MaxBatchMessages)
+	}
+	return nil
+}
+
+// ProducerBufferFill returns the fraction of the producer buffer capacity
+// occupied by messages waiting to be submitted to Kafka.
+func (p *T) ProducerBufferFill() float64 {
+	p.producerMu.RLock()
+	defer p.producerMu.RUnlock()
+	if p.producer == nil {
+		return 0
+	}
+	return p.producer.BufferFill()
+}
+
+// ProducerBufferUsage returns the number of messages waiting in the producer
+// buffer to be submitted to Kafka, and the buffer capacity.
+func (p *T) ProducerBufferUsage() (int, int) {
+	p.producerMu.RLock()
+	defer p.producerMu.RUnlock()
+	if p.producer == nil {
+		return 0, 0
+	}
+	return p.producer.BufferUsage()
+}
+
 // AsyncProduce is an asynchronously counterpart of the `Produce` function.
-// Errors are silently ignored.
-func (p *T) AsyncProduce(topic string, key, message sarama.Encoder, headers []sarama.RecordHeader) {
+// Production errors are silently ignored, but if the producer spool is
+// configured, then an error is returned if the message could not be spooled.
+func (p *T) AsyncProduce(topic string, partition int32, key, message sarama.Encoder, headers []sarama.RecordHeader, timestamp time.Time) error {
 	if len(headers) > 0 && !p.cfg.Kafka.Version.IsAtLeast(sarama.V0_11_0_0) {
-		return
+		return nil
+	}
+	message, err := p.encodeMessage(topic, message)
+	if err != nil {
+		return err
 	}
 
 	p.producerMu.RLock()
+	defer p.producerMu.RUnlock()
 	if p.producer == nil {
-		p.producerMu.RUnlock()
-		return
+		return nil
+	}
+	return p.producer.AsyncProduceDurable(topic, partition, key, message, headers, timestamp)
+}
+
+// AsyncProduceReported is like `AsyncProduce`, but the result of writing the
+// message to Kafka is passed to `report`. Unlike `AsyncProduce` the message is
+// not persisted in the producer spool.
+func (p *T) AsyncProduceReported(topic string, partition int32, key, message sarama.Encoder, headers []sarama.RecordHeader, timestamp time.Time, report producer.Reporter) error {
+	if len(headers) > 0 && !p.cfg.Kafka.Version.IsAtLeast(sarama.V0_11_0_0) {
+		return ErrHeadersUnsupported
+	}
+	message, err := p.encodeMessage(topic, message)
+	if err != nil {
+		return err
+	}
+
+	p.producerMu.RLock()
+	defer p.producerMu.RUnlock()
+	if p.producer == nil {
+		return ErrUnavailable
+	}
+	return p.producer.AsyncProduceReported(topic, partition, key, message, headers, timestamp, report)
+}
+
+// ProduceBatch submits all messages to the producer at once, and then waits
+// for all of them to be written to Kafka. Unlike `ProduceAtomic` it does not
+// guarantee atomicity, each message succeeds or fails individually. Results
+// are returned in the order of the given messages.
+func (p *T) ProduceBatch(msgs []*sarama.ProducerMessage) []producer.Response {
+	responses := make([]producer.Response, len(msgs))
+	responseChs := make([]<-chan producer.Response, len(msgs))
+	headersSupported := p.cfg.Kafka.Version.IsAtLeast(sarama.V0_11_0_0)
+
+	p.producerMu.RLock()
+	for i, msg := range msgs {
+		if p.producer == nil {
+			responses[i] = producer.Response{Msg: msg, Err: ErrUnavailable}
+			continue
+		}
+		if len(msg.Headers) > 0 && !headersSupported {
+			responses[i] = producer.Response{Msg: msg, Err: ErrHeadersUnsupported}
+			continue
+		}
+		value, err := p.encodeMessage(msg.Topic, msg.Value)
+		if err != nil {
+			responses[i] = producer.Response{Msg: msg, Err: err}
+			continue
+		}
+		responseChs[i] = p.producer.AsyncProduceToPartition(msg.Topic, producer.AnyPartition, msg.Key, value, msg.Headers, msg.Timestamp)
 	}
-	p.producer.AsyncProduce(topic, key, message, headers)
 	p.producerMu.RUnlock()
+
+	for i, responseCh := range responseChs {
+		if responseCh != nil {
+			responses[i] = <-responseCh
+		}
+	}
+	return responses
+}
+
+// ProduceAtomic writes all messages to Kafka in a single transaction, so
+// either all of them or none becomes visible to `read_committed` consumers.
+// On success partitions and offsets of all messages are updated to reflect
+// where they were written to.
+func (p *T) ProduceAtomic(msgs []*sarama.ProducerMessage) error {
+	values := make([]sarama.Encoder, len(msgs))
+	for i, msg := range msgs {
+		var err error
+		if values[i], err = p.encodeMessage(msg.Topic, msg.Value); err != nil {
+			return err
+		}
+	}
+	for i, msg := range msgs {
+		msg.Value = values[i]
+	}
+
+	p.producerMu.RLock()
+	defer p.producerMu.RUnlock()
+	if p.producer == nil {
+		return ErrUnavailable
+	}
+	return p.producer.ProduceAtomic(msgs)
 }
 
 // Consume consumes a message from the specified topic on behalf of the
@@ -333,6 +567,17 @@ func (p *T) GetAllTopicConsumers(topic string) (map[string]map[string][]int32, e
 	return p.admin.GetAllTopicConsumers(topic)
 }
 
+// GetNativeTopicConsumers returns consumer groups coordinated by Kafka that
+// consume a topic, see `admin.T.GetNativeTopicConsumers`.
+func (p *T) GetNativeTopicConsumers(group, topic string) (map[string]admin.NativeGroup, error) {
+	p.adminMu.RLock()
+	defer p.adminMu.RUnlock()
+	if p.admin == nil {
+		return nil, ErrUnavailable
+	}
+	return p.admin.GetNativeTopicConsumers(group, topic)
+}
+
 // ListTopics returns a list of all topics existing in the Kafka cluster.
 func (p *T) ListTopics(withPartitions, withConfig bool) ([]admin.TopicMetadata, error) {
 	p.adminMu.RLock()
@@ -353,3 +598,335 @@ func (p *T) GetTopicMetadata(topic string, withPartitions, withConfig bool) (adm
 	}
 	return p.admin.GetTopicMetadata(topic, withPartitions, withConfig)
 }
+
+// DescribeCluster returns brokers of the Kafka cluster along with their
+// connectivity, and summary of the partition replication state.
+func (p *T) DescribeCluster() (admin.ClusterMetadata, error) {
+	p.adminMu.RLock()
+	defer p.adminMu.RUnlock()
+	if p.admin == nil {
+		return admin.ClusterMetadata{}, ErrUnavailable
+	}
+	return p.admin.DescribeCluster()
+}
+
+// CreateTopic creates a topic on behalf of a client, see
+// `admin.T.CreateTopic`. If the client is not listed in the
+// `admin.topic_managers` config parameter, then `ErrForbidden` is returned.
+func (p *T) CreateTopic(client, topic string, partitions int32, replicationFactor int16, config map[string]string, validateOnly bool) error {
+	if !p.isTopicManager(client) {
+		return ErrForbidden
+	}
+	p.adminMu.RLock()
+	defer p.adminMu.RUnlock()
+	if p.admin == nil {
+		return ErrUnavailable
+	}
+	if err := p.admin.CreateTopic(topic, partitions, replicationFactor, config, validateOnly); err != nil {
+		return err
+	}
+	if !validateOnly {
+		p.actDesc.Log().Infof("Topic created: topic=%s, partitions=%d, replicationFactor=%d, client=%s",
+			topic, partitions, replicationFactor, client)
+	}
+	return nil
+}
+
+// DeleteTopic deletes a topic on behalf of a client, see
+// `admin.T.DeleteTopic`. If the client is not listed in the
+// `admin.topic_managers` config parameter, then `ErrForbidden` is returned.
+func (p *T) DeleteTopic(client, topic string) error {
+	if !p.isTopicManager(client) {
+		return ErrForbidden
+	}
+	p.adminMu.RLock()
+	defer p.adminMu.RUnlock()
+	if p.admin == nil {
+		return ErrUnavailable
+	}
+	if err := p.admin.DeleteTopic(topic); err != nil {
+		return err
+	}
+	p.actDesc.Log().Infof("Topic deleted: topic=%s, client=%s", topic, client)
+	return nil
+}
+
+// AlterTopicConfig changes configuration overrides of a topic on behalf of a
+// client, see `admin.T.AlterTopicConfig`. If the client is not listed in the
+// `admin.topic_managers` config parameter, then `ErrForbidden` is returned.
+func (p *T) AlterTopicConfig(client, topic string, config map[string]*string, validateOnly bool) error {
+	if !p.isTopicManager(client) {
+		return ErrForbidden
+	}
+	p.adminMu.RLock()
+	defer p.adminMu.RUnlock()
+	if p.admin == nil {
+		return ErrUnavailable
+	}
+	if err := p.admin.AlterTopicConfig(topic, config, validateOnly); err != nil {
+		return err
+	}
+	if !validateOnly {
+		p.actDesc.Log().Infof("Topic config altered: topic=%s, client=%s", topic, client)
+	}
+	return nil
+}
+
+// AddPartitions increases the number of partitions of a topic on behalf of a
+// client, see `admin.T.AddPartitions`. If the client is not listed in the
+// `admin.topic_managers` config parameter, then `ErrForbidden` is returned.
+// When partitions are added, metadata of the topic is refreshed in all Kafka
+// clients of the proxy in the background, and consumer groups consuming the
+// topic reassign partitions to start consuming the new ones.
+func (p *T) AddPartitions(client, topic string, count int32, validateOnly bool) error {
+	if !p.isTopicManager(client) {
+		return ErrForbidden
+	}
+	p.adminMu.RLock()
+	if p.admin == nil {
+		p.adminMu.RUnlock()
+		return ErrUnavailable
+	}
+	err := p.admin.AddPartitions(topic, count, validateOnly)
+	p.adminMu.RUnlock()
+	if err != nil {
+		return err
+	}
+	if validateOnly {
+		return nil
+	}
+	p.actDesc.Log().Infof("Partitions added: topic=%s, count=%d, client=%s", topic, count, client)
+	actor.Spawn(p.actDesc.NewChild("refresh_partitions"), &p.refreshWG, func() {
+		p.refreshPartitions(topic, count)
+	})
+	return nil
+}
+
+// ElectPreferredLeaders triggers preferred leader election on behalf of a
+// client, see `admin.T.ElectPreferredLeaders`. If the client is not listed in
+// the `admin.topic_managers` config parameter, then `ErrForbidden` is
+// returned.
+func (p *T) ElectPreferredLeaders(client string, partitions []admin.TopicPartition) ([]admin.PartitionProgress, error) {
+	if !p.isTopicManager(client) {
+		return nil, ErrForbidden
+	}
+	p.adminMu.RLock()
+	defer p.adminMu.RUnlock()
+	if p.admin == nil {
+		return nil, ErrUnavailable
+	}
+	progress, err := p.admin.ElectPreferredLeaders(partitions)
+	if err != nil {
+		return nil, err
+	}
+	p.actDesc.Log().Infof("Preferred leader election requested: partitions=%d, client=%s", len(partitions), client)
+	return progress, nil
+}
+
+// ReassignPartitions moves partitions to new replicas on behalf of a client,
+// see `admin.T.ReassignPartitions`. If the client is not listed in the
+// `admin.topic_managers` config parameter, then `ErrForbidden` is returned.
+func (p *T) ReassignPartitions(client string, reassignments []admin.PartitionReassignment) ([]admin.PartitionProgress, error) {
+	if !p.isTopicManager(client) {
+		return nil, ErrForbidden
+	}
+	p.adminMu.RLock()
+	defer p.adminMu.RUnlock()
+	if p.admin == nil {
+		return nil, ErrUnavailable
+	}
+	progress, err := p.admin.ReassignPartitions(reassignments)
+	if err != nil {
+		return nil, err
+	}
+	p.actDesc.Log().Infof("Partition reassignment requested: partitions=%d, client=%s", len(reassignments), client)
+	return progress, nil
+}
+
+// GetReassignments returns progress of partition reassignments that are in
+// progress, see `admin.T.GetReassignments`.
+func (p *T) GetReassignments() ([]admin.PartitionProgress, error) {
+	p.adminMu.RLock()
+	defer p.adminMu.RUnlock()
+	if p.admin == nil {
+		return nil, ErrUnavailable
+	}
+	return p.admin.GetReassignments()
+}
+
+// BrowseMessages reads up to `count` messages of a partition starting with
+// offset `from` on behalf of a client, see `admin.T.FetchMessages`. If `from`
+// is negative, then messages are read starting with the oldest one. If
+// `count` is not positive, then 10 messages are read, and it is capped by the
+// `browse.max_count` config parameter. Browsing never changes offsets
+// committed by consumer groups. If the client exceeds the
+// `browse.client_limit` rate, then `*ratelimit.ThrottledError` is returned.
+func (p *T) BrowseMessages(client, topic string, partition int32, from int64, count int) (MessagePage, error) {
+	if from < 0 {
+		from = sarama.OffsetOldest
+	}
+	if count <= 0 {
+		count = defaultBrowseCount
+	}
+	if count > p.cfg.Browse.MaxCount {
+		count = p.cfg.Browse.MaxCount
+	}
+	// The size of messages is not known until they are fetched, so bytes are
+	// charged afterwards, but a client in debt is throttled right away.
+	if p.browseLim != nil {
+		if err := p.browseLim.Allow(client, count, 0); err != nil {
+			return MessagePage{}, err
+		}
+	}
+	p.adminMu.RLock()
+	defer p.adminMu.RUnlock()
+	if p.admin == nil {
+		return MessagePage{}, ErrUnavailable
+	}
+	fetched, err := p.admin.FetchMessages(topic, partition, from, count, p.cfg.Browse.MaxBytes, p.cfg.Browse.Timeout)
+	if err != nil {
+		return MessagePage{}, err
+	}
+	page := MessagePage{
+		Messages:   make([]BrowsedMessage, len(fetched.Messages)),
+		NextOffset: fetched.NextOffset,
+		Begin:      fetched.Begin,
+		End:        fetched.End,
+	}
+	byteCount := 0
+	for i, msg := range fetched.Messages {
+		page.Messages[i] = BrowsedMessage{Message: msg, Preview: p.previewMessage(topic, msg.Value)}
+		byteCount += len(msg.Key) + len(msg.Value)
+	}
+	if p.browseLim != nil {
+		p.browseLim.Charge(client, 0, byteCount)
+	}
+	p.actDesc.Log().Infof("Messages browsed: topic=%s, partition=%d, from=%d, count=%d, client=%s",
+		topic, partition, from, len(page.Messages), client)
+	return page, nil
+}
+
+// previewMessage returns a human readable representation of a message value,
+// that is JSON if the topic is bound to a schema with `decode_on_consume`
+// enabled, or the value itself otherwise. Invalid UTF-8 sequences are
+// replaced, and the preview is truncated to `browse.preview_length` bytes.
+func (p *T) previewMessage(topic string, value []byte) string {
+	decoded, ok, err := p.DecodeMessage(topic, value)
+	if err == nil && ok {
+		value = decoded
+	}
+	preview := strings.ToValidUTF8(string(value), "\uFFFD")
+	if len(preview) <= p.cfg.Browse.PreviewLength {
+		return preview
+	}
+	// Do not cut a multi-byte character in the middle.
+	end := p.cfg.Browse.PreviewLength
+	for end > 0 && !utf8.RuneStart(preview[end]) {
+		end--
+	}
+	return preview[:end]
+}
+
+// refreshPartitions refreshes metadata of a topic in all Kafka clients of the
+// proxy, after partitions have been added to the topic. Brokers learn about
+// new partitions from the controller asynchronously, so metadata is refreshed
+// until it shows at least `count` partitions, or the admin timeout elapses,
+// or the proxy is stopped. Refresh errors are only logged, for the partitions
+// have been added anyway.
+func (p *T) refreshPartitions(topic string, count int32) {
+	deadline := time.Now().Add(p.cfg.Admin.Timeout)
+	for {
+		err := p.kafkaClt.RefreshMetadata(topic)
+		if err == nil {
+			var partitions []int32
+			if partitions, err = p.kafkaClt.Partitions(topic); err == nil && len(partitions) >= int(count) {
+				break
+			}
+		}
+		if time.Now().After(deadline) {
+			p.actDesc.Log().WithError(err).Warnf("New partitions are not in metadata yet: topic=%s", topic)
+			break
+		}
+		select {
+		case <-p.stopCh:
+			return
+		case <-time.After(partitionsRefreshBackoff):
+		}
+	}
+	p.adminMu.RLock()
+	if p.admin != nil {
+		if err := p.admin.RefreshMetadata(topic); err != nil {
+			p.actDesc.Log().WithError(err).Warnf("Failed to refresh admin metadata: topic=%s", topic)
+		}
+	}
+	p.adminMu.RUnlock()
+	p.producerMu.RLock()
+	if p.producer != nil {
+		if err := p.producer.RefreshMetadata(topic); err != nil {
+			p.actDesc.Log().WithError(err).Warnf("Failed to refresh producer metadata: topic=%s", topic)
+		}
+	}
+	p.producerMu.RUnlock()
+	p.consumerMu.RLock()
+	if p.consumer != nil {
+		if err := p.consumer.RefreshPartitions(topic); err != nil {
+			p.actDesc.Log().WithError(err).Warnf("Failed to refresh consumer partitions: topic=%s", topic)
+		}
+	}
+	p.consumerMu.RUnlock()
+}
+
+// isTopicManager tells whether a client is allowed to manage topics. The
+// client has to be an identity verified by the server, for anonymous clients
+// are never allowed.
+func (p *T) isTopicManager(client string) bool {
+	if client == "" {
+		return false
+	}
+	for _, topicManager := range p.cfg.Admin.TopicManagers {
+		if client == topicManager {
+			return true
+		}
+	}
+	return false
+}
+
+// DecodeMessage converts a message consumed from a topic to JSON if the topic
+// is bound to a schema with `decode_on_consume` enabled. The returned flag is
+// false if the topic is not configured for decoding.
+func (p *T) DecodeMessage(topic string, message []byte) ([]byte, bool, error) {
+	if p.schemas == nil {
+		return nil, false, nil
+	}
+	return p.schemas.Decode(topic, message)
+}
+
+// encodeMessage validates a message against the JSON Schema bound to the topic
+// it is produced to, and then encodes it if the topic is bound to a schema
+// registry schema. Otherwise the message is returned as is.
+func (p *T) encodeMessage(topic string, message sarama.Encoder) (sarama.Encoder, error) {
+	if (p.schemas == nil && p.validator == nil) || message == nil {
+		return message, nil
+	}
+	data, err := message.Encode()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode message")
+	}
+	if p.validator != nil {
+		if err := p.validator.Validate(topic, data); err != nil {
+			return nil, err
+		}
+	}
+	if p.schemas != nil {
+		if data, err = p.schemas.Encode(topic, data); err != nil {
+			return nil, err
+		}
+	}
+	return sarama.ByteEncoder(data), nil
+}
+
+func failedProduce(err error) <-chan producer.Response {
+	responseCh := make(chan producer.Response, 1)
+	responseCh <- producer.Response{Err: err}
+	return responseCh
+}
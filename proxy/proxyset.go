@@ -1,16 +1,56 @@
 package proxy
 
 import (
+	"expvar"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/mailgun/kafka-pixy/producer"
+	"github.com/mailgun/kafka-pixy/ratelimit"
+	"github.com/mailgun/kafka-pixy/schema"
 	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+)
+
+var (
+	// Number of messages failed over to another cluster by topic.
+	produceFailovers = expvar.NewMap("produce_failovers")
+
+	// Number of messages that were not failed over to another cluster,
+	// because the failover budget was spent, by topic.
+	produceFailoverRejects = expvar.NewMap("produce_failover_rejects")
 )
 
 // Set represents a collection of proxy.T instances with a default value.
 type Set struct {
-	proxies    map[string]*T
-	defaultPxy *T
+	proxies        map[string]*T
+	defaultPxy     *T
+	defaultCluster string
+	routes         map[string]map[string]*route
+}
+
+// DeliveryReporter is a function that the result of a message produced by
+// `Set.AsyncProduce` is passed to along with the name of the cluster that
+// accepted the message. It must not block.
+type DeliveryReporter func(cluster string, rs producer.Response)
+
+// Response is the result of producing a message via `Set` along with the name
+// of the cluster that accepted the message. If the message failed, then it is
+// the name of the cluster that the message was produced to.
+type Response struct {
+	producer.Response
+	Cluster string
+}
+
+// route is a produce route of a topic of a cluster.
+type route struct {
+	mirrorTo   []string
+	failoverTo []string
+	budget     *rate.Limiter
 }
 
 // NewSet creates a proxy.Set from a cluster-to-proxy map and a default proxy.
+// Produce routes are taken from configs of the proxies.
 func NewSet(proxies map[string]*T, defaultPxy *T) *Set {
 	if len(proxies) < 1 {
 		panic("set must contain at least one proxy")
@@ -18,7 +58,33 @@ func NewSet(proxies map[string]*T, defaultPxy *T) *Set {
 	if defaultPxy == nil {
 		panic("default proxy must be provided")
 	}
-	return &Set{proxies: proxies, defaultPxy: defaultPxy}
+	s := Set{
+		proxies:    proxies,
+		defaultPxy: defaultPxy,
+		routes:     make(map[string]map[string]*route),
+	}
+	for cluster, pxy := range proxies {
+		if pxy == defaultPxy {
+			s.defaultCluster = cluster
+		}
+		if len(pxy.cfg.ProduceRoutes) == 0 {
+			continue
+		}
+		routes := make(map[string]*route, len(pxy.cfg.ProduceRoutes))
+		for topic, routeCfg := range pxy.cfg.ProduceRoutes {
+			rt := route{mirrorTo: routeCfg.MirrorTo, failoverTo: routeCfg.FailoverTo}
+			if len(routeCfg.FailoverTo) > 0 {
+				// The refill rate is computed in floating point, for a budget
+				// that is bigger than the window in nanoseconds would make
+				// an integer refill interval zero, that is an infinite rate.
+				refillRate := rate.Limit(float64(routeCfg.FailoverBudget) / routeCfg.FailoverWindow.Seconds())
+				rt.budget = rate.NewLimiter(refillRate, routeCfg.FailoverBudget)
+			}
+			routes[topic] = &rt
+		}
+		s.routes[cluster] = routes
+	}
+	return &s
 }
 
 // Get returns a proxy for a cluster name. If there is no proxy configured for
@@ -32,3 +98,240 @@ func (s *Set) Get(cluster string) (*T, error) {
 	}
 	return nil, errors.Errorf("proxy `%s` does not exist", cluster)
 }
+
+// Produce writes a message to a cluster the same way as `T.Produce` does, but
+// obeys the produce route of the topic if the cluster has one. The message is
+// mirrored to clusters of the route, and if the cluster fails to write it,
+// then it is written to failover clusters of the route in order, as long as
+// the failover budget allows. The name of the cluster that accepted the
+// message is returned.
+func (s *Set) Produce(cluster, topic string, partition int32, key, message sarama.Encoder, headers []sarama.RecordHeader, timestamp time.Time) (*sarama.ProducerMessage, string, error) {
+	cluster, pxy, err := s.resolve(cluster)
+	if err != nil {
+		return nil, "", err
+	}
+	rt := s.routes[cluster][topic]
+	if rt == nil {
+		prodMsg, err := pxy.Produce(topic, partition, key, message, headers, timestamp)
+		return prodMsg, cluster, err
+	}
+	s.mirror(rt, topic, partition, key, message, headers, timestamp)
+	prodMsg, err := pxy.Produce(topic, partition, key, message, headers, timestamp)
+	if err == nil || !s.canFailover(rt, cluster, topic, err) {
+		return prodMsg, cluster, err
+	}
+	for _, failoverCluster := range rt.failoverTo {
+		failoverPxy := s.proxies[failoverCluster]
+		failoverMsg, failoverErr := failoverPxy.Produce(topic, partition, key, message, headers, timestamp)
+		if failoverErr == nil {
+			produceFailovers.Add(topic, 1)
+			return failoverMsg, failoverCluster, nil
+		}
+		failoverPxy.actDesc.Log().WithError(failoverErr).Errorf("Failover produce failed: topic=%s", topic)
+	}
+	// If all failover clusters failed too, then the error of the cluster
+	// that the message was produced to is returned.
+	return nil, cluster, err
+}
+
+// AsyncProduce is an asynchronous counterpart of the `Produce` function. A
+// message is failed over to another cluster only if the cluster rejects it
+// right away, e.g. if its producer buffer or spool is full. If `report` is not
+// nil, then the result of writing the message to Kafka is passed to it, see
+// `T.AsyncProduceReported`. Results of mirrored messages are not reported.
+func (s *Set) AsyncProduce(cluster, topic string, partition int32, key, message sarama.Encoder, headers []sarama.RecordHeader, timestamp time.Time, report DeliveryReporter) (string, error) {
+	cluster, pxy, err := s.resolve(cluster)
+	if err != nil {
+		return "", err
+	}
+	rt := s.routes[cluster][topic]
+	if rt == nil {
+		return cluster, asyncProduce(pxy, cluster, topic, partition, key, message, headers, timestamp, report)
+	}
+	s.mirror(rt, topic, partition, key, message, headers, timestamp)
+	err = asyncProduce(pxy, cluster, topic, partition, key, message, headers, timestamp, report)
+	if err == nil || !s.canFailover(rt, cluster, topic, err) {
+		return cluster, err
+	}
+	for _, failoverCluster := range rt.failoverTo {
+		failoverPxy := s.proxies[failoverCluster]
+		failoverErr := asyncProduce(failoverPxy, failoverCluster, topic, partition, key, message, headers, timestamp, report)
+		if failoverErr == nil {
+			produceFailovers.Add(topic, 1)
+			return failoverCluster, nil
+		}
+		failoverPxy.actDesc.Log().WithError(failoverErr).Errorf("Failover produce failed: topic=%s", topic)
+	}
+	return cluster, err
+}
+
+// ProduceBatch writes messages to a cluster the same way as `T.ProduceBatch`
+// does, but obeys produce routes of their topics the same way as `Produce`
+// does. Results are returned in the order of the given messages.
+func (s *Set) ProduceBatch(cluster string, msgs []*sarama.ProducerMessage) ([]Response, error) {
+	cluster, pxy, err := s.resolve(cluster)
+	if err != nil {
+		return nil, err
+	}
+	routes := s.routes[cluster]
+	for _, msg := range msgs {
+		if rt := routes[msg.Topic]; rt != nil {
+			s.mirror(rt, msg.Topic, producer.AnyPartition, msg.Key, msg.Value, msg.Headers, msg.Timestamp)
+		}
+	}
+	responses := make([]Response, len(msgs))
+	var failingOver []int
+	for i, rs := range pxy.ProduceBatch(msgs) {
+		responses[i] = Response{Response: rs, Cluster: cluster}
+		if rs.Err == nil {
+			continue
+		}
+		if rt := routes[msgs[i].Topic]; rt != nil && s.canFailover(rt, cluster, msgs[i].Topic, rs.Err) {
+			failingOver = append(failingOver, i)
+		}
+	}
+	// Messages are failed over in rounds, in each round a message is written
+	// to the next failover cluster of its route. Messages that go to the same
+	// cluster in a round are written in one batch. If all failover clusters
+	// fail a message, then the error of the original cluster is returned.
+	for round := 0; len(failingOver) > 0; round++ {
+		var failoverClusters []string
+		batches := make(map[string][]int)
+		for _, i := range failingOver {
+			failoverTo := routes[msgs[i].Topic].failoverTo
+			if round >= len(failoverTo) {
+				continue
+			}
+			failoverCluster := failoverTo[round]
+			if batches[failoverCluster] == nil {
+				failoverClusters = append(failoverClusters, failoverCluster)
+			}
+			batches[failoverCluster] = append(batches[failoverCluster], i)
+		}
+		failingOver = failingOver[:0]
+		for _, failoverCluster := range failoverClusters {
+			batch := batches[failoverCluster]
+			failoverMsgs := make([]*sarama.ProducerMessage, len(batch))
+			for j, i := range batch {
+				failoverMsgs[j] = msgs[i]
+			}
+			failoverPxy := s.proxies[failoverCluster]
+			for j, rs := range failoverPxy.ProduceBatch(failoverMsgs) {
+				i := batch[j]
+				if rs.Err == nil {
+					produceFailovers.Add(msgs[i].Topic, 1)
+					responses[i] = Response{Response: rs, Cluster: failoverCluster}
+					continue
+				}
+				failoverPxy.actDesc.Log().WithError(rs.Err).Errorf("Failover produce failed: topic=%s", msgs[i].Topic)
+				failingOver = append(failingOver, i)
+			}
+		}
+	}
+	return responses, nil
+}
+
+// AsyncProduceWithResult writes a message to a cluster the same way as
+// `T.AsyncProduceWithResult` does, but obeys the produce route of the topic
+// the same way as `Produce` does. The result is sent to the returned channel
+// once the message is either written to Kafka or failed by all clusters it
+// was attempted on.
+func (s *Set) AsyncProduceWithResult(cluster, topic string, key, message sarama.Encoder, headers []sarama.RecordHeader, timestamp time.Time) <-chan Response {
+	resultCh := make(chan Response, 1)
+	cluster, pxy, err := s.resolve(cluster)
+	if err != nil {
+		resultCh <- Response{Response: producer.Response{Err: err}}
+		return resultCh
+	}
+	rt := s.routes[cluster][topic]
+	if rt != nil {
+		s.mirror(rt, topic, producer.AnyPartition, key, message, headers, timestamp)
+	}
+	responseCh := pxy.AsyncProduceWithResult(topic, key, message, headers, timestamp)
+	go func() {
+		rs := <-responseCh
+		if rs.Err == nil || rt == nil || !s.canFailover(rt, cluster, topic, rs.Err) {
+			resultCh <- Response{Response: rs, Cluster: cluster}
+			return
+		}
+		for _, failoverCluster := range rt.failoverTo {
+			failoverPxy := s.proxies[failoverCluster]
+			failoverMsg, failoverErr := failoverPxy.Produce(topic, producer.AnyPartition, key, message, headers, timestamp)
+			if failoverErr == nil {
+				produceFailovers.Add(topic, 1)
+				resultCh <- Response{Response: producer.Response{Msg: failoverMsg}, Cluster: failoverCluster}
+				return
+			}
+			failoverPxy.actDesc.Log().WithError(failoverErr).Errorf("Failover produce failed: topic=%s", topic)
+		}
+		resultCh <- Response{Response: rs, Cluster: cluster}
+	}()
+	return resultCh
+}
+
+// asyncProduce submits a message to a proxy of a cluster, reporting the
+// result of writing it to Kafka if `report` is not nil.
+func asyncProduce(pxy *T, cluster, topic string, partition int32, key, message sarama.Encoder, headers []sarama.RecordHeader, timestamp time.Time, report DeliveryReporter) error {
+	if report == nil {
+		return pxy.AsyncProduce(topic, partition, key, message, headers, timestamp)
+	}
+	return pxy.AsyncProduceReported(topic, partition, key, message, headers, timestamp, func(rs producer.Response) {
+		report(cluster, rs)
+	})
+}
+
+// resolve returns a cluster name along with its proxy. An empty cluster name
+// stands for the default cluster.
+func (s *Set) resolve(cluster string) (string, *T, error) {
+	pxy, err := s.Get(cluster)
+	if err != nil {
+		return "", nil, err
+	}
+	if cluster == "" {
+		cluster = s.defaultCluster
+	}
+	return cluster, pxy, nil
+}
+
+// mirror submits a message to mirror clusters of a route asynchronously.
+func (s *Set) mirror(rt *route, topic string, partition int32, key, message sarama.Encoder, headers []sarama.RecordHeader, timestamp time.Time) {
+	for _, mirrorCluster := range rt.mirrorTo {
+		mirrorPxy := s.proxies[mirrorCluster]
+		if err := mirrorPxy.AsyncProduce(topic, partition, key, message, headers, timestamp); err != nil {
+			mirrorPxy.actDesc.Log().WithError(err).Errorf("Mirror produce failed: topic=%s", topic)
+		}
+	}
+}
+
+// canFailover tells whether a message that a cluster failed to write with
+// the given error should be failed over to another cluster, and takes a
+// token from the failover budget of the route if so.
+func (s *Set) canFailover(rt *route, cluster, topic string, err error) bool {
+	if len(rt.failoverTo) == 0 || !isFailoverError(err) {
+		return false
+	}
+	pxy := s.proxies[cluster]
+	if !rt.budget.Allow() {
+		produceFailoverRejects.Add(topic, 1)
+		pxy.actDesc.Log().WithError(err).Errorf("Failover budget spent: topic=%s", topic)
+		return false
+	}
+	pxy.actDesc.Log().WithError(err).Warnf("Failing over: topic=%s", topic)
+	return true
+}
+
+// isFailoverError tells whether a produce error may be resolved by writing
+// the message to another cluster. Errors caused by the message itself or by
+// the client are not.
+func isFailoverError(err error) bool {
+	switch errors.Cause(err).(type) {
+	case *schema.ValidationError, *ratelimit.ThrottledError:
+		return false
+	}
+	switch errors.Cause(err) {
+	case ErrHeadersUnsupported, schema.ErrInvalidMessage, sarama.ErrMessageSizeTooLarge,
+		sarama.ErrInvalidPartition, sarama.ErrUnknownTopicOrPartition:
+		return false
+	}
+	return true
+}
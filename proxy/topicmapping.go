@@ -0,0 +1,84 @@
+package proxy
+
+import (
+	"github.com/pkg/errors"
+)
+
+// ErrCrossClusterAlias is returned when a topic alias refers to a topic of
+// another cluster, but a request can only be served by a single cluster.
+var ErrCrossClusterAlias = errors.New("topic alias refers to another cluster, that is not supported by this request")
+
+// ErrUnknownTenant is returned when tenant prefixes are configured for a
+// cluster, but the client is not one of the tenants.
+var ErrUnknownTenant = errors.New("client is not a known tenant")
+
+// TopicMapping describes how a topic name that a client uses resolves to a
+// real Kafka topic.
+type TopicMapping struct {
+	// Topic name that a client used.
+	Name string
+
+	// Cluster the real topic is in.
+	Cluster string
+
+	// Name of the real topic.
+	Topic string
+
+	// Whether the name is an alias.
+	Aliased bool
+
+	// Tenant prefix prepended to the topic name.
+	Prefix string
+}
+
+// MapTopic resolves a topic name that a tenant uses with a cluster to a real
+// Kafka topic. An alias is applied first, possibly switching to another
+// cluster, and then the tenant prefix configured for the cluster the real
+// topic is in is prepended to the topic name. The proxy of that cluster is
+// returned along with the mapping. The tenant has to be an authenticated
+// client identity. If tenant prefixes are configured for that cluster, then
+// requests of other clients, including anonymous ones, are rejected with an
+// error wrapping `ErrUnknownTenant`, rather than served with real topic names.
+func (s *Set) MapTopic(cluster, tenant, topic string) (*T, TopicMapping, error) {
+	cluster, pxy, err := s.resolve(cluster)
+	if err != nil {
+		return nil, TopicMapping{}, err
+	}
+	mapping := TopicMapping{Name: topic, Cluster: cluster, Topic: topic}
+	if alias, ok := pxy.cfg.TopicMapping.Aliases[topic]; ok {
+		mapping.Aliased = true
+		mapping.Topic = alias.Topic
+		if alias.Cluster != "" {
+			mapping.Cluster = alias.Cluster
+		}
+	}
+	pxy = s.proxies[mapping.Cluster]
+	if tenantPrefixes := pxy.cfg.TopicMapping.TenantPrefixes; len(tenantPrefixes) > 0 {
+		prefix, ok := tenantPrefixes[tenant]
+		if !ok {
+			return nil, TopicMapping{}, errors.Wrapf(ErrUnknownTenant, "tenant=%q", tenant)
+		}
+		mapping.Prefix = prefix
+	}
+	mapping.Topic = mapping.Prefix + mapping.Topic
+	return pxy, mapping, nil
+}
+
+// MapLocalTopic is the same as `MapTopic` but for requests that can only be
+// served by the cluster they were made to. It returns the real topic name, or
+// ErrCrossClusterAlias if the topic name is an alias of a topic of another
+// cluster.
+func (s *Set) MapLocalTopic(cluster, tenant, topic string) (string, error) {
+	cluster, _, err := s.resolve(cluster)
+	if err != nil {
+		return "", err
+	}
+	_, mapping, err := s.MapTopic(cluster, tenant, topic)
+	if err != nil {
+		return "", err
+	}
+	if mapping.Cluster != cluster {
+		return "", errors.Wrapf(ErrCrossClusterAlias, "topic=%s, cluster=%s", topic, mapping.Cluster)
+	}
+	return mapping.Topic, nil
+}
@@ -0,0 +1,333 @@
+// Package ratelimit implements token bucket limits of produce rate per topic
+// and per client, and of browse rate per client and in total.
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/mailgun/kafka-pixy/config"
+	"golang.org/x/time/rate"
+)
+
+// ThrottledError is returned if a request exceeds a rate limit.
+type ThrottledError struct {
+	// Operation that was throttled, e.g. produce or browse.
+	Op string
+
+	// Describes the limit that was exceeded.
+	Limit string
+
+	// How long the client should wait before retrying the request.
+	RetryAfter time.Duration
+}
+
+// Error implements error.
+func (e *ThrottledError) Error() string {
+	return fmt.Sprintf("%s rate limit exceeded: %s, retry after %v", e.Op, e.Limit, e.RetryAfter)
+}
+
+// T enforces produce rate limits of a cluster.
+type T struct {
+	topics  map[string]*bucketPair
+	clients map[string]*bucketPair
+	// Buckets of clients that are not explicitly configured. It is nil if
+	// there is no default client limit.
+	defaultClients *clientSet
+}
+
+// bucketPair holds token buckets of messages and bytes. Either can be nil if
+// the respective rate is not limited.
+type bucketPair struct {
+	msgs  *rate.Limiter
+	bytes *rate.Limiter
+}
+
+// New creates a rate limiter for the given cluster config. It returns nil if
+// no limits are configured.
+func New(cfg *config.Proxy) *T {
+	if len(cfg.ProduceLimits.Topics) == 0 && len(cfg.ProduceLimits.Clients) == 0 &&
+		cfg.ProduceLimits.DefaultClient.IsZero() {
+		return nil
+	}
+	t := T{
+		topics:  make(map[string]*bucketPair, len(cfg.ProduceLimits.Topics)),
+		clients: make(map[string]*bucketPair, len(cfg.ProduceLimits.Clients)),
+	}
+	if !cfg.ProduceLimits.DefaultClient.IsZero() {
+		t.defaultClients = newClientSet(cfg.ProduceLimits.DefaultClient)
+	}
+	for topic, limit := range cfg.ProduceLimits.Topics {
+		t.topics[topic] = newBucketPair(limit)
+	}
+	for client, limit := range cfg.ProduceLimits.Clients {
+		t.clients[client] = newBucketPair(limit)
+	}
+	return &t
+}
+
+// Usage is the number of messages produced to a topic and their total size.
+type Usage struct {
+	Topic     string
+	MsgCount  int
+	ByteCount int
+}
+
+// Allow takes tokens for `msgCount` messages of `byteCount` total size
+// produced by a client to a topic. If any of the applicable limits does not
+// have enough tokens, then no tokens are taken, and `*ThrottledError` is
+// returned that tells when the request can be retried. An empty client is
+// anonymous and is subject to the default client limits.
+func (t *T) Allow(client, topic string, msgCount, byteCount int) error {
+	return t.AllowTopics(client, []Usage{{Topic: topic, MsgCount: msgCount, ByteCount: byteCount}})
+}
+
+// AllowTopics is like `Allow`, but for messages produced by a client to
+// several topics at once. Tokens are taken from the buckets of all topics and
+// of the client together, so either all messages are allowed or none.
+func (t *T) AllowTopics(client string, usages []Usage) error {
+	rs := newReservations()
+	var msgCount, byteCount int
+	for _, u := range usages {
+		msgCount += u.MsgCount
+		byteCount += u.ByteCount
+		if topicBuckets := t.topics[u.Topic]; topicBuckets != nil {
+			rs.reserve(topicBuckets.msgs, u.MsgCount, fmt.Sprintf("topic %s messages", u.Topic))
+			rs.reserve(topicBuckets.bytes, u.ByteCount, fmt.Sprintf("topic %s bytes", u.Topic))
+		}
+	}
+	if clientBuckets := t.clientBuckets(client, rs.now); clientBuckets != nil {
+		rs.reserve(clientBuckets.msgs, msgCount, fmt.Sprintf("client %q messages", client))
+		rs.reserve(clientBuckets.bytes, byteCount, fmt.Sprintf("client %q bytes", client))
+	}
+	return rs.commit("produce")
+}
+
+// clientBuckets returns token buckets of a client. Clients that are not
+// explicitly configured get their own buckets with the default limits. It
+// returns nil if the client is not limited.
+func (t *T) clientBuckets(client string, now time.Time) *bucketPair {
+	if clientBuckets := t.clients[client]; clientBuckets != nil {
+		return clientBuckets
+	}
+	if t.defaultClients == nil {
+		return nil
+	}
+	return t.defaultClients.get(client, now)
+}
+
+// PerClient enforces the same rate limit on each client separately, and
+// another one on all clients together, so that many clients cannot exceed
+// the capacity that their limits add up to.
+type PerClient struct {
+	op string
+	// It is nil if there is no client limit.
+	clients *clientSet
+	// It is nil if there is no total limit.
+	total *bucketPair
+}
+
+// NewPerClient creates a rate limiter that gives every client its own token
+// buckets with `clientLimit`, and makes all clients share token buckets with
+// `totalLimit`. The operation name is used in errors. It returns nil if
+// neither limit limits anything.
+func NewPerClient(clientLimit, totalLimit config.RateLimit, op string) *PerClient {
+	if clientLimit.IsZero() && totalLimit.IsZero() {
+		return nil
+	}
+	pc := PerClient{op: op}
+	if !clientLimit.IsZero() {
+		pc.clients = newClientSet(clientLimit)
+	}
+	if !totalLimit.IsZero() {
+		pc.total = newBucketPair(totalLimit)
+	}
+	return &pc
+}
+
+// Allow takes tokens for `msgCount` messages of `byteCount` total size from
+// the buckets of a client and the total buckets. If any of the buckets does
+// not have enough tokens, including the case when it is in debt after
+// `Charge`, then no tokens are taken, and `*ThrottledError` is returned.
+func (pc *PerClient) Allow(client string, msgCount, byteCount int) error {
+	rs := newReservations()
+	if pc.clients != nil {
+		clientBuckets := pc.clients.get(client, rs.now)
+		rs.reserve(clientBuckets.msgs, msgCount, fmt.Sprintf("client %q messages", client))
+		rs.reserve(clientBuckets.bytes, byteCount, fmt.Sprintf("client %q bytes", client))
+	}
+	if pc.total != nil {
+		rs.reserve(pc.total.msgs, msgCount, "total messages")
+		rs.reserve(pc.total.bytes, byteCount, "total bytes")
+	}
+	return rs.commit(pc.op)
+}
+
+// Charge takes tokens for `msgCount` messages of `byteCount` total size from
+// the buckets of a client and the total buckets even if there is not enough
+// of them. It is used to account for resources that are only known after a
+// request has been served, the resulting debt makes subsequent requests wait.
+func (pc *PerClient) Charge(client string, msgCount, byteCount int) {
+	rs := newReservations()
+	if pc.clients != nil {
+		clientBuckets := pc.clients.get(client, rs.now)
+		rs.reserve(clientBuckets.msgs, msgCount, "")
+		rs.reserve(clientBuckets.bytes, byteCount, "")
+	}
+	if pc.total != nil {
+		rs.reserve(pc.total.msgs, msgCount, "")
+		rs.reserve(pc.total.bytes, byteCount, "")
+	}
+}
+
+// clientSet holds token buckets of clients that are subject to the same
+// limit. A bucket that has been idle long enough to refill completely is
+// indistinguishable from a new one, so such buckets are evicted, and the
+// number of buckets is bounded by the number of recently active clients.
+type clientSet struct {
+	limit   config.RateLimit
+	idleTTL time.Duration
+
+	mu        sync.Mutex
+	clients   map[string]*clientBuckets
+	nextSweep time.Time
+}
+
+type clientBuckets struct {
+	*bucketPair
+	lastUsed time.Time
+}
+
+func newClientSet(limit config.RateLimit) *clientSet {
+	return &clientSet{
+		limit:   limit,
+		idleTTL: refillTime(limit),
+		clients: make(map[string]*clientBuckets),
+	}
+}
+
+// get returns buckets of a client creating them if necessary. Every once in
+// a refill time buckets of clients that have been idle for longer than that
+// are evicted, unless they are still in debt after `Charge`.
+func (cs *clientSet) get(client string, now time.Time) *bucketPair {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	if !now.Before(cs.nextSweep) {
+		for otherClient, otherBuckets := range cs.clients {
+			if now.Sub(otherBuckets.lastUsed) > cs.idleTTL && otherBuckets.isFull(now) {
+				delete(cs.clients, otherClient)
+			}
+		}
+		cs.nextSweep = now.Add(cs.idleTTL)
+	}
+	buckets := cs.clients[client]
+	if buckets == nil {
+		buckets = &clientBuckets{bucketPair: newBucketPair(cs.limit)}
+		cs.clients[client] = buckets
+	}
+	buckets.lastUsed = now
+	return buckets.bucketPair
+}
+
+func (cs *clientSet) size() int {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	return len(cs.clients)
+}
+
+// refillTime returns how long it takes for empty buckets with the given limit
+// to refill completely.
+func refillTime(limit config.RateLimit) time.Duration {
+	var maxSeconds float64
+	if limit.MessagesPerSecond > 0 {
+		maxSeconds = float64(burstOf(limit.MessagesPerSecond, limit.MessagesBurst)) / limit.MessagesPerSecond
+	}
+	if limit.BytesPerSecond > 0 {
+		maxSeconds = math.Max(maxSeconds, float64(burstOf(limit.BytesPerSecond, limit.BytesBurst))/limit.BytesPerSecond)
+	}
+	return time.Duration(maxSeconds * float64(time.Second))
+}
+
+// reservations collects tokens reserved in several buckets at the same time,
+// so that they can be returned if any of the buckets does not have enough.
+type reservations struct {
+	now        time.Time
+	taken      []*rate.Reservation
+	retryAfter time.Duration
+	limit      string
+}
+
+func newReservations() *reservations {
+	return &reservations{now: time.Now()}
+}
+
+// reserve takes `n` tokens from a bucket, that can be nil if the respective
+// rate is not limited.
+func (rs *reservations) reserve(limiter *rate.Limiter, n int, desc string) {
+	if limiter == nil {
+		return
+	}
+	// A request bigger than the bucket takes all tokens there is, or it
+	// would never be allowed.
+	if n > limiter.Burst() {
+		n = limiter.Burst()
+	}
+	r := limiter.ReserveN(rs.now, n)
+	rs.taken = append(rs.taken, r)
+	if delay := r.DelayFrom(rs.now); delay > rs.retryAfter {
+		rs.retryAfter = delay
+		rs.limit = desc
+	}
+}
+
+// commit keeps the reserved tokens if all buckets had enough of them.
+// Otherwise the tokens are returned to the buckets, and `*ThrottledError` is
+// returned.
+func (rs *reservations) commit(op string) error {
+	if rs.retryAfter == 0 {
+		return nil
+	}
+	for _, r := range rs.taken {
+		r.CancelAt(rs.now)
+	}
+	return &ThrottledError{Op: op, Limit: rs.limit, RetryAfter: rs.retryAfter}
+}
+
+// isFull tells whether both buckets have all their tokens.
+func (bp *bucketPair) isFull(now time.Time) bool {
+	return isFull(bp.msgs, now) && isFull(bp.bytes, now)
+}
+
+func isFull(limiter *rate.Limiter, now time.Time) bool {
+	if limiter == nil {
+		return true
+	}
+	r := limiter.ReserveN(now, limiter.Burst())
+	defer r.CancelAt(now)
+	return r.DelayFrom(now) == 0
+}
+
+func newBucketPair(limit config.RateLimit) *bucketPair {
+	return &bucketPair{
+		msgs:  newLimiter(limit.MessagesPerSecond, limit.MessagesBurst),
+		bytes: newLimiter(limit.BytesPerSecond, limit.BytesBurst),
+	}
+}
+
+func newLimiter(ratePerSec float64, burst int) *rate.Limiter {
+	if ratePerSec == 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(ratePerSec), burstOf(ratePerSec, burst))
+}
+
+// burstOf returns the bucket size for a rate. If it is not configured, then
+// it is one second worth of tokens.
+func burstOf(ratePerSec float64, burst int) int {
+	if burst == 0 {
+		return int(math.Ceil(ratePerSec))
+	}
+	return burst
+}
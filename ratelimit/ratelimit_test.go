@@ -0,0 +1,201 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mailgun/kafka-pixy/config"
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+type RateLimitSuite struct {
+	cfg *config.Proxy
+}
+
+var _ = Suite(&RateLimitSuite{})
+
+func (s *RateLimitSuite) SetUpTest(c *C) {
+	s.cfg = config.DefaultProxy()
+}
+
+// If no limits are configured, then no limiter is created.
+func (s *RateLimitSuite) TestNoLimits(c *C) {
+	c.Assert(New(s.cfg), IsNil)
+}
+
+// Topic limits are shared by all clients, and throttled requests are given a
+// retry after hint.
+func (s *RateLimitSuite) TestTopicLimit(c *C) {
+	s.cfg.ProduceLimits.Topics = map[string]config.RateLimit{
+		"foo": {MessagesPerSecond: 10, MessagesBurst: 3},
+	}
+	rl := New(s.cfg)
+
+	c.Assert(rl.Allow("a", "foo", 2, 100), IsNil)
+	c.Assert(rl.Allow("b", "foo", 1, 100), IsNil)
+
+	// When
+	err := rl.Allow("c", "foo", 1, 100)
+
+	// Then
+	c.Assert(err, FitsTypeOf, &ThrottledError{})
+	throttledErr := err.(*ThrottledError)
+	c.Assert(throttledErr.Limit, Equals, "topic foo messages")
+	c.Assert(throttledErr.RetryAfter > 0, Equals, true)
+	c.Assert(throttledErr.RetryAfter <= 100*time.Millisecond, Equals, true)
+
+	// Other topics are not limited.
+	c.Assert(rl.Allow("c", "bar", 100, 100), IsNil)
+
+	// Tokens are replenished with time.
+	time.Sleep(throttledErr.RetryAfter)
+	c.Assert(rl.Allow("c", "foo", 1, 100), IsNil)
+}
+
+// Clients that are not explicitly configured get the default limits each.
+func (s *RateLimitSuite) TestClientLimits(c *C) {
+	s.cfg.ProduceLimits.Clients = map[string]config.RateLimit{
+		"vip": {BytesPerSecond: 1000},
+	}
+	s.cfg.ProduceLimits.DefaultClient = config.RateLimit{BytesPerSecond: 1, BytesBurst: 100}
+	rl := New(s.cfg)
+
+	c.Assert(rl.Allow("vip", "foo", 1, 1000), IsNil)
+	c.Assert(rl.Allow("a", "foo", 1, 100), IsNil)
+	c.Assert(rl.Allow("b", "foo", 1, 100), IsNil)
+	c.Assert(rl.Allow("", "foo", 1, 100), IsNil)
+
+	err := rl.Allow("a", "foo", 1, 1)
+	c.Assert(err, ErrorMatches, `produce rate limit exceeded: client "a" bytes, retry after .*`)
+	err = rl.Allow("vip", "foo", 1, 1)
+	c.Assert(err, ErrorMatches, `produce rate limit exceeded: client "vip" bytes, retry after .*`)
+}
+
+// If a request is throttled by one limit, then no tokens are taken from the
+// other limits.
+func (s *RateLimitSuite) TestNoTokensTakenIfThrottled(c *C) {
+	s.cfg.ProduceLimits.Topics = map[string]config.RateLimit{
+		"foo": {MessagesPerSecond: 0.001, MessagesBurst: 2},
+	}
+	s.cfg.ProduceLimits.DefaultClient = config.RateLimit{MessagesPerSecond: 0.001, MessagesBurst: 1}
+	rl := New(s.cfg)
+
+	c.Assert(rl.Allow("a", "foo", 1, 1), IsNil)
+
+	// When
+	err := rl.Allow("a", "foo", 1, 1)
+
+	// Then
+	c.Assert(err, ErrorMatches, `produce rate limit exceeded: client "a" messages, .*`)
+	c.Assert(rl.Allow("b", "foo", 1, 1), IsNil)
+}
+
+// If messages produced to several topics are throttled by a limit of one of
+// them, then no tokens are taken from limits of the other topics or of the
+// client.
+func (s *RateLimitSuite) TestAllowTopicsAllOrNothing(c *C) {
+	s.cfg.ProduceLimits.Topics = map[string]config.RateLimit{
+		"foo": {MessagesPerSecond: 0.001, MessagesBurst: 2},
+		"bar": {MessagesPerSecond: 0.001, MessagesBurst: 2},
+	}
+	s.cfg.ProduceLimits.DefaultClient = config.RateLimit{MessagesPerSecond: 0.001, MessagesBurst: 4}
+	rl := New(s.cfg)
+	c.Assert(rl.Allow("b", "bar", 1, 1), IsNil)
+
+	// When
+	err := rl.AllowTopics("a", []Usage{
+		{Topic: "foo", MsgCount: 2, ByteCount: 2},
+		{Topic: "bar", MsgCount: 2, ByteCount: 2},
+	})
+
+	// Then
+	c.Assert(err, ErrorMatches, `produce rate limit exceeded: topic bar messages, .*`)
+	c.Assert(rl.Allow("c", "foo", 2, 2), IsNil)
+	c.Assert(rl.Allow("a", "baz", 4, 4), IsNil)
+	c.Assert(rl.Allow("a", "baz", 1, 1), ErrorMatches, `produce rate limit exceeded: client "a" messages, .*`)
+}
+
+// A request bigger than the bucket is allowed if the bucket is full.
+func (s *RateLimitSuite) TestBiggerThanBurst(c *C) {
+	s.cfg.ProduceLimits.DefaultClient = config.RateLimit{BytesPerSecond: 0.001, BytesBurst: 10}
+	rl := New(s.cfg)
+
+	c.Assert(rl.Allow("a", "foo", 1, 1000), IsNil)
+	c.Assert(rl.Allow("a", "foo", 1, 1), NotNil)
+}
+
+// Every client has its own buckets, and charged tokens delay subsequent
+// requests of the client.
+func (s *RateLimitSuite) TestPerClient(c *C) {
+	c.Assert(NewPerClient(config.RateLimit{}, config.RateLimit{}, "browse"), IsNil)
+	pc := NewPerClient(config.RateLimit{MessagesPerSecond: 10, BytesPerSecond: 1000}, config.RateLimit{}, "browse")
+
+	c.Assert(pc.Allow("a", 10, 0), IsNil)
+	c.Assert(pc.Allow("b", 10, 0), IsNil)
+	err := pc.Allow("a", 1, 0)
+	c.Assert(err, ErrorMatches, `browse rate limit exceeded: client "a" messages, retry after .*`)
+
+	// When
+	pc.Charge("b", 0, 500)
+	pc.Charge("b", 0, 1000)
+
+	// Then
+	err = pc.Allow("b", 0, 0)
+	c.Assert(err, ErrorMatches, `browse rate limit exceeded: client "b" bytes, retry after .*`)
+	c.Assert(err.(*ThrottledError).RetryAfter > 400*time.Millisecond, Equals, true)
+}
+
+// The total limit applies to all clients together, and tokens taken from
+// client buckets are returned if the total limit is exceeded.
+func (s *RateLimitSuite) TestPerClientTotal(c *C) {
+	pc := NewPerClient(config.RateLimit{MessagesPerSecond: 10}, config.RateLimit{MessagesPerSecond: 15}, "browse")
+
+	c.Assert(pc.Allow("a", 10, 0), IsNil)
+	c.Assert(pc.Allow("b", 5, 0), IsNil)
+
+	// When
+	err := pc.Allow("b", 5, 0)
+
+	// Then
+	c.Assert(err, ErrorMatches, `browse rate limit exceeded: total messages, retry after .*`)
+	c.Assert(pc.Allow("c", 0, 0), IsNil)
+	pc.Charge("c", 0, 1000)
+	c.Assert(pc.Allow("b", 5, 0), NotNil)
+
+	// Without a client limit only the total one applies.
+	pc = NewPerClient(config.RateLimit{}, config.RateLimit{MessagesPerSecond: 10}, "browse")
+	c.Assert(pc.clients, IsNil)
+	c.Assert(pc.Allow("a", 10, 0), IsNil)
+	c.Assert(pc.Allow("b", 1, 0), ErrorMatches, `browse rate limit exceeded: total messages, .*`)
+}
+
+// Buckets with default limits are created only if there is a default limit,
+// and are evicted once they are idle and full again.
+func (s *RateLimitSuite) TestIdleClientsEvicted(c *C) {
+	s.cfg.ProduceLimits.Topics = map[string]config.RateLimit{
+		"foo": {MessagesPerSecond: 1000},
+	}
+	rl := New(s.cfg)
+	c.Assert(rl.Allow("a", "foo", 1, 1), IsNil)
+	c.Assert(rl.defaultClients, IsNil)
+	c.Assert(rl.clients, HasLen, 0)
+
+	pc := NewPerClient(config.RateLimit{MessagesPerSecond: 100, MessagesBurst: 1}, config.RateLimit{}, "browse")
+	c.Assert(pc.Allow("a", 1, 0), IsNil)
+	for i := 0; i < 5; i++ {
+		pc.Charge("b", 1, 0)
+	}
+	c.Assert(pc.clients.size(), Equals, 2)
+
+	// When
+	time.Sleep(20 * time.Millisecond)
+	c.Assert(pc.Allow("c", 1, 0), IsNil)
+
+	// Then
+	c.Assert(pc.clients.size(), Equals, 2)
+	c.Assert(pc.Allow("b", 1, 0), NotNil)
+}
@@ -0,0 +1,198 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoparse"
+	"github.com/jhump/protoreflect/dynamic"
+	"github.com/linkedin/goavro/v2"
+	"github.com/pkg/errors"
+)
+
+const protoSchemaFileName = "schema.proto"
+
+// codec converts messages between JSON and a schema binary encoding.
+type codec interface {
+	// encode converts a JSON message into the binary encoding. For Protobuf
+	// the returned data is prefixed with the message indexes of the message
+	// type. If messageType is empty, then the first type is used.
+	encode(msg []byte, messageType string) ([]byte, error)
+
+	// decode converts data produced by encode back to JSON.
+	decode(data []byte) ([]byte, error)
+}
+
+func newCodec(info *Info) (codec, error) {
+	switch info.SchemaType {
+	case schemaTypeAvro:
+		return newAvroCodec(info.Schema)
+	case schemaTypeProtobuf:
+		return newProtoCodec(info.Schema)
+	default:
+		return nil, errors.Errorf("unsupported schema type: %s", info.SchemaType)
+	}
+}
+
+type avroCodec struct {
+	codec *goavro.Codec
+}
+
+func newAvroCodec(schema string) (*avroCodec, error) {
+	c, err := goavro.NewCodec(schema)
+	if err != nil {
+		return nil, errors.Wrap(err, "bad Avro schema")
+	}
+	return &avroCodec{codec: c}, nil
+}
+
+// encode implements codec.
+func (c *avroCodec) encode(msg []byte, _ string) ([]byte, error) {
+	native, rest, err := c.codec.NativeFromTextual(msg)
+	if err != nil {
+		return nil, err
+	}
+	if len(bytes.TrimSpace(rest)) != 0 {
+		return nil, errors.New("trailing data after message")
+	}
+	return c.codec.BinaryFromNative(nil, native)
+}
+
+// decode implements codec.
+func (c *avroCodec) decode(data []byte) ([]byte, error) {
+	native, _, err := c.codec.NativeFromBinary(data)
+	if err != nil {
+		return nil, err
+	}
+	return c.codec.TextualFromNative(nil, native)
+}
+
+type protoCodec struct {
+	fd *desc.FileDescriptor
+}
+
+func newProtoCodec(schema string) (*protoCodec, error) {
+	parser := protoparse.Parser{
+		Accessor: protoparse.FileContentsFromMap(map[string]string{protoSchemaFileName: schema}),
+	}
+	fds, err := parser.ParseFiles(protoSchemaFileName)
+	if err != nil {
+		return nil, errors.Wrap(err, "bad Protobuf schema")
+	}
+	if len(fds[0].GetMessageTypes()) == 0 {
+		return nil, errors.New("no message types in Protobuf schema")
+	}
+	return &protoCodec{fd: fds[0]}, nil
+}
+
+// encode implements codec.
+func (c *protoCodec) encode(msg []byte, messageType string) ([]byte, error) {
+	md := c.fd.GetMessageTypes()[0]
+	if messageType != "" {
+		if md = c.fd.FindMessage(messageType); md == nil {
+			return nil, errors.Errorf("message type %s not found in schema", messageType)
+		}
+	}
+	dm := dynamic.NewMessage(md)
+	if err := dm.UnmarshalJSON(msg); err != nil {
+		return nil, err
+	}
+	body, err := dm.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return append(encodeMessageIndexes(messageIndexes(md)), body...), nil
+}
+
+// decode implements codec.
+func (c *protoCodec) decode(data []byte) ([]byte, error) {
+	indexes, body, err := decodeMessageIndexes(data)
+	if err != nil {
+		return nil, err
+	}
+	md, err := c.messageByIndexes(indexes)
+	if err != nil {
+		return nil, err
+	}
+	dm := dynamic.NewMessage(md)
+	if err := dm.Unmarshal(body); err != nil {
+		return nil, err
+	}
+	return dm.MarshalJSON()
+}
+
+func (c *protoCodec) messageByIndexes(indexes []int) (*desc.MessageDescriptor, error) {
+	mds := c.fd.GetMessageTypes()
+	var md *desc.MessageDescriptor
+	for _, idx := range indexes {
+		if idx < 0 || idx >= len(mds) {
+			return nil, errors.Errorf("bad message indexes: %v", indexes)
+		}
+		md = mds[idx]
+		mds = md.GetNestedMessageTypes()
+	}
+	return md, nil
+}
+
+// messageIndexes returns the path to a message type in the schema file as a
+// list of indexes of the message and its parents in their parent definitions.
+func messageIndexes(md *desc.MessageDescriptor) []int {
+	var indexes []int
+	for {
+		var siblings []*desc.MessageDescriptor
+		parent, ok := md.GetParent().(*desc.MessageDescriptor)
+		if ok {
+			siblings = parent.GetNestedMessageTypes()
+		} else {
+			siblings = md.GetFile().GetMessageTypes()
+		}
+		for i, sibling := range siblings {
+			if sibling == md {
+				indexes = append([]int{i}, indexes...)
+				break
+			}
+		}
+		if !ok {
+			return indexes
+		}
+		md = parent
+	}
+}
+
+// encodeMessageIndexes encodes message indexes the way Confluent serializers
+// do: a count followed by the indexes, all zig-zag varints. The most common
+// case of the first message type is encoded as a single zero.
+func encodeMessageIndexes(indexes []int) []byte {
+	if len(indexes) == 1 && indexes[0] == 0 {
+		return []byte{0}
+	}
+	buf := make([]byte, 0, (len(indexes)+1)*binary.MaxVarintLen32)
+	var tmp [binary.MaxVarintLen64]byte
+	buf = append(buf, tmp[:binary.PutVarint(tmp[:], int64(len(indexes)))]...)
+	for _, idx := range indexes {
+		buf = append(buf, tmp[:binary.PutVarint(tmp[:], int64(idx))]...)
+	}
+	return buf
+}
+
+func decodeMessageIndexes(data []byte) ([]int, []byte, error) {
+	count, n := binary.Varint(data)
+	if n <= 0 || count < 0 || count > int64(len(data)) {
+		return nil, nil, errors.New("bad message indexes")
+	}
+	data = data[n:]
+	if count == 0 {
+		return []int{0}, data, nil
+	}
+	indexes := make([]int, count)
+	for i := range indexes {
+		idx, n := binary.Varint(data)
+		if n <= 0 {
+			return nil, nil, errors.New("bad message indexes")
+		}
+		indexes[i] = int(idx)
+		data = data[n:]
+	}
+	return indexes, data, nil
+}
@@ -0,0 +1,191 @@
+package schema
+
+import (
+	"expvar"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mailgun/kafka-pixy/actor"
+	"github.com/mailgun/kafka-pixy/config"
+	"github.com/mailgun/kafka-pixy/none"
+	"github.com/pkg/errors"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// Number of produced messages rejected by JSON Schema validation by topic.
+var jsonSchemaRejects = expvar.NewMap("produce_json_schema_rejects")
+
+// ValidationError is returned if a message does not match the JSON Schema
+// bound to the topic it is produced to.
+type ValidationError struct {
+	Topic      string
+	Violations []string
+}
+
+// Error implements error.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("message does not match JSON schema of topic %s: %s",
+		e.Topic, strings.Join(e.Violations, "; "))
+}
+
+// Validator validates messages against JSON Schemas bound to topics. Schema
+// files are periodically checked for changes and reloaded.
+type Validator struct {
+	actDesc *actor.Descriptor
+	cfg     *config.Proxy
+	stopCh  chan none.T
+	wg      sync.WaitGroup
+
+	mu      sync.RWMutex
+	schemas map[string]*jsonSchemaFile
+}
+
+// jsonSchemaFile is a JSON Schema loaded from a file, along with the file
+// attributes that are used to detect changes.
+type jsonSchemaFile struct {
+	path    string
+	modTime time.Time
+	size    int64
+	schema  *gojsonschema.Schema
+}
+
+// SpawnValidator loads JSON Schemas of all configured topics and starts a
+// goroutine that reloads them on change. It returns nil if no topics are bound
+// to JSON Schemas.
+func SpawnValidator(parentActDesc *actor.Descriptor, cfg *config.Proxy) (*Validator, error) {
+	if len(cfg.JSONSchema.Topics) == 0 {
+		return nil, nil
+	}
+	v := Validator{
+		actDesc: parentActDesc.NewChild("json_schema"),
+		cfg:     cfg,
+		stopCh:  make(chan none.T),
+		schemas: make(map[string]*jsonSchemaFile, len(cfg.JSONSchema.Topics)),
+	}
+	for topic, path := range cfg.JSONSchema.Topics {
+		schemaFile, err := loadJSONSchema(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load JSON schema of topic %s", topic)
+		}
+		v.schemas[topic] = schemaFile
+	}
+	actor.Spawn(v.actDesc, &v.wg, v.run)
+	return &v, nil
+}
+
+// Validate checks a message produced to a topic against the JSON Schema bound
+// to the topic. If the message does not match, then `*ValidationError` listing
+// all schema violations is returned.
+func (v *Validator) Validate(topic string, msg []byte) error {
+	v.mu.RLock()
+	schemaFile := v.schemas[topic]
+	v.mu.RUnlock()
+	if schemaFile == nil {
+		return nil
+	}
+	result, err := schemaFile.schema.Validate(gojsonschema.NewBytesLoader(msg))
+	if err != nil {
+		jsonSchemaRejects.Add(topic, 1)
+		return &ValidationError{Topic: topic, Violations: []string{err.Error()}}
+	}
+	if result.Valid() {
+		return nil
+	}
+	jsonSchemaRejects.Add(topic, 1)
+	violations := make([]string, len(result.Errors()))
+	for i, resultErr := range result.Errors() {
+		violations[i] = resultErr.String()
+	}
+	// Properties are validated in random order, sort violations to make
+	// responses stable.
+	sort.Strings(violations)
+	return &ValidationError{Topic: topic, Violations: violations}
+}
+
+// Stop stops reloading schemas.
+func (v *Validator) Stop() {
+	close(v.stopCh)
+	v.wg.Wait()
+}
+
+func (v *Validator) run() {
+	ticker := time.NewTicker(v.cfg.JSONSchema.ReloadInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			v.reloadChanged()
+		case <-v.stopCh:
+			return
+		}
+	}
+}
+
+// reloadChanged reloads schema files that have been modified since they were
+// loaded. If a modified file cannot be loaded, then the previously loaded
+// version of the schema stays in effect.
+func (v *Validator) reloadChanged() {
+	v.mu.RLock()
+	schemas := make(map[string]*jsonSchemaFile, len(v.schemas))
+	for topic, schemaFile := range v.schemas {
+		schemas[topic] = schemaFile
+	}
+	v.mu.RUnlock()
+
+	for topic, schemaFile := range schemas {
+		fileInfo, err := os.Stat(schemaFile.path)
+		if err != nil {
+			v.actDesc.Log().WithError(err).Errorf("Failed to check JSON schema: topic=%s, path=%s", topic, schemaFile.path)
+			continue
+		}
+		if fileInfo.ModTime().Equal(schemaFile.modTime) && fileInfo.Size() == schemaFile.size {
+			continue
+		}
+		reloaded, err := loadJSONSchema(schemaFile.path)
+		if err != nil {
+			v.actDesc.Log().WithError(err).Errorf("Failed to reload JSON schema: topic=%s, path=%s", topic, schemaFile.path)
+			// Do not retry until the file changes again.
+			reloaded = &jsonSchemaFile{
+				path:    schemaFile.path,
+				modTime: fileInfo.ModTime(),
+				size:    fileInfo.Size(),
+				schema:  schemaFile.schema,
+			}
+		} else {
+			v.actDesc.Log().Infof("JSON schema reloaded: topic=%s, path=%s", topic, schemaFile.path)
+		}
+		v.mu.Lock()
+		v.schemas[topic] = reloaded
+		v.mu.Unlock()
+	}
+}
+
+func loadJSONSchema(path string) (*jsonSchemaFile, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "bad path")
+	}
+	// Stat before reading, so that a change made while the file is being read
+	// is detected on the next check.
+	fileInfo, err := os.Stat(absPath)
+	if err != nil {
+		return nil, err
+	}
+	// A reference loader is used so that relative `$ref`s are resolved
+	// relative to the schema file.
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewReferenceLoader("file://" + filepath.ToSlash(absPath)))
+	if err != nil {
+		return nil, err
+	}
+	return &jsonSchemaFile{
+		path:    path,
+		modTime: fileInfo.ModTime(),
+		size:    fileInfo.Size(),
+		schema:  schema,
+	}, nil
+}
@@ -0,0 +1,134 @@
+package schema
+
+import (
+	"expvar"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/mailgun/kafka-pixy/actor"
+	"github.com/mailgun/kafka-pixy/config"
+	"github.com/mailgun/kafka-pixy/testhelpers"
+	. "gopkg.in/check.v1"
+)
+
+const orderSchema = `{
+	"type": "object",
+	"properties": {
+		"id": {"type": "integer"},
+		"status": {"enum": ["new", "paid"]}
+	},
+	"required": ["id", "status"]
+}`
+
+type ValidatorSuite struct {
+	ns   *actor.Descriptor
+	cfg  *config.Proxy
+	path string
+}
+
+var _ = Suite(&ValidatorSuite{})
+
+func (s *ValidatorSuite) SetUpSuite(c *C) {
+	testhelpers.InitLogging()
+}
+
+func (s *ValidatorSuite) SetUpTest(c *C) {
+	s.ns = actor.Root().NewChild("T")
+	s.cfg = config.DefaultProxy()
+	s.cfg.JSONSchema.ReloadInterval = 10 * time.Millisecond
+	s.path = filepath.Join(c.MkDir(), "orders.json")
+	c.Assert(ioutil.WriteFile(s.path, []byte(orderSchema), 0644), IsNil)
+	s.cfg.JSONSchema.Topics = map[string]string{"orders": s.path}
+}
+
+// If no topics are bound to JSON schemas, then no validator is created.
+func (s *ValidatorSuite) TestNoTopics(c *C) {
+	s.cfg.JSONSchema.Topics = nil
+	v, err := SpawnValidator(s.ns, s.cfg)
+	c.Assert(err, IsNil)
+	c.Assert(v, IsNil)
+}
+
+// Messages that do not match the schema are rejected with all violations
+// listed, and rejects are counted by topic.
+func (s *ValidatorSuite) TestValidate(c *C) {
+	v, err := SpawnValidator(s.ns, s.cfg)
+	c.Assert(err, IsNil)
+	defer v.Stop()
+	rejectsBefore := rejectCount("orders")
+
+	c.Assert(v.Validate("orders", []byte(`{"id": 1, "status": "new"}`)), IsNil)
+	c.Assert(v.Validate("foo", []byte(`not json`)), IsNil)
+
+	err = v.Validate("orders", []byte(`{"id": "1", "status": "lost"}`))
+	c.Assert(err, FitsTypeOf, &ValidationError{})
+	validationErr := err.(*ValidationError)
+	c.Assert(validationErr.Topic, Equals, "orders")
+	c.Assert(validationErr.Violations, HasLen, 2)
+	c.Assert(validationErr.Violations[0], Matches, `id: Invalid type.*`)
+	c.Assert(validationErr.Violations[1], Matches, `status: status must be one of .*`)
+
+	err = v.Validate("orders", []byte(`{"id": 1`))
+	c.Assert(err, FitsTypeOf, &ValidationError{})
+
+	c.Assert(rejectCount("orders"), Equals, rejectsBefore+2)
+}
+
+// A bad schema file fails validator creation.
+func (s *ValidatorSuite) TestBadSchema(c *C) {
+	c.Assert(ioutil.WriteFile(s.path, []byte(`{"type": 1}`), 0644), IsNil)
+	_, err := SpawnValidator(s.ns, s.cfg)
+	c.Assert(err, ErrorMatches, "failed to load JSON schema of topic orders: .*")
+}
+
+// Changed schema files are reloaded, but if a changed file cannot be loaded,
+// then the previous schema version stays in effect.
+func (s *ValidatorSuite) TestReload(c *C) {
+	v, err := SpawnValidator(s.ns, s.cfg)
+	c.Assert(err, IsNil)
+	defer v.Stop()
+	msg := []byte(`{"id": 1, "status": "shipped"}`)
+	c.Assert(v.Validate("orders", msg), NotNil)
+
+	// When
+	s.writeSchema(c, `{"type": "object", "required": ["id"]}`)
+
+	// Then
+	waitFor(c, func() bool { return v.Validate("orders", msg) == nil })
+
+	// When
+	s.writeSchema(c, `{"type": `)
+	time.Sleep(50 * time.Millisecond)
+
+	// Then
+	c.Assert(v.Validate("orders", msg), IsNil)
+	c.Assert(v.Validate("orders", []byte(`{}`)), NotNil)
+}
+
+func (s *ValidatorSuite) writeSchema(c *C, schema string) {
+	c.Assert(ioutil.WriteFile(s.path, []byte(schema), 0644), IsNil)
+	// Make sure the change is detected even on file systems with coarse
+	// modification time granularity.
+	modTime := time.Now().Add(time.Duration(len(schema)) * time.Second)
+	c.Assert(os.Chtimes(s.path, modTime, modTime), IsNil)
+}
+
+func rejectCount(topic string) int64 {
+	count, ok := jsonSchemaRejects.Get(topic).(*expvar.Int)
+	if !ok {
+		return 0
+	}
+	return count.Value()
+}
+
+func waitFor(c *C, cond func() bool) {
+	for deadline := time.Now().Add(3 * time.Second); time.Now().Before(deadline); {
+		if cond() {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	c.Fatal("condition is not met in time")
+}
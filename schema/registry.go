@@ -0,0 +1,186 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	schemaTypeAvro     = "AVRO"
+	schemaTypeProtobuf = "PROTOBUF"
+
+	registryContentType = "application/vnd.schemaregistry.v1+json"
+)
+
+// Info describes a schema version in the format returned by the
+// `/subjects/<subject>/versions/<version>` endpoint of a Confluent schema
+// registry.
+type Info struct {
+	Subject    string `json:"subject"`
+	Version    int    `json:"version"`
+	ID         int32  `json:"id"`
+	SchemaType string `json:"schemaType"`
+	Schema     string `json:"schema"`
+}
+
+// registry provides access to schemas stored in a schema registry.
+type registry interface {
+	// getBySubject returns a particular version of a subject schema. If
+	// version is 0, then the latest version is returned.
+	getBySubject(subject string, version int) (*Info, error)
+
+	// getByID returns a schema by its globally unique ID.
+	getByID(id int32) (*Info, error)
+}
+
+// httpRegistry is a client of a Confluent compatible schema registry.
+type httpRegistry struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+func newHTTPRegistry(baseURL string, timeout time.Duration) *httpRegistry {
+	return &httpRegistry{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+// getBySubject implements registry.
+func (r *httpRegistry) getBySubject(subject string, version int) (*Info, error) {
+	versionStr := "latest"
+	if version > 0 {
+		versionStr = fmt.Sprint(version)
+	}
+	var info Info
+	if err := r.get(fmt.Sprintf("/subjects/%s/versions/%s", url.PathEscape(subject), versionStr), &info); err != nil {
+		return nil, err
+	}
+	if info.SchemaType == "" {
+		info.SchemaType = schemaTypeAvro
+	}
+	return &info, nil
+}
+
+// getByID implements registry.
+func (r *httpRegistry) getByID(id int32) (*Info, error) {
+	info := Info{ID: id}
+	if err := r.get(fmt.Sprintf("/schemas/ids/%d", id), &info); err != nil {
+		return nil, err
+	}
+	if info.SchemaType == "" {
+		info.SchemaType = schemaTypeAvro
+	}
+	return &info, nil
+}
+
+func (r *httpRegistry) get(path string, rs interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, r.baseURL+path, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("Accept", registryContentType)
+	httpRs, err := r.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "request failed")
+	}
+	defer httpRs.Body.Close()
+	body, err := ioutil.ReadAll(httpRs.Body)
+	if err != nil {
+		return errors.Wrap(err, "failed to read response")
+	}
+	if httpRs.StatusCode != http.StatusOK {
+		var errorRs struct {
+			ErrorCode int    `json:"error_code"`
+			Message   string `json:"message"`
+		}
+		if json.Unmarshal(body, &errorRs) == nil && errorRs.Message != "" {
+			return errors.Errorf("registry error %d: %s", errorRs.ErrorCode, errorRs.Message)
+		}
+		return errors.Errorf("registry error: status=%d", httpRs.StatusCode)
+	}
+	if err := json.Unmarshal(body, rs); err != nil {
+		return errors.Wrap(err, "bad response")
+	}
+	return nil
+}
+
+// fileRegistry is a schema registry backed by a directory of JSON files. Each
+// file describes one schema version in the `Info` format. All files are read
+// on creation.
+type fileRegistry struct {
+	byID      map[int32]*Info
+	bySubject map[string]map[int]*Info
+	latest    map[string]*Info
+}
+
+func newFileRegistry(dir string) (*fileRegistry, error) {
+	r := fileRegistry{
+		byID:      make(map[int32]*Info),
+		bySubject: make(map[string]map[int]*Info),
+		latest:    make(map[string]*Info),
+	}
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list schema files")
+	}
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read %s", path)
+		}
+		var info Info
+		if err := json.Unmarshal(data, &info); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %s", path)
+		}
+		if info.Subject == "" || info.Version <= 0 || info.ID <= 0 {
+			return nil, errors.Errorf("subject, version and id must be set in %s", path)
+		}
+		if info.SchemaType == "" {
+			info.SchemaType = schemaTypeAvro
+		}
+		if _, ok := r.byID[info.ID]; ok {
+			return nil, errors.Errorf("duplicate schema id %d in %s", info.ID, path)
+		}
+		r.byID[info.ID] = &info
+		versions := r.bySubject[info.Subject]
+		if versions == nil {
+			versions = make(map[int]*Info)
+			r.bySubject[info.Subject] = versions
+		}
+		versions[info.Version] = &info
+		if latest := r.latest[info.Subject]; latest == nil || latest.Version < info.Version {
+			r.latest[info.Subject] = &info
+		}
+	}
+	return &r, nil
+}
+
+// getBySubject implements registry.
+func (r *fileRegistry) getBySubject(subject string, version int) (*Info, error) {
+	info := r.latest[subject]
+	if version > 0 {
+		info = r.bySubject[subject][version]
+	}
+	if info == nil {
+		return nil, errors.Errorf("subject %s version %d not found", subject, version)
+	}
+	return info, nil
+}
+
+// getByID implements registry.
+func (r *fileRegistry) getByID(id int32) (*Info, error) {
+	info := r.byID[id]
+	if info == nil {
+		return nil, errors.Errorf("schema %d not found", id)
+	}
+	return info, nil
+}
@@ -0,0 +1,184 @@
+// Package schema implements validation and encoding of produced messages
+// according to schemas stored in a Confluent compatible schema registry, or
+// in a local directory that mimics one. Messages are accepted as JSON and
+// encoded into the Confluent wire format: a zero magic byte, a big-endian 4
+// byte schema ID, and the Avro or Protobuf binary encoded message. It also
+// implements validation of produced messages against JSON Schemas.
+package schema
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/mailgun/kafka-pixy/config"
+	"github.com/pkg/errors"
+)
+
+const (
+	magicByte      = 0
+	wireHeaderSize = 5
+)
+
+var (
+	// ErrInvalidMessage is returned if a message does not match the schema
+	// bound to the topic it is produced to.
+	ErrInvalidMessage = errors.New("message does not match schema")
+
+	// ErrRegistry is returned if a schema could not be retrieved from the
+	// schema registry.
+	ErrRegistry = errors.New("schema registry request failed")
+)
+
+// T validates, encodes and decodes messages of topics bound to schemas.
+type T struct {
+	registry registry
+	topics   map[string]config.TopicSchema
+	cacheTTL time.Duration
+
+	mu        sync.Mutex
+	codecs    map[int32]codec
+	subjectID map[subjectVersion]cachedID
+}
+
+type subjectVersion struct {
+	subject string
+	version int
+}
+
+type cachedID struct {
+	id        int32
+	fetchedAt time.Time
+}
+
+// New creates a schema handler for the given cluster config. It returns nil if
+// no topics are bound to schemas.
+func New(cfg *config.Proxy) (*T, error) {
+	if len(cfg.SchemaRegistry.Topics) == 0 {
+		return nil, nil
+	}
+	t := T{
+		topics:    cfg.SchemaRegistry.Topics,
+		cacheTTL:  cfg.SchemaRegistry.CacheTTL,
+		codecs:    make(map[int32]codec),
+		subjectID: make(map[subjectVersion]cachedID),
+	}
+	if cfg.SchemaRegistry.URL != "" {
+		t.registry = newHTTPRegistry(cfg.SchemaRegistry.URL, cfg.SchemaRegistry.Timeout)
+		return &t, nil
+	}
+	var err error
+	if t.registry, err = newFileRegistry(cfg.SchemaRegistry.Dir); err != nil {
+		return nil, errors.Wrap(err, "failed to load schema registry dir")
+	}
+	return &t, nil
+}
+
+// Encode validates a JSON message produced to a topic against the schema
+// bound to the topic, and returns the message encoded in the wire format. If
+// the topic is not bound to a schema, then the message is returned as is.
+func (t *T) Encode(topic string, msg []byte) ([]byte, error) {
+	topicSchema, ok := t.topics[topic]
+	if !ok {
+		return msg, nil
+	}
+	id, c, err := t.subjectCodec(subjectOf(topic, topicSchema), topicSchema.Version)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.encode(msg, topicSchema.MessageType)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidMessage, "topic %s: %s", topic, err)
+	}
+	encoded := make([]byte, wireHeaderSize, wireHeaderSize+len(body))
+	encoded[0] = magicByte
+	binary.BigEndian.PutUint32(encoded[1:wireHeaderSize], uint32(id))
+	return append(encoded, body...), nil
+}
+
+// Decode converts a message consumed from a topic to JSON if the topic is
+// bound to a schema with decoding on consume enabled. The returned flag is
+// false if the message should be returned as is.
+func (t *T) Decode(topic string, data []byte) ([]byte, bool, error) {
+	topicSchema, ok := t.topics[topic]
+	if !ok || !topicSchema.DecodeOnConsume {
+		return nil, false, nil
+	}
+	if len(data) < wireHeaderSize || data[0] != magicByte {
+		return nil, false, errors.New("message is not in schema registry wire format")
+	}
+	id := int32(binary.BigEndian.Uint32(data[1:wireHeaderSize]))
+	c, err := t.idCodec(id)
+	if err != nil {
+		return nil, false, err
+	}
+	decoded, err := c.decode(data[wireHeaderSize:])
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "failed to decode with schema %d", id)
+	}
+	return decoded, true, nil
+}
+
+func (t *T) subjectCodec(subject string, version int) (int32, codec, error) {
+	key := subjectVersion{subject, version}
+	t.mu.Lock()
+	cached, ok := t.subjectID[key]
+	// Pinned versions never change, but the latest may.
+	if ok && (version > 0 || time.Since(cached.fetchedAt) < t.cacheTTL) {
+		c := t.codecs[cached.id]
+		t.mu.Unlock()
+		return cached.id, c, nil
+	}
+	t.mu.Unlock()
+
+	info, err := t.registry.getBySubject(subject, version)
+	if err != nil {
+		return 0, nil, errors.Wrapf(ErrRegistry, "subject %s: %s", subject, err)
+	}
+	c, err := t.cacheCodec(info)
+	if err != nil {
+		return 0, nil, err
+	}
+	t.mu.Lock()
+	t.subjectID[key] = cachedID{id: info.ID, fetchedAt: time.Now()}
+	t.mu.Unlock()
+	return info.ID, c, nil
+}
+
+func (t *T) idCodec(id int32) (codec, error) {
+	t.mu.Lock()
+	c, ok := t.codecs[id]
+	t.mu.Unlock()
+	if ok {
+		return c, nil
+	}
+	info, err := t.registry.getByID(id)
+	if err != nil {
+		return nil, errors.Wrapf(ErrRegistry, "schema %d: %s", id, err)
+	}
+	return t.cacheCodec(info)
+}
+
+// cacheCodec returns a codec for a schema. Schemas are immutable, so codecs
+// are cached by schema ID forever.
+func (t *T) cacheCodec(info *Info) (codec, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if c, ok := t.codecs[info.ID]; ok {
+		return c, nil
+	}
+	c, err := newCodec(info)
+	if err != nil {
+		return nil, errors.Wrapf(err, "schema %d", info.ID)
+	}
+	t.codecs[info.ID] = c
+	return c, nil
+}
+
+func subjectOf(topic string, topicSchema config.TopicSchema) string {
+	if topicSchema.Subject != "" {
+		return topicSchema.Subject
+	}
+	return fmt.Sprintf("%s-value", topic)
+}
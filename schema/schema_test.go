@@ -0,0 +1,248 @@
+package schema
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/mailgun/kafka-pixy/config"
+	"github.com/pkg/errors"
+	. "gopkg.in/check.v1"
+)
+
+const (
+	avroSchema = `{"type": "record", "name": "User", "fields": [
+		{"name": "name", "type": "string"},
+		{"name": "age", "type": "int"}]}`
+
+	protoSchema = `syntax = "proto3";
+		package test;
+		message Event {
+			string id = 1;
+			message Payload { int32 size = 1; }
+		}
+		message User {
+			string name = 1;
+			int32 age = 2;
+		}`
+)
+
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+type SchemaSuite struct {
+	cfg *config.Proxy
+}
+
+var _ = Suite(&SchemaSuite{})
+
+func (s *SchemaSuite) SetUpTest(c *C) {
+	s.cfg = config.DefaultProxy()
+	s.cfg.SchemaRegistry.Dir = c.MkDir()
+}
+
+// If no topics are bound to schemas, then no handler is created.
+func (s *SchemaSuite) TestNoTopics(c *C) {
+	schemas, err := New(s.cfg)
+	c.Assert(err, IsNil)
+	c.Assert(schemas, IsNil)
+}
+
+// Messages produced to a topic bound to an Avro schema are encoded in the wire
+// format using the latest version of the `<topic>-value` subject.
+func (s *SchemaSuite) TestAvroEncode(c *C) {
+	s.writeSchema(c, Info{Subject: "users-value", Version: 1, ID: 7, Schema: `"string"`})
+	s.writeSchema(c, Info{Subject: "users-value", Version: 2, ID: 8, Schema: avroSchema})
+	s.cfg.SchemaRegistry.Topics = map[string]config.TopicSchema{"users": {DecodeOnConsume: true}}
+	schemas, err := New(s.cfg)
+	c.Assert(err, IsNil)
+
+	// When
+	encoded, err := schemas.Encode("users", []byte(`{"name": "Bob", "age": 42}`))
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Assert(encoded[0], Equals, byte(0))
+	c.Assert(binary.BigEndian.Uint32(encoded[1:5]), Equals, uint32(8))
+	c.Assert(encoded[5:], DeepEquals, []byte{6, 'B', 'o', 'b', 84})
+
+	decoded, ok, err := schemas.Decode("users", encoded)
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, true)
+	assertJSONEquals(c, decoded, `{"name":"Bob","age":42}`)
+}
+
+// A pinned schema version is used if configured.
+func (s *SchemaSuite) TestPinnedVersion(c *C) {
+	s.writeSchema(c, Info{Subject: "strings", Version: 1, ID: 7, Schema: `"string"`})
+	s.writeSchema(c, Info{Subject: "strings", Version: 2, ID: 8, Schema: avroSchema})
+	s.cfg.SchemaRegistry.Topics = map[string]config.TopicSchema{"foo": {Subject: "strings", Version: 1}}
+	schemas, err := New(s.cfg)
+	c.Assert(err, IsNil)
+
+	// When
+	encoded, err := schemas.Encode("foo", []byte(`"bar"`))
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Assert(encoded, DeepEquals, []byte{0, 0, 0, 0, 7, 6, 'b', 'a', 'r'})
+}
+
+// Messages that do not match the schema are rejected with `ErrInvalidMessage`.
+func (s *SchemaSuite) TestInvalidMessage(c *C) {
+	s.writeSchema(c, Info{Subject: "users-value", Version: 1, ID: 1, Schema: avroSchema})
+	s.writeSchema(c, Info{Subject: "events-value", Version: 1, ID: 2, SchemaType: schemaTypeProtobuf, Schema: protoSchema})
+	s.cfg.SchemaRegistry.Topics = map[string]config.TopicSchema{"users": {}, "events": {}}
+	schemas, err := New(s.cfg)
+	c.Assert(err, IsNil)
+
+	for i, tc := range []struct {
+		topic string
+		msg   string
+	}{
+		{topic: "users", msg: `{"name": "Bob"}`},
+		{topic: "users", msg: `{"name": "Bob", "age": "old"}`},
+		{topic: "users", msg: `{"name": "Bob", "age": 42} garbage`},
+		{topic: "users", msg: `not json`},
+		{topic: "events", msg: `{"id": 1}`},
+		{topic: "events", msg: `{"unknown": "field"}`},
+	} {
+		_, err := schemas.Encode(tc.topic, []byte(tc.msg))
+		c.Assert(errors.Cause(err), Equals, ErrInvalidMessage, Commentf("case #%d", i))
+	}
+}
+
+// Messages produced to topics that are not bound to schemas are not changed.
+func (s *SchemaSuite) TestUnboundTopic(c *C) {
+	s.writeSchema(c, Info{Subject: "users-value", Version: 1, ID: 1, Schema: avroSchema})
+	s.cfg.SchemaRegistry.Topics = map[string]config.TopicSchema{"users": {}}
+	schemas, err := New(s.cfg)
+	c.Assert(err, IsNil)
+
+	encoded, err := schemas.Encode("foo", []byte("bar"))
+	c.Assert(err, IsNil)
+	c.Assert(string(encoded), Equals, "bar")
+
+	_, ok, err := schemas.Decode("foo", []byte("bar"))
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, false)
+
+	// Decoding is disabled by default.
+	_, ok, err = schemas.Decode("users", []byte{0, 0, 0, 0, 1, 6, 'B', 'o', 'b', 84})
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, false)
+}
+
+// Protobuf messages are prefixed with Confluent message indexes of the
+// configured message type.
+func (s *SchemaSuite) TestProtobuf(c *C) {
+	s.writeSchema(c, Info{Subject: "events-value", Version: 1, ID: 3, SchemaType: schemaTypeProtobuf, Schema: protoSchema})
+	s.cfg.SchemaRegistry.Topics = map[string]config.TopicSchema{
+		"events":   {DecodeOnConsume: true},
+		"users":    {Subject: "events-value", MessageType: "test.User", DecodeOnConsume: true},
+		"payloads": {Subject: "events-value", MessageType: "test.Event.Payload", DecodeOnConsume: true},
+	}
+	schemas, err := New(s.cfg)
+	c.Assert(err, IsNil)
+
+	for i, tc := range []struct {
+		topic   string
+		msg     string
+		encoded []byte
+	}{
+		{topic: "events", msg: `{"id":"foo"}`, encoded: []byte{0, 10, 3, 'f', 'o', 'o'}},
+		{topic: "users", msg: `{"name":"Bob","age":42}`, encoded: []byte{2, 2, 10, 3, 'B', 'o', 'b', 16, 42}},
+		{topic: "payloads", msg: `{"size":5}`, encoded: []byte{4, 0, 0, 8, 5}},
+	} {
+		comment := Commentf("case #%d", i)
+		encoded, err := schemas.Encode(tc.topic, []byte(tc.msg))
+		c.Assert(err, IsNil, comment)
+		c.Assert(encoded[:5], DeepEquals, []byte{0, 0, 0, 0, 3}, comment)
+		c.Assert(encoded[5:], DeepEquals, tc.encoded, comment)
+
+		decoded, ok, err := schemas.Decode(tc.topic, encoded)
+		c.Assert(err, IsNil, comment)
+		c.Assert(ok, Equals, true, comment)
+		c.Assert(string(decoded), Equals, tc.msg, comment)
+	}
+}
+
+// Schemas are retrieved from a Confluent compatible registry. The latest
+// version is cached for the configured TTL, and schemas by ID forever.
+func (s *SchemaSuite) TestHTTPRegistry(c *C) {
+	var requestCount int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requestCount, 1)
+		w.Header().Set("Content-Type", registryContentType)
+		switch r.URL.Path {
+		case "/subjects/users-value/versions/latest":
+			json.NewEncoder(w).Encode(Info{Subject: "users-value", Version: 3, ID: 12, Schema: avroSchema})
+		case "/schemas/ids/12":
+			json.NewEncoder(w).Encode(Info{Schema: avroSchema})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error_code": 40401, "message": "Subject not found."}`)
+		}
+	}))
+	defer server.Close()
+	s.cfg.SchemaRegistry.URL = server.URL
+	s.cfg.SchemaRegistry.Topics = map[string]config.TopicSchema{"users": {DecodeOnConsume: true}, "foo": {}}
+	schemas, err := New(s.cfg)
+	c.Assert(err, IsNil)
+
+	// When
+	encoded1, err := schemas.Encode("users", []byte(`{"name": "Bob", "age": 42}`))
+	c.Assert(err, IsNil)
+	encoded2, err := schemas.Encode("users", []byte(`{"name": "Bob", "age": 42}`))
+	c.Assert(err, IsNil)
+
+	// Then
+	c.Assert(encoded1, DeepEquals, []byte{0, 0, 0, 0, 12, 6, 'B', 'o', 'b', 84})
+	c.Assert(encoded2, DeepEquals, encoded1)
+	c.Assert(atomic.LoadInt32(&requestCount), Equals, int32(1))
+
+	// A codec retrieved by subject is reused for decoding.
+	decoded, ok, err := schemas.Decode("users", encoded1)
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, true)
+	assertJSONEquals(c, decoded, `{"name":"Bob","age":42}`)
+	c.Assert(atomic.LoadInt32(&requestCount), Equals, int32(1))
+
+	_, err = schemas.Encode("foo", []byte(`"bar"`))
+	c.Assert(errors.Cause(err), Equals, ErrRegistry)
+	c.Assert(err, ErrorMatches, ".*Subject not found.*")
+}
+
+// Schema files must describe unique schema IDs.
+func (s *SchemaSuite) TestFileRegistryDuplicateID(c *C) {
+	s.writeSchema(c, Info{Subject: "foo", Version: 1, ID: 1, Schema: avroSchema})
+	s.writeSchema(c, Info{Subject: "bar", Version: 1, ID: 1, Schema: avroSchema})
+	s.cfg.SchemaRegistry.Topics = map[string]config.TopicSchema{"foo": {}}
+
+	_, err := New(s.cfg)
+
+	c.Assert(err, ErrorMatches, "failed to load schema registry dir: duplicate schema id 1 in .*")
+}
+
+func (s *SchemaSuite) writeSchema(c *C, info Info) {
+	data, err := json.Marshal(info)
+	c.Assert(err, IsNil)
+	path := filepath.Join(s.cfg.SchemaRegistry.Dir, fmt.Sprintf("%s-%d.json", info.Subject, info.Version))
+	c.Assert(ioutil.WriteFile(path, data, 0644), IsNil)
+}
+
+// assertJSONEquals compares JSON documents ignoring the order of fields, since
+// Avro records are decoded via Go maps.
+func assertJSONEquals(c *C, obtained []byte, expected string) {
+	var obtainedVal, expectedVal interface{}
+	c.Assert(json.Unmarshal(obtained, &obtainedVal), IsNil)
+	c.Assert(json.Unmarshal([]byte(expected), &expectedVal), IsNil)
+	c.Assert(obtainedVal, DeepEquals, expectedVal)
+}
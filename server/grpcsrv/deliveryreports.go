@@ -0,0 +1,91 @@
+package grpcsrv
+
+import (
+	"expvar"
+	"sync"
+
+	pb "github.com/mailgun/kafka-pixy/gen/golang"
+	"github.com/mailgun/kafka-pixy/none"
+	"github.com/mailgun/kafka-pixy/producer"
+	"github.com/mailgun/kafka-pixy/proxy"
+)
+
+const (
+	// The maximum number of delivery reports that can be awaiting to be sent
+	// to a single DeliveryReports stream. Reports that do not fit are dropped.
+	deliveryReportsBufferSize = 1024
+)
+
+var (
+	// Number of delivery reports dropped because a client had no
+	// DeliveryReports stream open, or a stream was not keeping up.
+	deliveryReportsDropped = expvar.NewInt("delivery_reports_dropped")
+)
+
+// deliveryReports fans delivery reports of messages produced by clients out to
+// DeliveryReports streams opened by the same clients. Clients are identified
+// by verified TLS client certificates only, for otherwise any client could
+// receive reports of another one by claiming its identity.
+type deliveryReports struct {
+	mu          sync.Mutex
+	subscribers map[string]map[chan *pb.DeliveryReport]none.T
+}
+
+func newDeliveryReports() *deliveryReports {
+	return &deliveryReports{subscribers: make(map[string]map[chan *pb.DeliveryReport]none.T)}
+}
+
+// subscribe returns a channel that delivery reports of messages produced by a
+// client are sent to, until the channel is unsubscribed.
+func (dr *deliveryReports) subscribe(clientID string) chan *pb.DeliveryReport {
+	reportCh := make(chan *pb.DeliveryReport, deliveryReportsBufferSize)
+	dr.mu.Lock()
+	defer dr.mu.Unlock()
+	clientSubscribers := dr.subscribers[clientID]
+	if clientSubscribers == nil {
+		clientSubscribers = make(map[chan *pb.DeliveryReport]none.T)
+		dr.subscribers[clientID] = clientSubscribers
+	}
+	clientSubscribers[reportCh] = none.V
+	return reportCh
+}
+
+func (dr *deliveryReports) unsubscribe(clientID string, reportCh chan *pb.DeliveryReport) {
+	dr.mu.Lock()
+	defer dr.mu.Unlock()
+	delete(dr.subscribers[clientID], reportCh)
+	if len(dr.subscribers[clientID]) == 0 {
+		delete(dr.subscribers, clientID)
+	}
+}
+
+// reporter returns a function that sends a delivery report for a message
+// produced by a client with a correlation ID. It never blocks, for it is
+// called by the producer dispatcher goroutine.
+func (dr *deliveryReports) reporter(clientID, correlationID string) proxy.DeliveryReporter {
+	return func(cluster string, rs producer.Response) {
+		report := pb.DeliveryReport{CorrelationId: correlationID, Cluster: cluster}
+		if rs.Err != nil {
+			report.Partition = -1
+			report.Offset = -1
+			report.ErrorCode = int32(produceErrorCode(rs.Err))
+			report.Error = rs.Err.Error()
+		} else {
+			report.Partition = rs.Msg.Partition
+			report.Offset = rs.Msg.Offset
+		}
+		dr.mu.Lock()
+		defer dr.mu.Unlock()
+		if len(dr.subscribers[clientID]) == 0 {
+			deliveryReportsDropped.Add(1)
+			return
+		}
+		for reportCh := range dr.subscribers[clientID] {
+			select {
+			case reportCh <- &report:
+			default:
+				deliveryReportsDropped.Add(1)
+			}
+		}
+	}
+}
@@ -2,9 +2,12 @@ package grpcsrv
 
 import (
 	"fmt"
+	"io"
+	"math"
 	"net"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/Shopify/sarama"
 	"github.com/mailgun/kafka-pixy/actor"
@@ -13,17 +16,41 @@ import (
 	"github.com/mailgun/kafka-pixy/consumer/offsettrk"
 	pb "github.com/mailgun/kafka-pixy/gen/golang"
 	"github.com/mailgun/kafka-pixy/offsetmgr"
+	"github.com/mailgun/kafka-pixy/producer"
+	"github.com/mailgun/kafka-pixy/producer/spool"
 	"github.com/mailgun/kafka-pixy/proxy"
+	"github.com/mailgun/kafka-pixy/ratelimit"
+	"github.com/mailgun/kafka-pixy/schema"
+	"github.com/mailgun/kafka-pixy/server"
 	"github.com/pkg/errors"
 	"github.com/samuel/go-zookeeper/zk"
 	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 const (
 	maxRequestSize = 1 * 1024 * 1024 // 1Mb
+
+	// Room left for the topic, key, headers and other request fields when a
+	// request size limit is derived from a message size limit.
+	requestOverhead = 64 * 1024
+
+	// ProduceStream stops reading messages from a stream when the producer
+	// buffer fill reaches this level, and checks the level again in
+	// streamFlowControlRecheck.
+	streamFlowControlFill    = 0.75
+	streamFlowControlRecheck = 10 * time.Millisecond
+
+	// The maximum number of messages that can be awaiting for results within
+	// a single ProduceStream call.
+	streamMaxPending = 1024
 )
 
 type T struct {
@@ -34,16 +61,21 @@ type T struct {
 	proxySet *proxy.Set
 	wg       sync.WaitGroup
 	errorCh  chan error
+
+	deliveryReports *deliveryReports
+
+	// Metadata key that identifies a client for rate limiting.
+	clientIDHeader string
 }
 
 // New creates a gRPC server instance.
-func New(addr string, proxySet *proxy.Set, srvOpts ...grpc.ServerOption) (*T, error) {
+func New(addr string, proxySet *proxy.Set, clientIDHeader string, srvOpts ...grpc.ServerOption) (*T, error) {
 	listener, err := net.Listen("tcp", addr)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create listener")
 	}
 
-	opts := append(srvOpts, grpc.MaxRecvMsgSize(maxRequestSize))
+	opts := append([]grpc.ServerOption{grpc.MaxRecvMsgSize(maxRequestSize)}, srvOpts...)
 	grpcSrv := grpc.NewServer(opts...)
 	s := T{
 		actDesc:  actor.Root().NewChild(fmt.Sprintf("grpc://%s", addr)),
@@ -51,11 +83,24 @@ func New(addr string, proxySet *proxy.Set, srvOpts ...grpc.ServerOption) (*T, er
 		grpcSrv:  grpcSrv,
 		proxySet: proxySet,
 		errorCh:  make(chan error, 1),
+
+		deliveryReports: newDeliveryReports(),
+		clientIDHeader:  clientIDHeader,
 	}
 	pb.RegisterKafkaPixyServer(grpcSrv, &s)
 	return &s, nil
 }
 
+// MaxMessageSize returns a server option that raises the request size limit
+// to fit messages up to maxMessageBytes, e.g. messages that are going to be
+// split into chunks by the producer. The limit is never lowered.
+func MaxMessageSize(maxMessageBytes int) grpc.ServerOption {
+	if maxMessageBytes+requestOverhead < maxRequestSize {
+		return grpc.MaxRecvMsgSize(maxRequestSize)
+	}
+	return grpc.MaxRecvMsgSize(maxMessageBytes + requestOverhead)
+}
+
 // Start starts triggers asynchronous gRPC server start. If it fails then the error
 // will be sent down to `ErrorCh()`.
 func (s *T) Start() {
@@ -83,53 +128,275 @@ func (s *T) Stop() {
 
 // Produce implements pb.KafkaPixyServer
 func (s *T) Produce(ctx context.Context, req *pb.ProdRq) (*pb.ProdRs, error) {
+	clientID := s.clientID(ctx)
+	pxy, mapping, err := s.proxySet.MapTopic(req.Cluster, verifiedClientID(ctx), req.Topic)
+	if err != nil {
+		return nil, mapTopicError(err)
+	}
+
+	headers := toSaramaHeaders(req.Headers)
+	partition := producer.AnyPartition
+	if req.ExplicitPartition {
+		if req.Partition < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid partition: %d", req.Partition)
+		}
+		partition = req.Partition
+	}
+	if req.Timestamp < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid timestamp: %d", req.Timestamp)
+	}
+	if req.CorrelationId != "" && !req.AsyncMode {
+		return nil, status.Errorf(codes.InvalidArgument, "correlation_id requires async_mode")
+	}
+	reportClientID := verifiedClientID(ctx)
+	if req.CorrelationId != "" && reportClientID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "correlation_id requires a client TLS certificate")
+	}
+	timestamp := fromMillis(req.Timestamp)
+	key, message := keyEncoderFor(req), sarama.StringEncoder(req.Message)
+	err = pxy.AllowProduce(clientID, &sarama.ProducerMessage{Topic: mapping.Topic, Key: key, Value: message})
+	if err != nil {
+		return nil, produceError(err)
+	}
+
+	if req.AsyncMode {
+		var report proxy.DeliveryReporter
+		if req.CorrelationId != "" {
+			report = s.deliveryReports.reporter(reportClientID, req.CorrelationId)
+		}
+		cluster, err := s.proxySet.AsyncProduce(mapping.Cluster, mapping.Topic, partition, key, message, headers, timestamp, report)
+		if err != nil {
+			return nil, produceError(err)
+		}
+		return &pb.ProdRs{Partition: -1, Offset: -1, Cluster: cluster}, nil
+	}
+
+	prodMsg, cluster, err := s.proxySet.Produce(mapping.Cluster, mapping.Topic, partition, key, message, headers, timestamp)
+	if err != nil {
+		return nil, produceError(err)
+	}
+	return &pb.ProdRs{Partition: prodMsg.Partition, Offset: prodMsg.Offset, Cluster: cluster}, nil
+}
+
+// ProduceAtomic implements pb.KafkaPixyServer
+func (s *T) ProduceAtomic(ctx context.Context, req *pb.ProdAtomicRq) (*pb.ProdAtomicRs, error) {
 	pxy, err := s.proxySet.Get(req.Cluster)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	var headers []sarama.RecordHeader
-	if len(req.Headers) > 0 {
-		headers = make([]sarama.RecordHeader, 0, len(req.Headers))
-		for _, h := range req.Headers {
-			if h == nil {
-				continue
-			}
-			headers = append(headers, sarama.RecordHeader{
-				Key:   []byte(h.Key),
-				Value: h.Value,
-			})
+	if err := pxy.CheckBatchSize(len(req.Messages)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	prodMsgs, err := toProducerMsgs(req.Messages)
+	if err != nil {
+		return nil, err
+	}
+	clientID := s.clientID(ctx)
+	tenant := verifiedClientID(ctx)
+	for _, prodMsg := range prodMsgs {
+		topic, err := s.proxySet.MapLocalTopic(req.Cluster, tenant, prodMsg.Topic)
+		if err != nil {
+			return nil, produceError(err)
 		}
+		prodMsg.Topic = topic
+	}
+	if err := pxy.AllowProduce(clientID, prodMsgs...); err != nil {
+		return nil, produceError(err)
 	}
+	if err := pxy.ProduceAtomic(prodMsgs); err != nil {
+		return nil, produceError(err)
+	}
+	res := pb.ProdAtomicRs{Results: make([]*pb.ProdRs, len(prodMsgs))}
+	for i, prodMsg := range prodMsgs {
+		res.Results[i] = &pb.ProdRs{Partition: prodMsg.Partition, Offset: prodMsg.Offset}
+	}
+	return &res, nil
+}
 
-	if req.AsyncMode {
-		pxy.AsyncProduce(req.Topic, keyEncoderFor(req), sarama.StringEncoder(req.Message), headers)
-		return &pb.ProdRs{Partition: -1, Offset: -1}, nil
+// ProduceBatch implements pb.KafkaPixyServer
+func (s *T) ProduceBatch(ctx context.Context, req *pb.ProdBatchRq) (*pb.ProdBatchRs, error) {
+	pxy, err := s.proxySet.Get(req.Cluster)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	prodMsg, err := pxy.Produce(req.Topic, keyEncoderFor(req), sarama.StringEncoder(req.Message), headers)
+	if err := pxy.CheckBatchSize(len(req.Messages)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	prodMsgs, err := toProducerMsgs(req.Messages)
 	if err != nil {
-		switch err {
-		case sarama.ErrUnknownTopicOrPartition:
-			return nil, status.Errorf(codes.InvalidArgument, err.Error())
-		case proxy.ErrDisabled:
-			fallthrough
-		case proxy.ErrUnavailable:
-			return nil, status.Errorf(codes.Unavailable, err.Error())
-		case proxy.ErrHeadersUnsupported:
-			return nil, status.Errorf(codes.InvalidArgument, err.Error())
-		default:
-			return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, err
+	}
+
+	// Messages that exceed rate limits are rejected individually.
+	clientID := s.clientID(ctx)
+	tenant := verifiedClientID(ctx)
+	allowed := make([]*sarama.ProducerMessage, 0, len(prodMsgs))
+	responses := make([]proxy.Response, len(prodMsgs))
+	for i, prodMsg := range prodMsgs {
+		topic, err := s.proxySet.MapLocalTopic(req.Cluster, tenant, prodMsg.Topic)
+		if err != nil {
+			responses[i].Response = producer.Response{Msg: prodMsg, Err: err}
+			continue
+		}
+		prodMsg.Topic = topic
+		if err := pxy.AllowProduce(clientID, prodMsg); err != nil {
+			responses[i].Response = producer.Response{Msg: prodMsg, Err: err}
+			continue
+		}
+		allowed = append(allowed, prodMsg)
+	}
+	allowedResponses, err := s.proxySet.ProduceBatch(req.Cluster, allowed)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	for i := range responses {
+		if responses[i].Err == nil {
+			responses[i], allowedResponses = allowedResponses[0], allowedResponses[1:]
+		}
+	}
+
+	res := pb.ProdBatchRs{Results: make([]*pb.ProdBatchResult, len(responses))}
+	for i, rs := range responses {
+		if rs.Err != nil {
+			res.Results[i] = &pb.ProdBatchResult{
+				Partition: -1,
+				Offset:    -1,
+				ErrorCode: int32(produceErrorCode(rs.Err)),
+				Error:     rs.Err.Error(),
+			}
+			continue
+		}
+		res.Results[i] = &pb.ProdBatchResult{Partition: rs.Msg.Partition, Offset: rs.Msg.Offset, Cluster: rs.Cluster}
+	}
+	return &res, nil
+}
+
+// ProduceStream implements pb.KafkaPixyServer
+func (s *T) ProduceStream(stream pb.KafkaPixy_ProduceStreamServer) error {
+	var wg sync.WaitGroup
+	var sendErr error
+	pendingCh := make(chan pendingProdStreamRs, streamMaxPending)
+	actor.Spawn(s.actDesc.NewChild("prod_stream"), &wg, func() {
+		sendErr = sendProduceStreamResults(stream, pendingCh)
+	})
+	recvErr := s.recvProduceStreamRequests(stream, pendingCh)
+	close(pendingCh)
+	wg.Wait()
+	if recvErr != nil {
+		return recvErr
+	}
+	return sendErr
+}
+
+type pendingProdStreamRs struct {
+	seq        int64
+	responseCh <-chan proxy.Response
+}
+
+// recvProduceStreamRequests reads messages from the stream and submits them to
+// the producer until the client closes the stream. It stops reading while the
+// producer buffer is filled up.
+func (s *T) recvProduceStreamRequests(stream pb.KafkaPixy_ProduceStreamServer, pendingCh chan<- pendingProdStreamRs) error {
+	ctx := stream.Context()
+	clientID := s.clientID(ctx)
+	tenant := verifiedClientID(ctx)
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if req.Message == nil {
+			return status.Errorf(codes.InvalidArgument, "message missing: seq=%d", req.Seq)
+		}
+		if req.Message.Timestamp < 0 {
+			return status.Errorf(codes.InvalidArgument, "invalid timestamp: %d, seq=%d", req.Message.Timestamp, req.Seq)
+		}
+		pxy, mapping, err := s.proxySet.MapTopic(req.Cluster, tenant, req.Message.Topic)
+		if err != nil {
+			return mapTopicError(err)
+		}
+		for pxy.ProducerBufferFill() >= streamFlowControlFill {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(streamFlowControlRecheck):
+			}
+		}
+		prodMsg := toProducerMsg(req.Message)
+		prodMsg.Topic = mapping.Topic
+		var responseCh <-chan proxy.Response
+		if err := pxy.AllowProduce(clientID, prodMsg); err != nil {
+			failedCh := make(chan proxy.Response, 1)
+			failedCh <- proxy.Response{Response: producer.Response{Msg: prodMsg, Err: err}}
+			responseCh = failedCh
+		} else {
+			responseCh = s.proxySet.AsyncProduceWithResult(mapping.Cluster, prodMsg.Topic, prodMsg.Key, prodMsg.Value, prodMsg.Headers, prodMsg.Timestamp)
+		}
+		select {
+		case pendingCh <- pendingProdStreamRs{seq: req.Seq, responseCh: responseCh}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// sendProduceStreamResults sends results of submitted messages to the stream
+// in the order messages were received. If sending fails, then the remaining
+// results are discarded.
+func sendProduceStreamResults(stream pb.KafkaPixy_ProduceStreamServer, pendingCh <-chan pendingProdStreamRs) error {
+	var sendErr error
+	for pending := range pendingCh {
+		rs := <-pending.responseCh
+		if sendErr != nil {
+			continue
+		}
+		res := pb.ProdStreamRs{Seq: pending.seq}
+		if rs.Err != nil {
+			res.Partition = -1
+			res.Offset = -1
+			res.ErrorCode = int32(produceErrorCode(rs.Err))
+			res.Error = rs.Err.Error()
+		} else {
+			res.Partition = rs.Msg.Partition
+			res.Offset = rs.Msg.Offset
+			res.Cluster = rs.Cluster
+		}
+		sendErr = stream.Send(&res)
+	}
+	return sendErr
+}
+
+// DeliveryReports implements pb.KafkaPixyServer
+func (s *T) DeliveryReports(req *pb.DeliveryReportsRq, stream pb.KafkaPixy_DeliveryReportsServer) error {
+	ctx := stream.Context()
+	clientID := verifiedClientID(ctx)
+	if clientID == "" {
+		return status.Errorf(codes.Unauthenticated, "delivery reports require a client TLS certificate")
+	}
+	reportCh := s.deliveryReports.subscribe(clientID)
+	defer s.deliveryReports.unsubscribe(clientID, reportCh)
+	for {
+		select {
+		case report := <-reportCh:
+			if err := stream.Send(report); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
 		}
 	}
-	return &pb.ProdRs{Partition: prodMsg.Partition, Offset: prodMsg.Offset}, nil
 }
 
 // ConsumeNAck implements pb.KafkaPixyServer
 func (s *T) ConsumeNAck(ctx context.Context, req *pb.ConsNAckRq) (*pb.ConsRs, error) {
-	pxy, err := s.proxySet.Get(req.Cluster)
+	pxy, mapping, err := s.proxySet.MapTopic(req.Cluster, verifiedClientID(ctx), req.Topic)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		return nil, mapTopicError(err)
 	}
 
 	var ack proxy.Ack
@@ -143,7 +410,7 @@ func (s *T) ConsumeNAck(ctx context.Context, req *pb.ConsNAckRq) (*pb.ConsRs, er
 		}
 	}
 
-	consMsg, err := pxy.Consume(req.Group, req.Topic, ack)
+	consMsg, err := pxy.Consume(req.Group, mapping.Topic, ack)
 	if err != nil {
 		switch err {
 		case consumer.ErrRequestTimeout:
@@ -161,9 +428,13 @@ func (s *T) ConsumeNAck(ctx context.Context, req *pb.ConsNAckRq) (*pb.ConsRs, er
 		}
 	}
 	res := pb.ConsRs{
-		Partition: consMsg.Partition,
-		Offset:    consMsg.Offset,
-		Message:   consMsg.Value,
+		Partition:     consMsg.Partition,
+		Offset:        consMsg.Offset,
+		Message:       consMsg.Value,
+		TimestampType: consMsg.TimestampType.String(),
+	}
+	if consMsg.TimestampType != consumer.TimestampNone {
+		res.Timestamp = toMillis(consMsg.Timestamp)
 	}
 	for _, h := range consMsg.Headers {
 		res.Headers = append(res.Headers, &pb.RecordHeader{
@@ -180,16 +451,16 @@ func (s *T) ConsumeNAck(ctx context.Context, req *pb.ConsNAckRq) (*pb.ConsRs, er
 }
 
 func (s *T) Ack(ctx context.Context, req *pb.AckRq) (*pb.AckRs, error) {
-	pxy, err := s.proxySet.Get(req.Cluster)
+	pxy, mapping, err := s.proxySet.MapTopic(req.Cluster, verifiedClientID(ctx), req.Topic)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		return nil, mapTopicError(err)
 	}
 
 	ack, err := proxy.NewAck(req.Partition, req.Offset)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, errors.Wrap(err, "invalid ack").Error())
 	}
-	if err = pxy.Ack(req.Group, req.Topic, ack); err != nil {
+	if err = pxy.Ack(req.Group, mapping.Topic, ack); err != nil {
 		return nil, status.Errorf(codes.Code(http.StatusInternalServerError), err.Error())
 	}
 	return &pb.AckRs{}, nil
@@ -300,6 +571,13 @@ func (s *T) ListConsumers(ctx context.Context, req *pb.ListConsumersRq) (*pb.Lis
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
+	// Failure to list groups coordinated by Kafka should not hide Kafka-Pixy
+	// groups, hence it is only logged.
+	nativeGroups, err := pxy.GetNativeTopicConsumers(req.Group, req.Topic)
+	if err != nil {
+		s.actDesc.Log().WithError(err).Warnf("Failed to list native consumers: topic=%s", req.Topic)
+	}
+
 	var groups map[string]map[string][]int32
 	if req.Group == "" {
 		groups, err = pxy.GetAllTopicConsumers(req.Topic)
@@ -311,7 +589,8 @@ func (s *T) ListConsumers(ctx context.Context, req *pb.ListConsumersRq) (*pb.Lis
 		}
 	} else {
 		groupConsumers, err := pxy.GetTopicConsumers(req.Group, req.Topic)
-		if err != nil {
+		// A group coordinated by Kafka is not registered in ZooKeeper.
+		if err != nil && len(nativeGroups) == 0 {
 			if errors.Cause(err) == zk.ErrNoNode {
 				return nil, status.Errorf(codes.NotFound, err.Error())
 			}
@@ -326,7 +605,7 @@ func (s *T) ListConsumers(ctx context.Context, req *pb.ListConsumersRq) (*pb.Lis
 	}
 
 	var res pb.ListConsumersRs
-	res.Groups = make(map[string]*pb.ConsumerGroups, len(groups))
+	res.Groups = make(map[string]*pb.ConsumerGroups, len(groups)+len(nativeGroups))
 
 	for group, consumers := range groups {
 		consGroup := new(pb.ConsumerGroups)
@@ -336,6 +615,29 @@ func (s *T) ListConsumers(ctx context.Context, req *pb.ListConsumersRq) (*pb.Lis
 		}
 		res.Groups[group] = consGroup
 	}
+	for group, nativeGroup := range nativeGroups {
+		// Should a Kafka-Pixy group have the same name as a group coordinated
+		// by Kafka, then their consumers are listed together, and the group
+		// is marked as mixed.
+		consGroup := res.Groups[group]
+		if consGroup == nil {
+			consGroup = new(pb.ConsumerGroups)
+			consGroup.Consumers = make(map[string]*pb.ConsumerPartitions, len(nativeGroup.Members))
+			consGroup.Native = true
+			res.Groups[group] = consGroup
+		} else {
+			consGroup.Mixed = true
+		}
+		consGroup.State = nativeGroup.State
+		consGroup.Protocol = nativeGroup.Protocol
+		for memberID, member := range nativeGroup.Members {
+			consGroup.Consumers[memberID] = &pb.ConsumerPartitions{
+				Partitions: member.Partitions,
+				ClientId:   member.ClientID,
+				ClientHost: member.ClientHost,
+			}
+		}
+	}
 	return &res, nil
 }
 
@@ -375,9 +677,413 @@ func (s *T) GetTopicMetadata(ctx context.Context, req *pb.GetTopicMetadataRq) (*
 	return &res, nil
 }
 
+// DescribeCluster implements pb.KafkaPixyServer
+func (s *T) DescribeCluster(ctx context.Context, req *pb.DescribeClusterRq) (*pb.DescribeClusterRs, error) {
+	pxy, err := s.proxySet.Get(req.Cluster)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	cm, err := pxy.DescribeCluster()
+	if err != nil {
+		if errors.Cause(err) == proxy.ErrUnavailable {
+			return nil, status.Errorf(codes.Unavailable, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	res := pb.DescribeClusterRs{
+		ControllerId:              cm.ControllerID,
+		UnderReplicatedPartitions: int32(cm.UnderReplicatedPartitions),
+		OfflinePartitions:         int32(cm.OfflinePartitions),
+	}
+	for _, bm := range cm.Brokers {
+		entry := pb.BrokerMetadata{
+			Id:        bm.ID,
+			Addr:      bm.Addr,
+			Rack:      bm.Rack,
+			Connected: bm.Connected,
+		}
+		if bm.ConnErr != nil {
+			entry.Error = bm.ConnErr.Error()
+		}
+		res.Brokers = append(res.Brokers, &entry)
+	}
+	return &res, nil
+}
+
+// produceError returns a gRPC status error that corresponds to a produce
+// error. If the request was throttled, then the status details include a
+// `RetryInfo` with the time to wait before retrying.
+func produceError(err error) error {
+	return withRetryInfo(status.New(produceErrorCode(err), err.Error()), err).Err()
+}
+
+// browseError returns a gRPC status error that corresponds to a browse error.
+// If the request was throttled, then the status details include a `RetryInfo`
+// with the time to wait before retrying.
+func browseError(err error) error {
+	return withRetryInfo(status.New(browseErrorCode(err), err.Error()), err).Err()
+}
+
+// withRetryInfo adds a `RetryInfo` to the details of a status if the error
+// that it was created for is a throttling error.
+func withRetryInfo(st *status.Status, err error) *status.Status {
+	if throttledErr, ok := errors.Cause(err).(*ratelimit.ThrottledError); ok {
+		retryInfo := errdetails.RetryInfo{RetryDelay: durationpb.New(throttledErr.RetryAfter)}
+		if stWithDetails, err := st.WithDetails(&retryInfo); err == nil {
+			return stWithDetails
+		}
+	}
+	return st
+}
+
+// CreateTopic implements pb.KafkaPixyServer
+func (s *T) CreateTopic(ctx context.Context, req *pb.CreateTopicRq) (*pb.CreateTopicRs, error) {
+	pxy, err := s.proxySet.Get(req.Cluster)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if req.Partitions <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid partitions: %d", req.Partitions)
+	}
+	if req.ReplicationFactor <= 0 || req.ReplicationFactor > math.MaxInt16 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid replication factor: %d", req.ReplicationFactor)
+	}
+	err = pxy.CreateTopic(verifiedClientID(ctx), req.Topic, req.Partitions, int16(req.ReplicationFactor), req.Config, req.ValidateOnly)
+	if err != nil {
+		return nil, status.Errorf(adminErrorCode(err), err.Error())
+	}
+	return &pb.CreateTopicRs{}, nil
+}
+
+// DeleteTopic implements pb.KafkaPixyServer
+func (s *T) DeleteTopic(ctx context.Context, req *pb.DeleteTopicRq) (*pb.DeleteTopicRs, error) {
+	pxy, err := s.proxySet.Get(req.Cluster)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if err := pxy.DeleteTopic(verifiedClientID(ctx), req.Topic); err != nil {
+		return nil, status.Errorf(adminErrorCode(err), err.Error())
+	}
+	return &pb.DeleteTopicRs{}, nil
+}
+
+// AlterTopicConfig implements pb.KafkaPixyServer
+func (s *T) AlterTopicConfig(ctx context.Context, req *pb.AlterTopicConfigRq) (*pb.AlterTopicConfigRs, error) {
+	pxy, err := s.proxySet.Get(req.Cluster)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	config := make(map[string]*string, len(req.Set)+len(req.Delete))
+	for name, value := range req.Set {
+		value := value
+		config[name] = &value
+	}
+	for _, name := range req.Delete {
+		if _, ok := config[name]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "config %s is both set and deleted", name)
+		}
+		config[name] = nil
+	}
+	if err := pxy.AlterTopicConfig(verifiedClientID(ctx), req.Topic, config, req.ValidateOnly); err != nil {
+		return nil, status.Errorf(adminErrorCode(err), err.Error())
+	}
+	return &pb.AlterTopicConfigRs{}, nil
+}
+
+// AddPartitions implements pb.KafkaPixyServer
+func (s *T) AddPartitions(ctx context.Context, req *pb.AddPartitionsRq) (*pb.AddPartitionsRs, error) {
+	pxy, err := s.proxySet.Get(req.Cluster)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if req.Count <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid count: %d", req.Count)
+	}
+	if err := pxy.AddPartitions(verifiedClientID(ctx), req.Topic, req.Count, req.ValidateOnly); err != nil {
+		return nil, status.Errorf(adminErrorCode(err), err.Error())
+	}
+	return &pb.AddPartitionsRs{}, nil
+}
+
+// ElectPreferredLeaders implements pb.KafkaPixyServer
+func (s *T) ElectPreferredLeaders(ctx context.Context, req *pb.ElectPreferredLeadersRq) (*pb.ElectPreferredLeadersRs, error) {
+	pxy, err := s.proxySet.Get(req.Cluster)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	partitions := make([]admin.TopicPartition, len(req.Partitions))
+	for i, tp := range req.Partitions {
+		partitions[i] = admin.TopicPartition{Topic: tp.Topic, Partition: tp.Partition}
+	}
+	progress, err := pxy.ElectPreferredLeaders(verifiedClientID(ctx), partitions)
+	if err != nil {
+		return nil, status.Errorf(adminErrorCode(err), err.Error())
+	}
+	return &pb.ElectPreferredLeadersRs{Partitions: partitionProgressToPB(progress)}, nil
+}
+
+// ReassignPartitions implements pb.KafkaPixyServer
+func (s *T) ReassignPartitions(ctx context.Context, req *pb.ReassignPartitionsRq) (*pb.ReassignPartitionsRs, error) {
+	pxy, err := s.proxySet.Get(req.Cluster)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	reassignments := make([]admin.PartitionReassignment, len(req.Reassignments))
+	for i, pr := range req.Reassignments {
+		reassignments[i] = admin.PartitionReassignment{Topic: pr.Topic, Partition: pr.Partition, Replicas: pr.Replicas}
+	}
+	progress, err := pxy.ReassignPartitions(verifiedClientID(ctx), reassignments)
+	if err != nil {
+		return nil, status.Errorf(adminErrorCode(err), err.Error())
+	}
+	return &pb.ReassignPartitionsRs{Partitions: partitionProgressToPB(progress)}, nil
+}
+
+// GetReassignments implements pb.KafkaPixyServer
+func (s *T) GetReassignments(ctx context.Context, req *pb.GetReassignmentsRq) (*pb.GetReassignmentsRs, error) {
+	pxy, err := s.proxySet.Get(req.Cluster)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	progress, err := pxy.GetReassignments()
+	if err != nil {
+		return nil, status.Errorf(adminErrorCode(err), err.Error())
+	}
+	return &pb.GetReassignmentsRs{Partitions: partitionProgressToPB(progress)}, nil
+}
+
+// BrowseMessages implements pb.KafkaPixyServer
+func (s *T) BrowseMessages(ctx context.Context, req *pb.BrowseMessagesRq) (*pb.BrowseMessagesRs, error) {
+	clientID := s.clientID(ctx)
+	pxy, mapping, err := s.proxySet.MapTopic(req.Cluster, verifiedClientID(ctx), req.Topic)
+	if err != nil {
+		return nil, mapTopicError(err)
+	}
+	if req.Count < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid count: %d", req.Count)
+	}
+	page, err := pxy.BrowseMessages(clientID, mapping.Topic, req.Partition, req.From, int(req.Count))
+	if err != nil {
+		return nil, browseError(err)
+	}
+	res := pb.BrowseMessagesRs{
+		Messages:   make([]*pb.BrowsedMessage, len(page.Messages)),
+		NextOffset: page.NextOffset,
+		Begin:      page.Begin,
+		End:        page.End,
+	}
+	for i, msg := range page.Messages {
+		pbMsg := pb.BrowsedMessage{
+			Offset:        msg.Offset,
+			Message:       msg.Value,
+			TimestampType: msg.TimestampType.String(),
+			Preview:       msg.Preview,
+		}
+		if msg.TimestampType != consumer.TimestampNone {
+			pbMsg.Timestamp = toMillis(msg.Timestamp)
+		}
+		for _, h := range msg.Headers {
+			pbMsg.Headers = append(pbMsg.Headers, &pb.RecordHeader{
+				Key:   string(h.Key),
+				Value: h.Value,
+			})
+		}
+		if msg.Key == nil {
+			pbMsg.KeyUndefined = true
+		} else {
+			pbMsg.KeyValue = msg.Key
+		}
+		res.Messages[i] = &pbMsg
+	}
+	return &res, nil
+}
+
+func partitionProgressToPB(progress []admin.PartitionProgress) []*pb.PartitionProgress {
+	pbProgress := make([]*pb.PartitionProgress, len(progress))
+	for i, pp := range progress {
+		pbProgress[i] = &pb.PartitionProgress{
+			Topic:     pp.Topic,
+			Partition: pp.Partition,
+			Target:    pp.Target,
+			Leader:    pp.Leader,
+			Replicas:  pp.Replicas,
+			Isr:       pp.ISR,
+			Done:      pp.Done,
+		}
+	}
+	return pbProgress
+}
+
+// adminErrorCode returns a gRPC status code that a topic management request
+// should fail with.
+func adminErrorCode(err error) codes.Code {
+	switch errors.Cause(err) {
+	case proxy.ErrForbidden, sarama.ErrTopicAuthorizationFailed, sarama.ErrClusterAuthorizationFailed:
+		return codes.PermissionDenied
+	case sarama.ErrTopicAlreadyExists:
+		return codes.AlreadyExists
+	case sarama.ErrUnknownTopicOrPartition:
+		return codes.NotFound
+	case sarama.ErrInvalidTopic, sarama.ErrInvalidPartitions, sarama.ErrInvalidReplicationFactor,
+		sarama.ErrInvalidReplicaAssignment, sarama.ErrInvalidConfig, sarama.ErrPolicyViolation,
+		sarama.ErrInvalidRequest:
+		return codes.InvalidArgument
+	case sarama.ErrTopicDeletionDisabled, sarama.ErrReassignmentInProgress, admin.ErrElectionInProgress:
+		return codes.FailedPrecondition
+	case proxy.ErrUnavailable:
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
+
+// browseErrorCode returns a gRPC status code that corresponds to a browse
+// error.
+func browseErrorCode(err error) codes.Code {
+	if _, ok := errors.Cause(err).(*ratelimit.ThrottledError); ok {
+		return codes.ResourceExhausted
+	}
+	switch errors.Cause(err) {
+	case sarama.ErrUnknownTopicOrPartition:
+		return codes.NotFound
+	case sarama.ErrOffsetOutOfRange:
+		return codes.OutOfRange
+	case proxy.ErrUnavailable:
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
+
+// produceErrorCode returns a gRPC status code that corresponds to a produce
+// error.
+func produceErrorCode(err error) codes.Code {
+	switch errors.Cause(err).(type) {
+	case *schema.ValidationError:
+		return codes.InvalidArgument
+	case *ratelimit.ThrottledError:
+		return codes.ResourceExhausted
+	}
+	switch errors.Cause(err) {
+	case sarama.ErrUnknownTopicOrPartition, sarama.ErrInvalidPartition:
+		return codes.InvalidArgument
+	case proxy.ErrDisabled, proxy.ErrUnavailable, producer.ErrTxnDisabled:
+		return codes.Unavailable
+	case proxy.ErrHeadersUnsupported, proxy.ErrCrossClusterAlias:
+		return codes.InvalidArgument
+	case proxy.ErrUnknownTenant:
+		return codes.PermissionDenied
+	case schema.ErrInvalidMessage:
+		return codes.InvalidArgument
+	case schema.ErrRegistry:
+		return codes.Unavailable
+	case spool.ErrFull, producer.ErrBufferFull:
+		return codes.ResourceExhausted
+	default:
+		return codes.Internal
+	}
+}
+
+// clientID returns an identity of the client that made a request for the
+// purpose of rate limiting. It is the identity from the verified client TLS
+// certificate if there is one, otherwise it is taken from the request
+// metadata. The metadata is advisory, since any client can set it to
+// anything. An empty string is returned if the client is anonymous.
+func (s *T) clientID(ctx context.Context) string {
+	if clientID := verifiedClientID(ctx); clientID != "" {
+		return clientID
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(s.clientIDHeader); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+// mapTopicError converts a topic mapping error to a gRPC status.
+func mapTopicError(err error) error {
+	if errors.Cause(err) == proxy.ErrUnknownTenant {
+		return status.Errorf(codes.PermissionDenied, err.Error())
+	}
+	return status.Errorf(codes.InvalidArgument, err.Error())
+}
+
+// verifiedClientID returns an identity of the client that made a request
+// from the client TLS certificate verified by the server. An empty string is
+// returned if the client did not authenticate with a certificate.
+func verifiedClientID(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			return server.VerifiedClientID(&tlsInfo.State)
+		}
+	}
+	return ""
+}
+
+// toProducerMsgs converts messages of a batch request. Results of a batch are
+// matched with messages by index, therefore a missing message fails the
+// entire request rather than being skipped.
+func toProducerMsgs(msgs []*pb.ProdMsg) ([]*sarama.ProducerMessage, error) {
+	prodMsgs := make([]*sarama.ProducerMessage, len(msgs))
+	for i, msg := range msgs {
+		if msg == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "message missing: index=%d", i)
+		}
+		if msg.Timestamp < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid timestamp: %d, index=%d", msg.Timestamp, i)
+		}
+		prodMsgs[i] = toProducerMsg(msg)
+	}
+	return prodMsgs, nil
+}
+
+func toProducerMsg(msg *pb.ProdMsg) *sarama.ProducerMessage {
+	prodMsg := &sarama.ProducerMessage{
+		Topic:     msg.Topic,
+		Value:     sarama.StringEncoder(msg.Message),
+		Headers:   toSaramaHeaders(msg.Headers),
+		Timestamp: fromMillis(msg.Timestamp),
+	}
+	if !msg.KeyUndefined {
+		prodMsg.Key = sarama.ByteEncoder(msg.KeyValue)
+	}
+	return prodMsg
+}
+
+func toSaramaHeaders(pbHeaders []*pb.RecordHeader) []sarama.RecordHeader {
+	if len(pbHeaders) == 0 {
+		return nil
+	}
+	headers := make([]sarama.RecordHeader, 0, len(pbHeaders))
+	for _, h := range pbHeaders {
+		if h == nil {
+			continue
+		}
+		headers = append(headers, sarama.RecordHeader{
+			Key:   []byte(h.Key),
+			Value: h.Value,
+		})
+	}
+	return headers
+}
+
 func keyEncoderFor(prodReq *pb.ProdRq) sarama.Encoder {
 	if prodReq.KeyUndefined {
 		return nil
 	}
 	return sarama.ByteEncoder(prodReq.KeyValue)
 }
+
+// fromMillis converts milliseconds since the Unix epoch to time. Zero is
+// converted to zero time meaning that the timestamp is not set.
+func fromMillis(ms int64) time.Time {
+	if ms == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, ms*int64(time.Millisecond))
+}
+
+func toMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
@@ -2,10 +2,14 @@ package httpsrv
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/base64"
 	"encoding/json"
+	"expvar"
 	"fmt"
 	"io/ioutil"
+	"math"
+	"mime"
 	"net"
 	"net/http"
 	"os"
@@ -13,6 +17,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/Shopify/sarama"
 	"github.com/gorilla/mux"
@@ -22,7 +27,12 @@ import (
 	"github.com/mailgun/kafka-pixy/consumer/offsettrk"
 	"github.com/mailgun/kafka-pixy/offsetmgr"
 	"github.com/mailgun/kafka-pixy/prettyfmt"
+	"github.com/mailgun/kafka-pixy/producer"
+	"github.com/mailgun/kafka-pixy/producer/spool"
 	"github.com/mailgun/kafka-pixy/proxy"
+	"github.com/mailgun/kafka-pixy/ratelimit"
+	"github.com/mailgun/kafka-pixy/schema"
+	"github.com/mailgun/kafka-pixy/server"
 	"github.com/pkg/errors"
 )
 
@@ -34,6 +44,9 @@ const (
 	hdrContentLength = "Content-Length"
 	hdrContentType   = "Content-Type"
 	hdrKafkaPrefix   = "X-Kafka-"
+	hdrRetryAfter    = "Retry-After"
+
+	contentTypeNDJSON = "application/x-ndjson"
 
 	// HTTP request parameters.
 	prmCluster              = "cluster"
@@ -44,10 +57,15 @@ const (
 	prmNoAck                = "noAck"
 	prmAckPartition         = "ackPartition"
 	prmPartition            = "partition"
+	prmTimestamp            = "timestamp"
 	prmAckOffset            = "ackOffset"
 	prmOffset               = "offset"
 	prmTopicsWithPartitions = "withPartitions"
 	prmTopicsWithConfig     = "withConfig"
+	prmValidateOnly         = "validateOnly"
+	prmWithDetails          = "withDetails"
+	prmFrom                 = "from"
+	prmCount                = "count"
 )
 
 var (
@@ -64,9 +82,8 @@ type T struct {
 	wg         sync.WaitGroup
 	errorCh    chan error
 
-	// needed to pass in security info
-	certPath string
-	keyPath  string
+	// HTTP header that identifies a client for rate limiting.
+	clientIDHeader string
 }
 
 func init() {
@@ -80,9 +97,12 @@ func init() {
 // specified `network`/`address` and execute them with the specified `producer`,
 // `consumer`, or `admin`, depending on the request type.
 //
-// It also passes in the provided certificate and key paths for TLS. If
-// empty strings, it is run in non-TLS mode.
-func New(addr string, proxySet *proxy.Set, certPath, keyPath string) (*T, error) {
+// If `tlsCfg` is not nil, then the server is run in TLS mode with it.
+//
+// The `clientIDHeader` is the name of an HTTP header that identifies a client
+// for the purpose of rate limiting, unless the client is identified by a
+// verified TLS certificate.
+func New(addr string, proxySet *proxy.Set, clientIDHeader string, tlsCfg *tls.Config) (*T, error) {
 	network := networkUnix
 	if strings.Contains(addr, ":") {
 		network = networkTCP
@@ -100,7 +120,7 @@ func New(addr string, proxySet *proxy.Set, certPath, keyPath string) (*T, error)
 	}
 	// Create a graceful HTTP server instance.
 	router := mux.NewRouter()
-	httpServer := &http.Server{Handler: router}
+	httpServer := &http.Server{Handler: router, TLSConfig: tlsCfg}
 
 	hs := &T{
 		actDesc:    actor.Root().NewChild(addr),
@@ -109,16 +129,22 @@ func New(addr string, proxySet *proxy.Set, certPath, keyPath string) (*T, error)
 		httpServer: httpServer,
 		proxySet:   proxySet,
 		errorCh:    make(chan error, 1),
-		certPath:   certPath,
-		keyPath:    keyPath,
+
+		clientIDHeader: clientIDHeader,
 	}
 	// Configure the API request handlers.
 	router.HandleFunc(fmt.Sprintf("/clusters/{%s}/topics/{%s}/messages", prmCluster, prmTopic), hs.handleProduce).Methods("POST")
 	router.HandleFunc(fmt.Sprintf("/topics/{%s}/messages", prmTopic), hs.handleProduce).Methods("POST")
 
+	router.HandleFunc(fmt.Sprintf("/clusters/{%s}/messages", prmCluster), hs.handleProduceBatch).Methods("POST")
+	router.HandleFunc("/messages", hs.handleProduceBatch).Methods("POST")
+
 	router.HandleFunc(fmt.Sprintf("/clusters/{%s}/topics/{%s}/messages", prmCluster, prmTopic), hs.handleConsume).Methods("GET")
 	router.HandleFunc(fmt.Sprintf("/topics/{%s}/messages", prmTopic), hs.handleConsume).Methods("GET")
 
+	router.HandleFunc(fmt.Sprintf("/clusters/{%s}/topics/{%s}/partitions/{%s}/messages", prmCluster, prmTopic, prmPartition), hs.handleBrowseMessages).Methods("GET")
+	router.HandleFunc(fmt.Sprintf("/topics/{%s}/partitions/{%s}/messages", prmTopic, prmPartition), hs.handleBrowseMessages).Methods("GET")
+
 	router.HandleFunc(fmt.Sprintf("/clusters/{%s}/topics/{%s}/acks", prmCluster, prmTopic), hs.handleAck).Methods("POST")
 	router.HandleFunc(fmt.Sprintf("/topics/{%s}/acks", prmTopic), hs.handleAck).Methods("POST")
 
@@ -134,10 +160,40 @@ func New(addr string, proxySet *proxy.Set, certPath, keyPath string) (*T, error)
 	router.HandleFunc(fmt.Sprintf("/clusters/{%s}/topics", prmCluster), hs.handleListTopics).Methods("GET")
 	router.HandleFunc("/topics", hs.handleListTopics).Methods("GET")
 
+	router.HandleFunc(fmt.Sprintf("/clusters/{%s}/topics/{%s}/routing", prmCluster, prmTopic), hs.handleGetTopicRouting).Methods("GET")
+	router.HandleFunc(fmt.Sprintf("/topics/{%s}/routing", prmTopic), hs.handleGetTopicRouting).Methods("GET")
+
 	router.HandleFunc(fmt.Sprintf("/clusters/{%s}/topics/{%s}", prmCluster, prmTopic), hs.handleGetTopicMetadata).Methods("GET")
 	router.HandleFunc(fmt.Sprintf("/topics/{%s}", prmTopic), hs.handleGetTopicMetadata).Methods("GET")
 
+	router.HandleFunc(fmt.Sprintf("/clusters/{%s}/topics/{%s}", prmCluster, prmTopic), hs.handleCreateTopic).Methods("POST")
+	router.HandleFunc(fmt.Sprintf("/topics/{%s}", prmTopic), hs.handleCreateTopic).Methods("POST")
+
+	router.HandleFunc(fmt.Sprintf("/clusters/{%s}/topics/{%s}", prmCluster, prmTopic), hs.handleDeleteTopic).Methods("DELETE")
+	router.HandleFunc(fmt.Sprintf("/topics/{%s}", prmTopic), hs.handleDeleteTopic).Methods("DELETE")
+
+	router.HandleFunc(fmt.Sprintf("/clusters/{%s}/topics/{%s}/config", prmCluster, prmTopic), hs.handleAlterTopicConfig).Methods("POST")
+	router.HandleFunc(fmt.Sprintf("/topics/{%s}/config", prmTopic), hs.handleAlterTopicConfig).Methods("POST")
+
+	router.HandleFunc(fmt.Sprintf("/clusters/{%s}/topics/{%s}/partitions", prmCluster, prmTopic), hs.handleAddPartitions).Methods("POST")
+	router.HandleFunc(fmt.Sprintf("/topics/{%s}/partitions", prmTopic), hs.handleAddPartitions).Methods("POST")
+
+	router.HandleFunc(fmt.Sprintf("/clusters/{%s}/preferred-leaders", prmCluster), hs.handleElectPreferredLeaders).Methods("POST")
+	router.HandleFunc("/preferred-leaders", hs.handleElectPreferredLeaders).Methods("POST")
+
+	router.HandleFunc(fmt.Sprintf("/clusters/{%s}/reassignments", prmCluster), hs.handleReassignPartitions).Methods("POST")
+	router.HandleFunc("/reassignments", hs.handleReassignPartitions).Methods("POST")
+
+	router.HandleFunc(fmt.Sprintf("/clusters/{%s}/reassignments", prmCluster), hs.handleGetReassignments).Methods("GET")
+	router.HandleFunc("/reassignments", hs.handleGetReassignments).Methods("GET")
+
+	router.HandleFunc(fmt.Sprintf("/clusters/{%s}", prmCluster), hs.handleDescribeCluster).Methods("GET")
+
+	router.HandleFunc(fmt.Sprintf("/clusters/{%s}/producer", prmCluster), hs.handleGetProducer).Methods("GET")
+	router.HandleFunc("/producer", hs.handleGetProducer).Methods("GET")
+
 	router.HandleFunc("/_ping", hs.handlePing).Methods("GET")
+	router.Handle("/debug/vars", expvar.Handler()).Methods("GET")
 	return hs, nil
 }
 
@@ -145,8 +201,8 @@ func New(addr string, proxySet *proxy.Set, certPath, keyPath string) (*T, error)
 // will be sent down to `ErrorCh()`.
 func (s *T) Start() {
 	actor.Spawn(s.actDesc, &s.wg, func() {
-		if s.certPath != "" && s.keyPath != "" {
-			if err := s.httpServer.ServeTLS(s.listener, s.certPath, s.keyPath); err != nil {
+		if s.httpServer.TLSConfig != nil {
+			if err := s.httpServer.ServeTLS(s.listener, "", ""); err != nil {
 				s.errorCh <- errors.Wrap(err, "HTTP API server failed")
 			}
 		} else {
@@ -178,18 +234,34 @@ func (s *T) getProxy(r *http.Request) (*proxy.T, error) {
 	return s.proxySet.Get(cluster)
 }
 
+// mapTopic resolves the topic name of a request to a real Kafka topic for the
+// tenant that made the request, see `proxy.Set.MapTopic`.
+func (s *T) mapTopic(r *http.Request) (*proxy.T, proxy.TopicMapping, error) {
+	vars := mux.Vars(r)
+	return s.proxySet.MapTopic(vars[prmCluster], verifiedClientID(r), vars[prmTopic])
+}
+
 // handleProduce is an HTTP request handler for `POST /topic/{topic}/messages`
 func (s *T) handleProduce(w http.ResponseWriter, r *http.Request) {
 	defer r.Body.Close()
 
-	pxy, err := s.getProxy(r)
+	pxy, mapping, err := s.mapTopic(r)
 	if err != nil {
-		s.respondWithJSON(w, http.StatusBadRequest, errorRs{err.Error()})
+		s.respondWithJSON(w, mapTopicErrorStatus(err), errorRs{err.Error()})
 		return
 	}
-	topic := mux.Vars(r)[prmTopic]
 	key := getParamBytes(r, prmKey)
 	_, isSync := r.Form[prmSync]
+	partition, err := parseProducePartition(r)
+	if err != nil {
+		s.respondWithJSON(w, http.StatusBadRequest, errorRs{err.Error()})
+		return
+	}
+	timestamp, err := parseProduceTimestamp(r)
+	if err != nil {
+		s.respondWithJSON(w, http.StatusBadRequest, errorRs{err.Error()})
+		return
+	}
 
 	// Get the message body from the HTTP request.
 	var msg sarama.Encoder
@@ -220,38 +292,146 @@ func (s *T) handleProduce(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	err = pxy.AllowProduce(s.clientID(r), &sarama.ProducerMessage{Topic: mapping.Topic, Key: toEncoderPreservingNil(key), Value: msg})
+	if err != nil {
+		s.respondWithProduceError(w, err)
+		return
+	}
+
 	// Asynchronously submit the message to the Kafka cluster.
 	if !isSync {
-		pxy.AsyncProduce(topic, toEncoderPreservingNil(key), msg, headers)
-		s.respondWithJSON(w, http.StatusOK, EmptyResponse)
+		acceptedBy, err := s.proxySet.AsyncProduce(mapping.Cluster, mapping.Topic, partition, toEncoderPreservingNil(key), msg, headers, timestamp, nil)
+		if err != nil {
+			s.respondWithProduceError(w, err)
+			return
+		}
+		s.respondWithJSON(w, http.StatusOK, asyncProduceRs{Cluster: acceptedBy})
 		return
 	}
 
-	prodMsg, err := pxy.Produce(topic, toEncoderPreservingNil(key), msg, headers)
+	prodMsg, acceptedBy, err := s.proxySet.Produce(mapping.Cluster, mapping.Topic, partition, toEncoderPreservingNil(key), msg, headers, timestamp)
 	if err != nil {
-		var status int
-		switch err {
-		case sarama.ErrUnknownTopicOrPartition:
-			status = http.StatusNotFound
-		case proxy.ErrDisabled:
-			fallthrough
-		case proxy.ErrUnavailable:
-			status = http.StatusServiceUnavailable
-		case proxy.ErrHeadersUnsupported:
-			status = http.StatusBadRequest
-		default:
-			status = http.StatusInternalServerError
-		}
-		s.respondWithJSON(w, status, errorRs{err.Error()})
+		s.respondWithProduceError(w, err)
 		return
 	}
 
 	s.respondWithJSON(w, http.StatusOK, produceRs{
 		Partition: prodMsg.Partition,
 		Offset:    prodMsg.Offset,
+		Cluster:   acceptedBy,
 	})
 }
 
+// handleProduceBatch is an HTTP request handler for `POST /messages`. The
+// request body is expected to be a newline delimited JSON stream of
+// `produceBatchMsg` objects.
+func (s *T) handleProduceBatch(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	pxy, err := s.getProxy(r)
+	if err != nil {
+		s.respondWithJSON(w, http.StatusBadRequest, errorRs{err.Error()})
+		return
+	}
+	contentType := r.Header.Get(hdrContentType)
+	if mediaType, _, err := mime.ParseMediaType(contentType); err != nil || mediaType != contentTypeNDJSON {
+		errorText := fmt.Sprintf("unsupported content type %s", contentType)
+		s.respondWithJSON(w, http.StatusBadRequest, errorRs{errorText})
+		return
+	}
+
+	var prodMsgs []*sarama.ProducerMessage
+	decoder := json.NewDecoder(r.Body)
+	for i := 0; decoder.More(); i++ {
+		if err := pxy.CheckBatchSize(i + 1); err != nil {
+			s.respondWithJSON(w, http.StatusBadRequest, errorRs{err.Error()})
+			return
+		}
+		var msg produceBatchMsg
+		if err := decoder.Decode(&msg); err != nil {
+			errorText := fmt.Sprintf("invalid message #%d: %s", i, err)
+			s.respondWithJSON(w, http.StatusBadRequest, errorRs{errorText})
+			return
+		}
+		if msg.Topic == "" {
+			errorText := fmt.Sprintf("invalid message #%d: missing topic", i)
+			s.respondWithJSON(w, http.StatusBadRequest, errorRs{errorText})
+			return
+		}
+		if msg.Timestamp < 0 {
+			errorText := fmt.Sprintf("invalid message #%d: invalid timestamp: %d", i, msg.Timestamp)
+			s.respondWithJSON(w, http.StatusBadRequest, errorRs{errorText})
+			return
+		}
+		prodMsg := &sarama.ProducerMessage{
+			Topic:     msg.Topic,
+			Key:       toEncoderPreservingNil(msg.Key),
+			Value:     sarama.ByteEncoder(msg.Value),
+			Timestamp: fromMillis(msg.Timestamp),
+		}
+		for _, h := range msg.Headers {
+			prodMsg.Headers = append(prodMsg.Headers, sarama.RecordHeader{
+				Key:   []byte(h.Key),
+				Value: h.Value,
+			})
+		}
+		prodMsgs = append(prodMsgs, prodMsg)
+	}
+
+	// Messages that exceed rate limits are rejected individually.
+	cluster := mux.Vars(r)[prmCluster]
+	clientID := s.clientID(r)
+	tenant := verifiedClientID(r)
+	allowed := make([]*sarama.ProducerMessage, 0, len(prodMsgs))
+	responses := make([]proxy.Response, len(prodMsgs))
+	for i, prodMsg := range prodMsgs {
+		topic, err := s.proxySet.MapLocalTopic(cluster, tenant, prodMsg.Topic)
+		if err != nil {
+			responses[i].Response = producer.Response{Msg: prodMsg, Err: err}
+			continue
+		}
+		prodMsg.Topic = topic
+		if err := pxy.AllowProduce(clientID, prodMsg); err != nil {
+			responses[i].Response = producer.Response{Msg: prodMsg, Err: err}
+			continue
+		}
+		allowed = append(allowed, prodMsg)
+	}
+	allowedResponses, err := s.proxySet.ProduceBatch(cluster, allowed)
+	if err != nil {
+		s.respondWithJSON(w, http.StatusBadRequest, errorRs{err.Error()})
+		return
+	}
+	for i := range responses {
+		if responses[i].Err == nil {
+			responses[i], allowedResponses = allowedResponses[0], allowedResponses[1:]
+		}
+	}
+
+	rs := produceBatchRs{Results: make([]produceBatchResult, len(responses))}
+	for i, prodRs := range responses {
+		if prodRs.Err != nil {
+			errorRs := newProduceErrorRs(prodRs.Err)
+			rs.Results[i] = produceBatchResult{
+				Partition:    -1,
+				Offset:       -1,
+				Status:       produceErrorStatus(prodRs.Err),
+				Error:        errorRs.Error,
+				Violations:   errorRs.Violations,
+				RetryAfterMs: errorRs.RetryAfterMs,
+			}
+			continue
+		}
+		rs.Results[i] = produceBatchResult{
+			Partition: prodRs.Msg.Partition,
+			Offset:    prodRs.Msg.Offset,
+			Cluster:   prodRs.Cluster,
+			Status:    http.StatusOK,
+		}
+	}
+	s.respondWithJSON(w, http.StatusOK, rs)
+}
+
 // readMsg reads message from the HTTP request based on the Content-Type header.
 func (s *T) readMsg(r *http.Request) (sarama.Encoder, error) {
 	contentType := r.Header.Get(hdrContentType)
@@ -288,12 +468,12 @@ func (s *T) readMsg(r *http.Request) (sarama.Encoder, error) {
 func (s *T) handleConsume(w http.ResponseWriter, r *http.Request) {
 	defer r.Body.Close()
 
-	pxy, err := s.getProxy(r)
+	pxy, mapping, err := s.mapTopic(r)
 	if err != nil {
-		s.respondWithJSON(w, http.StatusBadRequest, errorRs{err.Error()})
+		s.respondWithJSON(w, mapTopicErrorStatus(err), errorRs{err.Error()})
 		return
 	}
-	topic := mux.Vars(r)[prmTopic]
+	topic := mapping.Topic
 	group, err := getGroupParam(r, false)
 	if err != nil {
 		s.respondWithJSON(w, http.StatusBadRequest, errorRs{err.Error()})
@@ -334,25 +514,105 @@ func (s *T) handleConsume(w http.ResponseWriter, r *http.Request) {
 		})
 	}
 
-	s.respondWithJSON(w, http.StatusOK, consumeRs{
-		Key:       consMsg.Key,
-		Value:     consMsg.Value,
-		Partition: consMsg.Partition,
-		Offset:    consMsg.Offset,
-		Headers:   headers,
-	})
+	rs := consumeRs{
+		Key:           consMsg.Key,
+		Value:         consMsg.Value,
+		Partition:     consMsg.Partition,
+		Offset:        consMsg.Offset,
+		Headers:       headers,
+		TimestampType: consMsg.TimestampType.String(),
+	}
+	if consMsg.TimestampType != consumer.TimestampNone {
+		rs.Timestamp = toMillis(consMsg.Timestamp)
+	}
+	decoded, ok, err := pxy.DecodeMessage(topic, consMsg.Value)
+	if err != nil {
+		s.actDesc.Log().WithError(err).Errorf("Failed to decode message: topic=%s, partition=%d, offset=%d",
+			topic, consMsg.Partition, consMsg.Offset)
+	} else if ok {
+		rs.Decoded = decoded
+	}
+	s.respondWithJSON(w, http.StatusOK, rs)
+}
+
+// handleBrowseMessages is an HTTP request handler for
+// `GET /topics/{topic}/partitions/{partition}/messages`. It returns a page of
+// messages of a partition without joining a consumer group, hence without
+// changing any consumer group offsets.
+func (s *T) handleBrowseMessages(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	pxy, mapping, err := s.mapTopic(r)
+	if err != nil {
+		s.respondWithJSON(w, mapTopicErrorStatus(err), errorRs{err.Error()})
+		return
+	}
+	partitionStr := mux.Vars(r)[prmPartition]
+	partition, err := strconv.ParseInt(partitionStr, 10, 32)
+	if err != nil || partition < 0 {
+		s.respondWithJSON(w, http.StatusBadRequest, errorRs{fmt.Sprintf("bad %s: %s", prmPartition, partitionStr)})
+		return
+	}
+	from := int64(-1)
+	if fromStr := r.FormValue(prmFrom); fromStr != "" {
+		if from, err = strconv.ParseInt(fromStr, 10, 64); err != nil || from < 0 {
+			s.respondWithJSON(w, http.StatusBadRequest, errorRs{fmt.Sprintf("bad %s: %s", prmFrom, fromStr)})
+			return
+		}
+	}
+	count := 0
+	if countStr := r.FormValue(prmCount); countStr != "" {
+		if count, err = strconv.Atoi(countStr); err != nil || count <= 0 {
+			s.respondWithJSON(w, http.StatusBadRequest, errorRs{fmt.Sprintf("bad %s: %s", prmCount, countStr)})
+			return
+		}
+	}
+
+	page, err := pxy.BrowseMessages(s.clientID(r), mapping.Topic, int32(partition), from, count)
+	if err != nil {
+		setRetryAfter(w, err)
+		s.respondWithJSON(w, browseErrorStatus(err), errorRs{err.Error()})
+		return
+	}
+	rs := browseRs{
+		Messages:   make([]browsedMessage, len(page.Messages)),
+		NextOffset: page.NextOffset,
+		Begin:      page.Begin,
+		End:        page.End,
+	}
+	for i, msg := range page.Messages {
+		headers := make([]consumeHeader, 0, len(msg.Headers))
+		for _, h := range msg.Headers {
+			headers = append(headers, consumeHeader{
+				Key:   string(h.Key),
+				Value: h.Value,
+			})
+		}
+		rs.Messages[i] = browsedMessage{
+			Key:           msg.Key,
+			Value:         msg.Value,
+			Offset:        msg.Offset,
+			Headers:       headers,
+			TimestampType: msg.TimestampType.String(),
+			Preview:       msg.Preview,
+		}
+		if msg.TimestampType != consumer.TimestampNone {
+			rs.Messages[i].Timestamp = toMillis(msg.Timestamp)
+		}
+	}
+	s.respondWithJSON(w, http.StatusOK, rs)
 }
 
 // handleConsume is an HTTP request handler for `GET /topic/{topic}/messages`
 func (s *T) handleAck(w http.ResponseWriter, r *http.Request) {
 	defer r.Body.Close()
 
-	pxy, err := s.getProxy(r)
+	pxy, mapping, err := s.mapTopic(r)
 	if err != nil {
-		s.respondWithJSON(w, http.StatusBadRequest, errorRs{err.Error()})
+		s.respondWithJSON(w, mapTopicErrorStatus(err), errorRs{err.Error()})
 		return
 	}
-	topic := mux.Vars(r)[prmTopic]
+	topic := mapping.Topic
 	group, err := getGroupParam(r, false)
 	if err != nil {
 		s.respondWithJSON(w, http.StatusBadRequest, errorRs{err.Error()})
@@ -487,6 +747,15 @@ func (s *T) handleGetTopicConsumers(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	_, withDetails := r.URL.Query()[prmWithDetails]
+
+	// Failure to list groups coordinated by Kafka should not hide Kafka-Pixy
+	// groups, hence it is only logged.
+	nativeGroups, err := pxy.GetNativeTopicConsumers(group, topic)
+	if err != nil {
+		s.actDesc.Log().WithError(err).Warnf("Failed to list native consumers: topic=%s", topic)
+	}
+
 	var consumers map[string]map[string][]int32
 	if group == "" {
 		consumers, err = pxy.GetAllTopicConsumers(topic)
@@ -496,7 +765,8 @@ func (s *T) handleGetTopicConsumers(w http.ResponseWriter, r *http.Request) {
 		}
 	} else {
 		groupConsumers, err := pxy.GetTopicConsumers(group, topic)
-		if err != nil {
+		// A group coordinated by Kafka is not registered in ZooKeeper.
+		if err != nil && len(nativeGroups) == 0 {
 			if _, ok := err.(admin.ErrInvalidParam); ok {
 				s.respondWithJSON(w, http.StatusBadRequest, errorRs{err.Error()})
 				return
@@ -510,7 +780,26 @@ func (s *T) handleGetTopicConsumers(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	encodedRes, err := json.MarshalIndent(consumers, "", "  ")
+	var rs interface{}
+	if withDetails {
+		rs = newConsumerGroupsRs(consumers, nativeGroups)
+	} else {
+		// Should a Kafka-Pixy group have the same name as a group
+		// coordinated by Kafka, then their consumers are listed together.
+		for group, nativeGroup := range nativeGroups {
+			groupConsumers := consumers[group]
+			if groupConsumers == nil {
+				groupConsumers = make(map[string][]int32, len(nativeGroup.Members))
+				consumers[group] = groupConsumers
+			}
+			for memberID, member := range nativeGroup.Members {
+				groupConsumers[memberID] = member.Partitions
+			}
+		}
+		rs = consumers
+	}
+
+	encodedRes, err := json.MarshalIndent(rs, "", "  ")
 	if err != nil {
 		s.actDesc.Log().WithError(err).Errorf("Failed to send HTTP response: status=%d, body=%v", http.StatusOK, encodedRes)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -599,15 +888,483 @@ func (s *T) handleGetTopicMetadata(w http.ResponseWriter, r *http.Request) {
 	s.respondWithJSON(w, http.StatusOK, tm_view)
 }
 
+// handleGetProducer is an HTTP request handler for `GET /producer`. It
+// responds with 503 if the producer buffer is full, so that load balancers
+// can route produce requests elsewhere.
+func (s *T) handleGetProducer(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	pxy, err := s.getProxy(r)
+	if err != nil {
+		s.respondWithJSON(w, http.StatusBadRequest, errorRs{err.Error()})
+		return
+	}
+	buffered, capacity := pxy.ProducerBufferUsage()
+	rs := producerRs{Buffered: buffered, Capacity: capacity}
+	if capacity > 0 {
+		rs.BufferFill = float64(buffered) / float64(capacity)
+	}
+	status := http.StatusOK
+	if buffered >= capacity {
+		status = http.StatusServiceUnavailable
+	}
+	s.respondWithJSON(w, status, rs)
+}
+
+// handleDescribeCluster is an HTTP request handler for
+// `GET /clusters/{cluster}`. It reports brokers of the cluster along with
+// their connectivity, and summary of the partition replication state.
+func (s *T) handleDescribeCluster(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	pxy, err := s.getProxy(r)
+	if err != nil {
+		s.respondWithJSON(w, http.StatusBadRequest, errorRs{err.Error()})
+		return
+	}
+
+	cm, err := pxy.DescribeCluster()
+	if err != nil {
+		if errors.Cause(err) == proxy.ErrUnavailable {
+			s.respondWithJSON(w, http.StatusServiceUnavailable, errorRs{err.Error()})
+			return
+		}
+		s.respondWithJSON(w, http.StatusInternalServerError, errorRs{err.Error()})
+		return
+	}
+	rs := clusterRs{
+		Cluster:                   mux.Vars(r)[prmCluster],
+		ControllerID:              cm.ControllerID,
+		Brokers:                   make([]brokerMetadata, len(cm.Brokers)),
+		UnderReplicatedPartitions: cm.UnderReplicatedPartitions,
+		OfflinePartitions:         cm.OfflinePartitions,
+	}
+	for i, bm := range cm.Brokers {
+		rs.Brokers[i] = brokerMetadata{
+			ID:        bm.ID,
+			Addr:      bm.Addr,
+			Rack:      bm.Rack,
+			Connected: bm.Connected,
+		}
+		if bm.ConnErr != nil {
+			rs.Brokers[i].Error = bm.ConnErr.Error()
+		}
+	}
+	s.respondWithJSON(w, http.StatusOK, rs)
+}
+
+// handleCreateTopic is an HTTP request handler for `POST /topics/{topic}`. The
+// request body is expected to be a JSON encoded `createTopicRq`.
+func (s *T) handleCreateTopic(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	pxy, err := s.getProxy(r)
+	if err != nil {
+		s.respondWithJSON(w, http.StatusBadRequest, errorRs{err.Error()})
+		return
+	}
+	topic := mux.Vars(r)[prmTopic]
+	_, validateOnly := r.URL.Query()[prmValidateOnly]
+
+	var rq createTopicRq
+	if err := json.NewDecoder(r.Body).Decode(&rq); err != nil {
+		errorText := fmt.Sprintf("Failed to parse the request: err=(%s)", err)
+		s.respondWithJSON(w, http.StatusBadRequest, errorRs{errorText})
+		return
+	}
+	if rq.Partitions <= 0 {
+		errorText := fmt.Sprintf("invalid partitions: %d", rq.Partitions)
+		s.respondWithJSON(w, http.StatusBadRequest, errorRs{errorText})
+		return
+	}
+	if rq.ReplicationFactor <= 0 {
+		errorText := fmt.Sprintf("invalid replication_factor: %d", rq.ReplicationFactor)
+		s.respondWithJSON(w, http.StatusBadRequest, errorRs{errorText})
+		return
+	}
+
+	err = pxy.CreateTopic(verifiedClientID(r), topic, rq.Partitions, rq.ReplicationFactor, rq.Config, validateOnly)
+	if err != nil {
+		s.respondWithJSON(w, adminErrorStatus(err), errorRs{err.Error()})
+		return
+	}
+	s.respondWithJSON(w, http.StatusOK, EmptyResponse)
+}
+
+// handleDeleteTopic is an HTTP request handler for `DELETE /topics/{topic}`.
+func (s *T) handleDeleteTopic(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	pxy, err := s.getProxy(r)
+	if err != nil {
+		s.respondWithJSON(w, http.StatusBadRequest, errorRs{err.Error()})
+		return
+	}
+	topic := mux.Vars(r)[prmTopic]
+
+	if err := pxy.DeleteTopic(verifiedClientID(r), topic); err != nil {
+		s.respondWithJSON(w, adminErrorStatus(err), errorRs{err.Error()})
+		return
+	}
+	s.respondWithJSON(w, http.StatusOK, EmptyResponse)
+}
+
+// handleAlterTopicConfig is an HTTP request handler for
+// `POST /topics/{topic}/config`. The request body is expected to be a JSON
+// encoded `alterTopicConfigRq`.
+func (s *T) handleAlterTopicConfig(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	pxy, err := s.getProxy(r)
+	if err != nil {
+		s.respondWithJSON(w, http.StatusBadRequest, errorRs{err.Error()})
+		return
+	}
+	topic := mux.Vars(r)[prmTopic]
+	_, validateOnly := r.URL.Query()[prmValidateOnly]
+
+	var rq alterTopicConfigRq
+	if err := json.NewDecoder(r.Body).Decode(&rq); err != nil {
+		errorText := fmt.Sprintf("Failed to parse the request: err=(%s)", err)
+		s.respondWithJSON(w, http.StatusBadRequest, errorRs{errorText})
+		return
+	}
+	config := make(map[string]*string, len(rq.Set)+len(rq.Delete))
+	for name, value := range rq.Set {
+		value := value
+		config[name] = &value
+	}
+	for _, name := range rq.Delete {
+		if _, ok := config[name]; ok {
+			errorText := fmt.Sprintf("config %s is both set and deleted", name)
+			s.respondWithJSON(w, http.StatusBadRequest, errorRs{errorText})
+			return
+		}
+		config[name] = nil
+	}
+
+	if err := pxy.AlterTopicConfig(verifiedClientID(r), topic, config, validateOnly); err != nil {
+		s.respondWithJSON(w, adminErrorStatus(err), errorRs{err.Error()})
+		return
+	}
+	s.respondWithJSON(w, http.StatusOK, EmptyResponse)
+}
+
+// handleAddPartitions is an HTTP request handler for
+// `POST /topics/{topic}/partitions`. The request body is expected to be a JSON
+// encoded `addPartitionsRq`.
+func (s *T) handleAddPartitions(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	pxy, err := s.getProxy(r)
+	if err != nil {
+		s.respondWithJSON(w, http.StatusBadRequest, errorRs{err.Error()})
+		return
+	}
+	topic := mux.Vars(r)[prmTopic]
+	_, validateOnly := r.URL.Query()[prmValidateOnly]
+
+	var rq addPartitionsRq
+	if err := json.NewDecoder(r.Body).Decode(&rq); err != nil {
+		errorText := fmt.Sprintf("Failed to parse the request: err=(%s)", err)
+		s.respondWithJSON(w, http.StatusBadRequest, errorRs{errorText})
+		return
+	}
+	if rq.Count <= 0 {
+		errorText := fmt.Sprintf("invalid count: %d", rq.Count)
+		s.respondWithJSON(w, http.StatusBadRequest, errorRs{errorText})
+		return
+	}
+
+	if err := pxy.AddPartitions(verifiedClientID(r), topic, rq.Count, validateOnly); err != nil {
+		s.respondWithJSON(w, adminErrorStatus(err), errorRs{err.Error()})
+		return
+	}
+	s.respondWithJSON(w, http.StatusOK, EmptyResponse)
+}
+
+// handleElectPreferredLeaders is an HTTP request handler for
+// `POST /preferred-leaders`. The request body is expected to be a JSON encoded
+// `electPreferredLeadersRq`.
+func (s *T) handleElectPreferredLeaders(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	pxy, err := s.getProxy(r)
+	if err != nil {
+		s.respondWithJSON(w, http.StatusBadRequest, errorRs{err.Error()})
+		return
+	}
+
+	var rq electPreferredLeadersRq
+	if err := json.NewDecoder(r.Body).Decode(&rq); err != nil {
+		errorText := fmt.Sprintf("Failed to parse the request: err=(%s)", err)
+		s.respondWithJSON(w, http.StatusBadRequest, errorRs{errorText})
+		return
+	}
+	partitions := make([]admin.TopicPartition, len(rq.Partitions))
+	for i, tp := range rq.Partitions {
+		partitions[i] = admin.TopicPartition{Topic: tp.Topic, Partition: tp.Partition}
+	}
+
+	progress, err := pxy.ElectPreferredLeaders(verifiedClientID(r), partitions)
+	if err != nil {
+		s.respondWithJSON(w, adminErrorStatus(err), errorRs{err.Error()})
+		return
+	}
+	s.respondWithJSON(w, http.StatusOK, newPartitionProgressRs(progress))
+}
+
+// handleReassignPartitions is an HTTP request handler for
+// `POST /reassignments`. The request body is expected to be a JSON encoded
+// `reassignPartitionsRq`.
+func (s *T) handleReassignPartitions(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	pxy, err := s.getProxy(r)
+	if err != nil {
+		s.respondWithJSON(w, http.StatusBadRequest, errorRs{err.Error()})
+		return
+	}
+
+	var rq reassignPartitionsRq
+	if err := json.NewDecoder(r.Body).Decode(&rq); err != nil {
+		errorText := fmt.Sprintf("Failed to parse the request: err=(%s)", err)
+		s.respondWithJSON(w, http.StatusBadRequest, errorRs{errorText})
+		return
+	}
+	reassignments := make([]admin.PartitionReassignment, len(rq.Reassignments))
+	for i, pr := range rq.Reassignments {
+		reassignments[i] = admin.PartitionReassignment{Topic: pr.Topic, Partition: pr.Partition, Replicas: pr.Replicas}
+	}
+
+	progress, err := pxy.ReassignPartitions(verifiedClientID(r), reassignments)
+	if err != nil {
+		s.respondWithJSON(w, adminErrorStatus(err), errorRs{err.Error()})
+		return
+	}
+	s.respondWithJSON(w, http.StatusOK, newPartitionProgressRs(progress))
+}
+
+// handleGetReassignments is an HTTP request handler for `GET /reassignments`.
+// It reports progress of partition reassignments that are in progress.
+func (s *T) handleGetReassignments(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	pxy, err := s.getProxy(r)
+	if err != nil {
+		s.respondWithJSON(w, http.StatusBadRequest, errorRs{err.Error()})
+		return
+	}
+
+	progress, err := pxy.GetReassignments()
+	if err != nil {
+		s.respondWithJSON(w, adminErrorStatus(err), errorRs{err.Error()})
+		return
+	}
+	s.respondWithJSON(w, http.StatusOK, newPartitionProgressRs(progress))
+}
+
+// handleGetTopicRouting is an HTTP request handler for
+// `GET /topics/{topic}/routing`. It reports how a topic name resolves to a real
+// Kafka topic for the tenant making the request. Resolution for other tenants
+// is not available, for it would disclose their prefixes.
+func (s *T) handleGetTopicRouting(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	tenant := verifiedClientID(r)
+	_, mapping, err := s.mapTopic(r)
+	if err != nil {
+		s.respondWithJSON(w, mapTopicErrorStatus(err), errorRs{err.Error()})
+		return
+	}
+	s.respondWithJSON(w, http.StatusOK, topicRoutingRs{
+		Name:    mapping.Name,
+		Tenant:  tenant,
+		Cluster: mapping.Cluster,
+		Topic:   mapping.Topic,
+		Aliased: mapping.Aliased,
+		Prefix:  mapping.Prefix,
+	})
+}
+
 func (s *T) handlePing(w http.ResponseWriter, r *http.Request) {
 	defer r.Body.Close()
 	w.WriteHeader(http.StatusOK)
 	w.Write([]byte("pong"))
 }
 
+type producerRs struct {
+	Buffered   int     `json:"buffered"`
+	Capacity   int     `json:"capacity"`
+	BufferFill float64 `json:"buffer_fill"`
+}
+
+type brokerMetadata struct {
+	ID        int32  `json:"id"`
+	Addr      string `json:"addr"`
+	Rack      string `json:"rack,omitempty"`
+	Connected bool   `json:"connected"`
+	Error     string `json:"error,omitempty"`
+}
+
+type clusterRs struct {
+	Cluster                   string           `json:"cluster"`
+	ControllerID              int32            `json:"controller_id"`
+	Brokers                   []brokerMetadata `json:"brokers"`
+	UnderReplicatedPartitions int              `json:"under_replicated_partitions"`
+	OfflinePartitions         int              `json:"offline_partitions"`
+}
+
+type consumerGroupRs struct {
+	Native    bool                  `json:"native"`
+	Mixed     bool                  `json:"mixed,omitempty"`
+	State     string                `json:"state,omitempty"`
+	Protocol  string                `json:"protocol,omitempty"`
+	Consumers map[string]consumerRs `json:"consumers"`
+}
+
+type consumerRs struct {
+	ClientID   string  `json:"client_id,omitempty"`
+	ClientHost string  `json:"client_host,omitempty"`
+	Partitions []int32 `json:"partitions"`
+}
+
+func newConsumerGroupsRs(consumers map[string]map[string][]int32, nativeGroups map[string]admin.NativeGroup) map[string]*consumerGroupRs {
+	rs := make(map[string]*consumerGroupRs, len(consumers)+len(nativeGroups))
+	for group, groupConsumers := range consumers {
+		groupRs := consumerGroupRs{Consumers: make(map[string]consumerRs, len(groupConsumers))}
+		for clientID, partitions := range groupConsumers {
+			groupRs.Consumers[clientID] = consumerRs{Partitions: partitions}
+		}
+		rs[group] = &groupRs
+	}
+	for group, nativeGroup := range nativeGroups {
+		// Should a Kafka-Pixy group have the same name as a group
+		// coordinated by Kafka, then their consumers are listed together,
+		// and the group is marked as mixed.
+		groupRs := rs[group]
+		if groupRs == nil {
+			groupRs = &consumerGroupRs{Native: true, Consumers: make(map[string]consumerRs, len(nativeGroup.Members))}
+			rs[group] = groupRs
+		} else {
+			groupRs.Mixed = true
+		}
+		groupRs.State = nativeGroup.State
+		groupRs.Protocol = nativeGroup.Protocol
+		for memberID, member := range nativeGroup.Members {
+			groupRs.Consumers[memberID] = consumerRs{
+				ClientID:   member.ClientID,
+				ClientHost: member.ClientHost,
+				Partitions: member.Partitions,
+			}
+		}
+	}
+	return rs
+}
+
+type createTopicRq struct {
+	Partitions        int32             `json:"partitions"`
+	ReplicationFactor int16             `json:"replication_factor"`
+	Config            map[string]string `json:"config"`
+}
+
+type alterTopicConfigRq struct {
+	Set    map[string]string `json:"set"`
+	Delete []string          `json:"delete"`
+}
+
+type addPartitionsRq struct {
+	Count int32 `json:"count"`
+}
+
+type topicPartition struct {
+	Topic     string `json:"topic"`
+	Partition int32  `json:"partition"`
+}
+
+type electPreferredLeadersRq struct {
+	Partitions []topicPartition `json:"partitions"`
+}
+
+type partitionReassignment struct {
+	Topic     string  `json:"topic"`
+	Partition int32   `json:"partition"`
+	Replicas  []int32 `json:"replicas"`
+}
+
+type reassignPartitionsRq struct {
+	Reassignments []partitionReassignment `json:"reassignments"`
+}
+
+type partitionProgress struct {
+	Topic     string  `json:"topic"`
+	Partition int32   `json:"partition"`
+	Target    []int32 `json:"target"`
+	Leader    int32   `json:"leader"`
+	Replicas  []int32 `json:"replicas"`
+	ISR       []int32 `json:"isr"`
+	Done      bool    `json:"done"`
+}
+
+type partitionProgressRs struct {
+	Partitions []partitionProgress `json:"partitions"`
+}
+
+func newPartitionProgressRs(progress []admin.PartitionProgress) partitionProgressRs {
+	rs := partitionProgressRs{Partitions: make([]partitionProgress, len(progress))}
+	for i, pp := range progress {
+		rs.Partitions[i] = partitionProgress{
+			Topic:     pp.Topic,
+			Partition: pp.Partition,
+			Target:    pp.Target,
+			Leader:    pp.Leader,
+			Replicas:  pp.Replicas,
+			ISR:       pp.ISR,
+			Done:      pp.Done,
+		}
+	}
+	return rs
+}
+
+type topicRoutingRs struct {
+	Name    string `json:"name"`
+	Tenant  string `json:"tenant"`
+	Cluster string `json:"cluster"`
+	Topic   string `json:"topic"`
+	Aliased bool   `json:"aliased"`
+	Prefix  string `json:"prefix"`
+}
+
 type produceRs struct {
-	Partition int32 `json:"partition"`
-	Offset    int64 `json:"offset"`
+	Partition int32  `json:"partition"`
+	Offset    int64  `json:"offset"`
+	Cluster   string `json:"cluster"`
+}
+
+type asyncProduceRs struct {
+	Cluster string `json:"cluster"`
+}
+
+type produceBatchMsg struct {
+	Topic     string          `json:"topic"`
+	Key       []byte          `json:"key"`
+	Value     []byte          `json:"value"`
+	Headers   []consumeHeader `json:"headers"`
+	Timestamp int64           `json:"timestamp"`
+}
+
+type produceBatchResult struct {
+	Partition    int32    `json:"partition"`
+	Offset       int64    `json:"offset"`
+	Cluster      string   `json:"cluster,omitempty"`
+	Status       int      `json:"status"`
+	Error        string   `json:"error,omitempty"`
+	Violations   []string `json:"violations,omitempty"`
+	RetryAfterMs int64    `json:"retry_after_ms,omitempty"`
+}
+
+type produceBatchRs struct {
+	Results []produceBatchResult `json:"results"`
 }
 
 type consumeHeader struct {
@@ -616,11 +1373,31 @@ type consumeHeader struct {
 }
 
 type consumeRs struct {
-	Key       []byte          `json:"key"`
-	Value     []byte          `json:"value"`
-	Partition int32           `json:"partition"`
-	Offset    int64           `json:"offset"`
-	Headers   []consumeHeader `json:"headers"`
+	Key           []byte          `json:"key"`
+	Value         []byte          `json:"value"`
+	Partition     int32           `json:"partition"`
+	Offset        int64           `json:"offset"`
+	Headers       []consumeHeader `json:"headers"`
+	Timestamp     int64           `json:"timestamp,omitempty"`
+	TimestampType string          `json:"timestamp_type,omitempty"`
+	Decoded       json.RawMessage `json:"decoded,omitempty"`
+}
+
+type browsedMessage struct {
+	Key           []byte          `json:"key"`
+	Value         []byte          `json:"value"`
+	Offset        int64           `json:"offset"`
+	Headers       []consumeHeader `json:"headers"`
+	Timestamp     int64           `json:"timestamp,omitempty"`
+	TimestampType string          `json:"timestamp_type,omitempty"`
+	Preview       string          `json:"preview"`
+}
+
+type browseRs struct {
+	Messages   []browsedMessage `json:"messages"`
+	NextOffset int64            `json:"next_offset"`
+	Begin      int64            `json:"begin"`
+	End        int64            `json:"end"`
 }
 
 type partitionInfo struct {
@@ -638,6 +1415,15 @@ type errorRs struct {
 	Error string `json:"error"`
 }
 
+// produceErrorRs is a produce error response. If a message was rejected by
+// JSON Schema validation, then it lists all schema violations. If a request
+// was throttled, then it tells how long to wait before retrying.
+type produceErrorRs struct {
+	Error        string   `json:"error"`
+	Violations   []string `json:"violations,omitempty"`
+	RetryAfterMs int64    `json:"retry_after_ms,omitempty"`
+}
+
 type topicConfig struct {
 	Version int32             `json:"version"`
 	Config  map[string]string `json:"config"`
@@ -696,6 +1482,126 @@ func getGroupParam(r *http.Request, opt bool) (string, error) {
 	return groups[0], nil
 }
 
+// produceErrorStatus returns an HTTP status code that corresponds to a
+// produce error.
+func produceErrorStatus(err error) int {
+	switch errors.Cause(err).(type) {
+	case *schema.ValidationError:
+		return http.StatusBadRequest
+	case *ratelimit.ThrottledError:
+		return http.StatusTooManyRequests
+	}
+	switch errors.Cause(err) {
+	case sarama.ErrUnknownTopicOrPartition:
+		return http.StatusNotFound
+	case proxy.ErrDisabled, proxy.ErrUnavailable, spool.ErrFull, schema.ErrRegistry, producer.ErrBufferFull:
+		return http.StatusServiceUnavailable
+	case proxy.ErrHeadersUnsupported, proxy.ErrCrossClusterAlias, sarama.ErrInvalidPartition, schema.ErrInvalidMessage:
+		return http.StatusBadRequest
+	case proxy.ErrUnknownTenant:
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// mapTopicErrorStatus returns an HTTP status code that corresponds to a topic
+// mapping error.
+func mapTopicErrorStatus(err error) int {
+	if errors.Cause(err) == proxy.ErrUnknownTenant {
+		return http.StatusForbidden
+	}
+	return http.StatusBadRequest
+}
+
+// browseErrorStatus returns an HTTP status code that corresponds to a browse
+// error.
+func browseErrorStatus(err error) int {
+	if _, ok := errors.Cause(err).(*ratelimit.ThrottledError); ok {
+		return http.StatusTooManyRequests
+	}
+	switch errors.Cause(err) {
+	case sarama.ErrUnknownTopicOrPartition:
+		return http.StatusNotFound
+	case sarama.ErrOffsetOutOfRange:
+		return http.StatusBadRequest
+	case proxy.ErrUnavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// adminErrorStatus returns an HTTP status that a topic management request
+// should fail with.
+func adminErrorStatus(err error) int {
+	switch errors.Cause(err) {
+	case proxy.ErrForbidden, sarama.ErrTopicAuthorizationFailed, sarama.ErrClusterAuthorizationFailed:
+		return http.StatusForbidden
+	case sarama.ErrTopicAlreadyExists:
+		return http.StatusConflict
+	case sarama.ErrUnknownTopicOrPartition:
+		return http.StatusNotFound
+	case sarama.ErrInvalidTopic, sarama.ErrInvalidPartitions, sarama.ErrInvalidReplicationFactor,
+		sarama.ErrInvalidReplicaAssignment, sarama.ErrInvalidConfig, sarama.ErrPolicyViolation,
+		sarama.ErrInvalidRequest:
+		return http.StatusBadRequest
+	case sarama.ErrTopicDeletionDisabled, sarama.ErrReassignmentInProgress, admin.ErrElectionInProgress:
+		return http.StatusConflict
+	case proxy.ErrUnavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func newProduceErrorRs(err error) produceErrorRs {
+	rs := produceErrorRs{Error: err.Error()}
+	switch err := errors.Cause(err).(type) {
+	case *schema.ValidationError:
+		rs.Violations = err.Violations
+	case *ratelimit.ThrottledError:
+		rs.RetryAfterMs = int64(math.Ceil(float64(err.RetryAfter) / float64(time.Millisecond)))
+	}
+	return rs
+}
+
+// respondWithProduceError sends a produce error response. If the request was
+// throttled, then the `Retry-After` header is set.
+func (s *T) respondWithProduceError(w http.ResponseWriter, err error) {
+	setRetryAfter(w, err)
+	s.respondWithJSON(w, produceErrorStatus(err), newProduceErrorRs(err))
+}
+
+// setRetryAfter sets the `Retry-After` header if a request was throttled.
+func setRetryAfter(w http.ResponseWriter, err error) {
+	if throttledErr, ok := errors.Cause(err).(*ratelimit.ThrottledError); ok {
+		retryAfterSec := int64(math.Ceil(throttledErr.RetryAfter.Seconds()))
+		w.Header().Set(hdrRetryAfter, strconv.FormatInt(retryAfterSec, 10))
+	}
+}
+
+// clientID returns an identity of the client that made a request for the
+// purpose of rate limiting. It is the identity from the verified client TLS
+// certificate if there is one, otherwise it is taken from the request header.
+// The header is advisory, since any client can set it to anything. An empty
+// string is returned if the client is anonymous.
+func (s *T) clientID(r *http.Request) string {
+	if clientID := server.VerifiedClientID(r.TLS); clientID != "" {
+		return clientID
+	}
+	return r.Header.Get(s.clientIDHeader)
+}
+
+// verifiedClientID returns an identity of the client that made a request
+// from the client TLS certificate verified by the server. Unlike `clientID`
+// it never trusts the client ID header, so it is used whenever the identity
+// grants access, that is for tenants and topic managers. An empty string is
+// returned if the client did not authenticate with a certificate.
+func verifiedClientID(r *http.Request) string {
+	return server.VerifiedClientID(r.TLS)
+}
+
 // toEncoderPreservingNil converts a slice of bytes to `sarama.Encoder` but
 // returns `nil` if the passed slice is `nil`.
 func toEncoderPreservingNil(b []byte) sarama.Encoder {
@@ -705,6 +1611,47 @@ func toEncoderPreservingNil(b []byte) sarama.Encoder {
 	return nil
 }
 
+// parseProducePartition returns a partition explicitly specified in a produce
+// request, or `producer.AnyPartition` if there is none.
+func parseProducePartition(r *http.Request) (int32, error) {
+	partitionStr := r.FormValue(prmPartition)
+	if partitionStr == "" {
+		return producer.AnyPartition, nil
+	}
+	partition, err := strconv.ParseInt(partitionStr, 10, 32)
+	if err != nil || partition < 0 {
+		return 0, errors.Errorf("bad %s: %s", prmPartition, partitionStr)
+	}
+	return int32(partition), nil
+}
+
+// parseProduceTimestamp returns a message timestamp given in milliseconds
+// since the Unix epoch, or zero time if it is not specified.
+func parseProduceTimestamp(r *http.Request) (time.Time, error) {
+	timestampStr := r.FormValue(prmTimestamp)
+	if timestampStr == "" {
+		return time.Time{}, nil
+	}
+	timestamp, err := strconv.ParseInt(timestampStr, 10, 64)
+	if err != nil || timestamp <= 0 {
+		return time.Time{}, errors.Errorf("bad %s: %s", prmTimestamp, timestampStr)
+	}
+	return fromMillis(timestamp), nil
+}
+
+// fromMillis converts milliseconds since the Unix epoch to time. Zero is
+// converted to zero time meaning that the timestamp is not set.
+func fromMillis(ms int64) time.Time {
+	if ms == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, ms*int64(time.Millisecond))
+}
+
+func toMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
 func parseAck(r *http.Request, isConsReq bool) (proxy.Ack, error) {
 	var partitionPrmName, offsetPrmName string
 	if isConsReq {
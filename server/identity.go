@@ -0,0 +1,22 @@
+package server
+
+import "crypto/tls"
+
+// VerifiedClientID returns the identity of a client that authenticated with a
+// TLS certificate verified by the server. It is the common name of the
+// certificate, or its first DNS name if the common name is empty. An empty
+// string is returned if the connection is not TLS, or the client did not
+// present a certificate, or the server is not configured to verify them.
+func VerifiedClientID(state *tls.ConnectionState) string {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	cert := state.VerifiedChains[0][0]
+	if cert.Subject.CommonName != "" {
+		return cert.Subject.CommonName
+	}
+	if len(cert.DNSNames) > 0 {
+		return cert.DNSNames[0]
+	}
+	return ""
+}
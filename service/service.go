@@ -46,7 +46,8 @@ func Spawn(cfg *config.App) (*T, error) {
 			s.stopProxies()
 			return nil, errors.Wrap(err, "failed to configure gRPC security")
 		}
-		grpcSrv, err := grpcsrv.New(cfg.GRPCAddr, proxySet, securityOpts...)
+		srvOpts := append(securityOpts, grpcsrv.MaxMessageSize(cfg.MaxChunkedMessageBytes()))
+		grpcSrv, err := grpcsrv.New(cfg.GRPCAddr, proxySet, cfg.ClientIDHeader, srvOpts...)
 		if err != nil {
 			s.stopProxies()
 			return nil, errors.Wrap(err, "failed to start gRPC server")
@@ -54,7 +55,12 @@ func Spawn(cfg *config.App) (*T, error) {
 		s.servers = append(s.servers, grpcSrv)
 	}
 	if cfg.TCPAddr != "" {
-		tcpSrv, err := httpsrv.New(cfg.TCPAddr, proxySet, cfg.TLS.CertPath, cfg.TLS.KeyPath)
+		tlsCfg, err := cfg.ServerTLSConfig()
+		if err != nil {
+			s.stopProxies()
+			return nil, errors.Wrap(err, "failed to configure HTTP API server TLS")
+		}
+		tcpSrv, err := httpsrv.New(cfg.TCPAddr, proxySet, cfg.ClientIDHeader, tlsCfg)
 		if err != nil {
 			s.stopProxies()
 			return nil, errors.Wrap(err, "failed to start TCP socket based HTTP API server")
@@ -62,7 +68,7 @@ func Spawn(cfg *config.App) (*T, error) {
 		s.servers = append(s.servers, tcpSrv)
 	}
 	if cfg.UnixAddr != "" {
-		unixSrv, err := httpsrv.New(cfg.UnixAddr, proxySet, "", "")
+		unixSrv, err := httpsrv.New(cfg.UnixAddr, proxySet, cfg.ClientIDHeader, nil)
 		if err != nil {
 			s.stopProxies()
 			return nil, errors.Wrapf(err, "failed to start Unix socket based HTTP API server")
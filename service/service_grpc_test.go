@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"io"
 	"math/rand"
 	"sync"
 	"time"
@@ -15,6 +16,8 @@ import (
 	"github.com/samuel/go-zookeeper/zk"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	. "gopkg.in/check.v1"
 )
@@ -76,7 +79,7 @@ func (s *ServiceGRPCSuite) TestProduceWithKey(c *C) {
 		}
 		res, err := s.clt.Produce(ctx, &req, grpc.FailFast(false))
 		c.Check(err, IsNil)
-		c.Check(*res, Equals, pb.ProdRs{Partition: -1, Offset: -1})
+		c.Check(*res, Equals, pb.ProdRs{Partition: -1, Offset: -1, Cluster: "pxyG"})
 	}
 	// Stop service to make it commit asynchronously produced messages to Kafka.
 	svc.Stop()
@@ -110,7 +113,7 @@ func (s *ServiceGRPCSuite) TestProduceKeyUndefined(c *C) {
 		}
 		res, err := s.clt.Produce(ctx, &req, grpc.FailFast(false))
 		c.Check(err, IsNil)
-		c.Check(*res, Equals, pb.ProdRs{Partition: -1, Offset: -1})
+		c.Check(*res, Equals, pb.ProdRs{Partition: -1, Offset: -1, Cluster: "pxyG"})
 	}
 	// Stop service to make it commit asynchronously produced messages to Kafka.
 	svc.Stop()
@@ -144,7 +147,7 @@ func (s *ServiceGRPCSuite) TestProduceDefaultKey(c *C) {
 		}
 		res, err := s.clt.Produce(ctx, &req, grpc.FailFast(false))
 		c.Check(err, IsNil)
-		c.Check(*res, Equals, pb.ProdRs{Partition: -1, Offset: -1})
+		c.Check(*res, Equals, pb.ProdRs{Partition: -1, Offset: -1, Cluster: "pxyG"})
 	}
 	// Stop service to make it commit asynchronously produced messages to Kafka.
 	svc.Stop()
@@ -180,7 +183,7 @@ func (s *ServiceGRPCSuite) TestProduceSync(c *C) {
 
 	// Then
 	c.Check(err, IsNil)
-	c.Check(*res, Equals, pb.ProdRs{Partition: 2, Offset: offsetsBefore[2]})
+	c.Check(*res, Equals, pb.ProdRs{Partition: 2, Offset: offsetsBefore[2], Cluster: "pxyG"})
 }
 
 func (s *ServiceGRPCSuite) TestProduceInvalidProxy(c *C) {
@@ -241,6 +244,511 @@ func (s *ServiceGRPCSuite) TestProduceHeadersUnsupported(c *C) {
 	c.Check(res, IsNil)
 }
 
+// Messages produced atomically are written to all specified topics, and the
+// response reports partitions and offsets they were written at.
+func (s *ServiceGRPCSuite) TestProduceAtomic(c *C) {
+	if !s.proxyCfg.Kafka.Version.IsAtLeast(sarama.V0_11_0_0) {
+		c.Skip("Transactions are not supported on old Kafka")
+	}
+	s.proxyCfg.Producer.TransactionalIDPrefix = "pxyG_txn_"
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+	s.waitSvcUp(c, 5*time.Second)
+
+	offsets1Before := s.kh.GetNewestOffsets("test.1")
+	offsets4Before := s.kh.GetNewestOffsets("test.4")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// When
+	req := pb.ProdAtomicRq{
+		Messages: []*pb.ProdMsg{
+			{Topic: "test.4", KeyValue: []byte("1"), Message: []byte("msg1")},
+			{Topic: "test.1", KeyUndefined: true, Message: []byte("msg2")},
+			{Topic: "test.4", KeyValue: []byte("1"), Message: []byte("msg3")},
+		},
+	}
+	res, err := s.clt.ProduceAtomic(ctx, &req, grpc.FailFast(false))
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Assert(len(res.Results), Equals, 3)
+	c.Check(res.Results[0].Partition, Equals, int32(0))
+	c.Check(res.Results[0].Offset, Equals, offsets4Before[0])
+	c.Check(res.Results[1].Partition, Equals, int32(0))
+	c.Check(res.Results[1].Offset, Equals, offsets1Before[0])
+	c.Check(res.Results[2].Partition, Equals, int32(0))
+	c.Check(res.Results[2].Offset, Equals, offsets4Before[0]+1)
+	// A commit marker is written to every partition of the transaction.
+	offsets1After := s.kh.GetNewestOffsets("test.1")
+	offsets4After := s.kh.GetNewestOffsets("test.4")
+	c.Check(offsets1After[0], Equals, offsets1Before[0]+2)
+	c.Check(offsets4After[0], Equals, offsets4Before[0]+3)
+}
+
+// If transactional produce is not configured, then Unavailable is returned.
+func (s *ServiceGRPCSuite) TestProduceAtomicDisabled(c *C) {
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+	s.waitSvcUp(c, 5*time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// When
+	req := pb.ProdAtomicRq{
+		Messages: []*pb.ProdMsg{
+			{Topic: "test.4", KeyValue: []byte("1"), Message: []byte("msg")},
+		},
+	}
+	res, err := s.clt.ProduceAtomic(ctx, &req, grpc.FailFast(false))
+
+	// Then
+	grpcStatus, ok := status.FromError(err)
+	c.Check(ok, Equals, true)
+	c.Check(grpcStatus.Message(), Equals, "transactional produce is disabled by configuration")
+	c.Check(grpcStatus.Code(), Equals, codes.Unavailable)
+	c.Check(res, IsNil)
+}
+
+// Results of a batch produce are reported for every message in the order of
+// messages in the request, regardless of whether they succeeded or failed.
+func (s *ServiceGRPCSuite) TestProduceBatch(c *C) {
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+	s.waitSvcUp(c, 5*time.Second)
+
+	offsetsBefore := s.kh.GetNewestOffsets("test.4")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// When
+	req := pb.ProdBatchRq{
+		Messages: []*pb.ProdMsg{
+			{Topic: "test.4", KeyValue: []byte("1"), Message: []byte("msg1")},
+			{Topic: "no-such-topic", KeyUndefined: true, Message: []byte("msg2")},
+			{Topic: "test.4", KeyValue: []byte("1"), Message: []byte("msg3")},
+		},
+	}
+	res, err := s.clt.ProduceBatch(ctx, &req, grpc.FailFast(false))
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Assert(len(res.Results), Equals, 3)
+	c.Check(res.Results[0].Partition, Equals, int32(0))
+	c.Check(res.Results[0].Offset, Equals, offsetsBefore[0])
+	c.Check(res.Results[0].ErrorCode, Equals, int32(codes.OK))
+	c.Check(res.Results[0].Cluster, Equals, "pxyG")
+	c.Check(res.Results[1].Partition, Equals, int32(-1))
+	c.Check(res.Results[1].Offset, Equals, int64(-1))
+	c.Check(res.Results[1].ErrorCode, Equals, int32(codes.InvalidArgument))
+	c.Check(res.Results[1].Error, Equals, sarama.ErrUnknownTopicOrPartition.Error())
+	c.Check(res.Results[2].Partition, Equals, int32(0))
+	c.Check(res.Results[2].Offset, Equals, offsetsBefore[0]+1)
+	c.Check(res.Results[2].Error, Equals, "")
+}
+
+// A batch with more messages than `producer.max_batch_messages` is rejected
+// as a whole.
+func (s *ServiceGRPCSuite) TestProduceBatchTooLarge(c *C) {
+	s.cfg.Proxies[s.cfg.DefaultCluster].Producer.MaxBatchMessages = 2
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+	s.waitSvcUp(c, 5*time.Second)
+
+	offsetsBefore := s.kh.GetNewestOffsets("test.4")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// When
+	req := pb.ProdBatchRq{
+		Messages: []*pb.ProdMsg{
+			{Topic: "test.4", KeyValue: []byte("1"), Message: []byte("msg1")},
+			{Topic: "test.4", KeyValue: []byte("1"), Message: []byte("msg2")},
+			{Topic: "test.4", KeyValue: []byte("1"), Message: []byte("msg3")},
+		},
+	}
+	res, err := s.clt.ProduceBatch(ctx, &req, grpc.FailFast(false))
+
+	// Then
+	grpcStatus, ok := status.FromError(err)
+	c.Check(ok, Equals, true)
+	c.Check(grpcStatus.Code(), Equals, codes.InvalidArgument)
+	c.Check(grpcStatus.Message(), Equals, "3 > 2: too many messages in batch")
+	c.Check(res, IsNil)
+	c.Check(s.kh.GetNewestOffsets("test.4"), DeepEquals, offsetsBefore)
+}
+
+// A batch with a message that has a negative timestamp is rejected as a
+// whole.
+func (s *ServiceGRPCSuite) TestProduceBatchInvalidTimestamp(c *C) {
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+	s.waitSvcUp(c, 5*time.Second)
+
+	offsetsBefore := s.kh.GetNewestOffsets("test.4")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// When
+	req := pb.ProdBatchRq{
+		Messages: []*pb.ProdMsg{
+			{Topic: "test.4", KeyValue: []byte("1"), Message: []byte("msg1")},
+			{Topic: "test.4", KeyValue: []byte("1"), Message: []byte("msg2"), Timestamp: -1},
+		},
+	}
+	res, err := s.clt.ProduceBatch(ctx, &req, grpc.FailFast(false))
+
+	// Then
+	grpcStatus, ok := status.FromError(err)
+	c.Check(ok, Equals, true)
+	c.Check(grpcStatus.Code(), Equals, codes.InvalidArgument)
+	c.Check(grpcStatus.Message(), Equals, "invalid timestamp: -1, index=1")
+	c.Check(res, IsNil)
+	c.Check(s.kh.GetNewestOffsets("test.4"), DeepEquals, offsetsBefore)
+}
+
+// Messages sent over a produce stream are acknowledged with results that
+// carry client supplied sequence numbers.
+func (s *ServiceGRPCSuite) TestProduceStream(c *C) {
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+	s.waitSvcUp(c, 5*time.Second)
+
+	offsetsBefore := s.kh.GetNewestOffsets("test.4")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream, err := s.clt.ProduceStream(ctx, grpc.FailFast(false))
+	c.Assert(err, IsNil)
+
+	// When
+	for i := 0; i < 10; i++ {
+		err := stream.Send(&pb.ProdStreamRq{
+			Seq:     int64(100 + i),
+			Message: &pb.ProdMsg{Topic: "test.4", KeyValue: []byte("1"), Message: []byte(fmt.Sprintf("msg%d", i))},
+		})
+		c.Assert(err, IsNil)
+	}
+	err = stream.Send(&pb.ProdStreamRq{
+		Seq:     200,
+		Message: &pb.ProdMsg{Topic: "no-such-topic", Message: []byte("msg")},
+	})
+	c.Assert(err, IsNil)
+	c.Assert(stream.CloseSend(), IsNil)
+
+	// Then
+	for i := 0; i < 10; i++ {
+		res, err := stream.Recv()
+		c.Assert(err, IsNil)
+		c.Check(res.Seq, Equals, int64(100+i))
+		c.Check(res.Partition, Equals, int32(0))
+		c.Check(res.Offset, Equals, offsetsBefore[0]+int64(i))
+		c.Check(res.ErrorCode, Equals, int32(codes.OK))
+		c.Check(res.Cluster, Equals, "pxyG")
+	}
+	res, err := stream.Recv()
+	c.Assert(err, IsNil)
+	c.Check(res.Seq, Equals, int64(200))
+	c.Check(res.Partition, Equals, int32(-1))
+	c.Check(res.ErrorCode, Equals, int32(codes.InvalidArgument))
+	c.Check(res.Error, Equals, sarama.ErrUnknownTopicOrPartition.Error())
+	_, err = stream.Recv()
+	c.Check(err, Equals, io.EOF)
+}
+
+// A message with a negative timestamp terminates a produce stream.
+func (s *ServiceGRPCSuite) TestProduceStreamInvalidTimestamp(c *C) {
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+	s.waitSvcUp(c, 5*time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream, err := s.clt.ProduceStream(ctx, grpc.FailFast(false))
+	c.Assert(err, IsNil)
+
+	// When
+	err = stream.Send(&pb.ProdStreamRq{
+		Seq:     7,
+		Message: &pb.ProdMsg{Topic: "test.4", Message: []byte("msg"), Timestamp: -1},
+	})
+	c.Assert(err, IsNil)
+
+	// Then
+	_, err = stream.Recv()
+	grpcStatus, ok := status.FromError(err)
+	c.Check(ok, Equals, true)
+	c.Check(grpcStatus.Code(), Equals, codes.InvalidArgument)
+	c.Check(grpcStatus.Message(), Equals, "invalid timestamp: -1, seq=7")
+}
+
+// Messages sent over a produce stream obey the produce route of the topic,
+// and results tell which cluster accepted each message.
+func (s *ServiceGRPCSuite) TestProduceStreamFailover(c *C) {
+	// Produce to pxyG fails, because its schema registry is not available.
+	s.proxyCfg.SchemaRegistry.URL = "http://127.0.0.1:1"
+	s.proxyCfg.SchemaRegistry.Topics = map[string]config.TopicSchema{"test.4": {}}
+	s.proxyCfg.ProduceRoutes = map[string]config.ProduceRoute{
+		"test.4": {FailoverTo: []string{"pxyF"}, FailoverBudget: 1, FailoverWindow: time.Hour},
+	}
+	s.cfg.Proxies["pxyF"] = testhelpers.NewTestProxyCfg("pxyF_client_id")
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+	s.waitSvcUp(c, 5*time.Second)
+
+	offsetsBefore := s.kh.GetNewestOffsets("test.4")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream, err := s.clt.ProduceStream(ctx, grpc.FailFast(false))
+	c.Assert(err, IsNil)
+
+	// When
+	for i := 0; i < 2; i++ {
+		err := stream.Send(&pb.ProdStreamRq{
+			Seq:     int64(i),
+			Message: &pb.ProdMsg{Topic: "test.4", KeyValue: []byte("1"), Message: []byte(fmt.Sprintf("msg%d", i))},
+		})
+		c.Assert(err, IsNil)
+	}
+	c.Assert(stream.CloseSend(), IsNil)
+
+	// Then: the failover budget allows only one message to be failed over.
+	res, err := stream.Recv()
+	c.Assert(err, IsNil)
+	c.Check(res.Seq, Equals, int64(0))
+	c.Check(res.Offset, Equals, offsetsBefore[0])
+	c.Check(res.ErrorCode, Equals, int32(codes.OK))
+	c.Check(res.Cluster, Equals, "pxyF")
+	res, err = stream.Recv()
+	c.Assert(err, IsNil)
+	c.Check(res.Seq, Equals, int64(1))
+	c.Check(res.Partition, Equals, int32(-1))
+	c.Check(res.ErrorCode, Equals, int32(codes.Unavailable))
+	c.Check(res.Cluster, Equals, "")
+	_, err = stream.Recv()
+	c.Check(err, Equals, io.EOF)
+}
+
+// Results of messages produced in async mode with a correlation ID are
+// reported by the DeliveryReports stream.
+func (s *ServiceGRPCSuite) TestDeliveryReports(c *C) {
+	testTLS, err := testhelpers.NewTestTLS(c.MkDir())
+	c.Assert(err, IsNil)
+	testTLS.Apply(s.cfg)
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+	s.dialTLS(c, testTLS, "foo")
+	s.waitSvcUp(c, 5*time.Second)
+
+	offsetsBefore := s.kh.GetNewestOffsets("test.4")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream, err := s.clt.DeliveryReports(ctx, &pb.DeliveryReportsRq{}, grpc.FailFast(false))
+	c.Assert(err, IsNil)
+	// Make sure the stream is subscribed before producing.
+	time.Sleep(100 * time.Millisecond)
+
+	// When
+	_, err = s.clt.Produce(ctx, &pb.ProdRq{
+		Topic:             "test.4",
+		Message:           []byte("msg"),
+		AsyncMode:         true,
+		ExplicitPartition: true,
+		Partition:         1,
+		CorrelationId:     "foo",
+	})
+	c.Assert(err, IsNil)
+	_, err = s.clt.Produce(ctx, &pb.ProdRq{
+		Topic:             "test.4",
+		Message:           []byte("msg"),
+		AsyncMode:         true,
+		ExplicitPartition: true,
+		Partition:         4,
+		CorrelationId:     "bar",
+	})
+	c.Assert(err, IsNil)
+
+	// Then
+	reports := make(map[string]*pb.DeliveryReport)
+	for i := 0; i < 2; i++ {
+		report, err := stream.Recv()
+		c.Assert(err, IsNil)
+		reports[report.CorrelationId] = report
+	}
+	c.Check(reports["foo"].Cluster, Equals, "pxyG")
+	c.Check(reports["foo"].Partition, Equals, int32(1))
+	c.Check(reports["foo"].Offset, Equals, offsetsBefore[1])
+	c.Check(reports["foo"].ErrorCode, Equals, int32(codes.OK))
+	c.Check(reports["bar"].Partition, Equals, int32(-1))
+	c.Check(reports["bar"].ErrorCode, Equals, int32(codes.InvalidArgument))
+	c.Check(reports["bar"].Error, Equals, sarama.ErrInvalidPartition.Error())
+}
+
+// When tenant prefixes are configured, a client cannot claim to be a tenant
+// with the client ID metadata, for only verified TLS identities are trusted.
+func (s *ServiceGRPCSuite) TestTenantMetadataSpoofed(c *C) {
+	s.cfg.ClientIDHeader = "X-Client-Id"
+	s.proxyCfg.TopicMapping.TenantPrefixes = map[string]string{"foo": "foo."}
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+	s.waitSvcUp(c, 5*time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-client-id", "foo")
+
+	// When
+	_, err = s.clt.Produce(ctx, &pb.ProdRq{
+		Topic:   "test.4",
+		Message: []byte("msg"),
+	}, grpc.FailFast(false))
+
+	// Then
+	c.Check(status.Code(err), Equals, codes.PermissionDenied)
+}
+
+// Delivery reports are only available to clients that authenticated with a
+// TLS certificate, for anonymous clients are indistinguishable.
+func (s *ServiceGRPCSuite) TestDeliveryReportsAnonymous(c *C) {
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+	s.waitSvcUp(c, 5*time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// When
+	stream, err := s.clt.DeliveryReports(ctx, &pb.DeliveryReportsRq{}, grpc.FailFast(false))
+	c.Assert(err, IsNil)
+	_, streamErr := stream.Recv()
+	_, produceErr := s.clt.Produce(ctx, &pb.ProdRq{
+		Topic:         "test.4",
+		Message:       []byte("msg"),
+		AsyncMode:     true,
+		CorrelationId: "foo",
+	}, grpc.FailFast(false))
+
+	// Then
+	c.Check(status.Code(streamErr), Equals, codes.Unauthenticated)
+	c.Check(status.Code(produceErr), Equals, codes.Unauthenticated)
+}
+
+// A client cannot claim to be a topic manager with the client ID metadata,
+// for only verified TLS identities are trusted.
+func (s *ServiceGRPCSuite) TestCreateTopicMetadataSpoofed(c *C) {
+	s.cfg.ClientIDHeader = "X-Client-Id"
+	s.proxyCfg.Admin.TopicManagers = []string{"ops"}
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+	s.waitSvcUp(c, 5*time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-client-id", "ops")
+
+	// When
+	_, err = s.clt.CreateTopic(ctx, &pb.CreateTopicRq{
+		Topic:             "test.new",
+		Partitions:        4,
+		ReplicationFactor: 1,
+		ValidateOnly:      true,
+	}, grpc.FailFast(false))
+
+	// Then
+	c.Check(status.Code(err), Equals, codes.PermissionDenied)
+}
+
+// A correlation ID can only be used in async mode.
+func (s *ServiceGRPCSuite) TestProduceCorrelationIDSync(c *C) {
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+	s.waitSvcUp(c, 5*time.Second)
+
+	// When
+	_, err = s.clt.Produce(context.Background(), &pb.ProdRq{
+		Topic:         "test.4",
+		Message:       []byte("msg"),
+		CorrelationId: "foo",
+	}, grpc.FailFast(false))
+
+	// Then
+	c.Check(status.Code(err), Equals, codes.InvalidArgument)
+}
+
+// If a partition is explicitly specified, then a message is written to it.
+func (s *ServiceGRPCSuite) TestProduceExplicitPartition(c *C) {
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+	s.waitSvcUp(c, 5*time.Second)
+
+	offsetsBefore := s.kh.GetNewestOffsets("test.4")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// When
+	req := pb.ProdRq{
+		Topic:             "test.4",
+		KeyValue:          []byte("1"),
+		Message:           []byte("msg"),
+		ExplicitPartition: true,
+		Partition:         2,
+	}
+	res, err := s.clt.Produce(ctx, &req, grpc.FailFast(false))
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Check(res.Partition, Equals, int32(2))
+	c.Check(res.Offset, Equals, offsetsBefore[2])
+}
+
+func (s *ServiceGRPCSuite) TestProduceExplicitPartitionOutOfRange(c *C) {
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+	s.waitSvcUp(c, 5*time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// When
+	req := pb.ProdRq{
+		Topic:             "test.4",
+		Message:           []byte("msg"),
+		ExplicitPartition: true,
+		Partition:         4,
+	}
+	res, err := s.clt.Produce(ctx, &req, grpc.FailFast(false))
+
+	// Then
+	grpcStatus, ok := status.FromError(err)
+	c.Check(ok, Equals, true)
+	c.Check(grpcStatus.Message(), Equals, sarama.ErrInvalidPartition.Error())
+	c.Check(grpcStatus.Code(), Equals, codes.InvalidArgument)
+	c.Check(res, IsNil)
+}
+
 // Offsets of messages consumed in auto-ack mode are properly committed.
 func (s *ServiceGRPCSuite) TestConsumeAutoAck(c *C) {
 	svc, err := Spawn(s.cfg)
@@ -423,9 +931,10 @@ func (s *ServiceGRPCSuite) TestConsumeExplicitProxy(c *C) {
 	defer cancel()
 
 	prodReq := pb.ProdRq{
-		Topic:    "test.4",
-		KeyValue: []byte("bar"),
-		Message:  []byte(fmt.Sprintf("msg%d", rand.Int())),
+		Topic:     "test.4",
+		KeyValue:  []byte("bar"),
+		Message:   []byte(fmt.Sprintf("msg%d", rand.Int())),
+		Timestamp: time.Now().Add(-time.Minute).UnixNano() / int64(time.Millisecond),
 	}
 	prodRes, err := s.clt.Produce(ctx, &prodReq, grpc.FailFast(false))
 	c.Check(err, IsNil)
@@ -437,10 +946,12 @@ func (s *ServiceGRPCSuite) TestConsumeExplicitProxy(c *C) {
 	// Then
 	c.Check(err, IsNil)
 	c.Check(*consRes, DeepEquals, pb.ConsRs{
-		Partition: prodRes.Partition,
-		Offset:    prodRes.Offset,
-		KeyValue:  prodReq.KeyValue,
-		Message:   prodReq.Message,
+		Partition:     prodRes.Partition,
+		Offset:        prodRes.Offset,
+		KeyValue:      prodReq.KeyValue,
+		Message:       prodReq.Message,
+		Timestamp:     prodReq.Timestamp,
+		TimestampType: "create_time",
 	})
 }
 
@@ -461,6 +972,7 @@ func (s *ServiceGRPCSuite) TestConsumeKeyUndefined(c *C) {
 		Topic:        "test.4",
 		KeyUndefined: true,
 		Message:      []byte(fmt.Sprintf("msg-%d", rand.Int())),
+		Timestamp:    time.Now().Add(-time.Minute).UnixNano() / int64(time.Millisecond),
 	}
 	prodRes, err := s.clt.Produce(ctx, &prodReq, grpc.FailFast(false))
 	c.Check(err, IsNil)
@@ -472,10 +984,12 @@ func (s *ServiceGRPCSuite) TestConsumeKeyUndefined(c *C) {
 	// Then
 	c.Check(err, IsNil)
 	c.Check(*consRes, DeepEquals, pb.ConsRs{
-		Partition:    prodRes.Partition,
-		Offset:       prodRes.Offset,
-		KeyUndefined: true,
-		Message:      prodReq.Message,
+		Partition:     prodRes.Partition,
+		Offset:        prodRes.Offset,
+		KeyUndefined:  true,
+		Message:       prodReq.Message,
+		Timestamp:     prodReq.Timestamp,
+		TimestampType: "create_time",
 	})
 }
 
@@ -500,6 +1014,7 @@ func (s *ServiceGRPCSuite) TestConsumeHeaders(c *C) {
 		Topic:        "test.4",
 		KeyUndefined: true,
 		Message:      []byte(fmt.Sprintf("msg-%d", rand.Int())),
+		Timestamp:    time.Now().Add(-time.Minute).UnixNano() / int64(time.Millisecond),
 		Headers: []*pb.RecordHeader{
 			{Key: "foo", Value: []byte("bar")},
 		},
@@ -514,11 +1029,13 @@ func (s *ServiceGRPCSuite) TestConsumeHeaders(c *C) {
 	// Then
 	c.Check(err, IsNil)
 	c.Check(*consRes, DeepEquals, pb.ConsRs{
-		Partition:    prodRes.Partition,
-		Offset:       prodRes.Offset,
-		KeyUndefined: true,
-		Message:      prodReq.Message,
-		Headers:      prodReq.Headers,
+		Partition:     prodRes.Partition,
+		Offset:        prodRes.Offset,
+		KeyUndefined:  true,
+		Message:       prodReq.Message,
+		Headers:       prodReq.Headers,
+		Timestamp:     prodReq.Timestamp,
+		TimestampType: "create_time",
 	})
 }
 
@@ -709,6 +1226,71 @@ func (s *ServiceGRPCSuite) TestConsumeMissingTopic(c *C) {
 	wg.Wait()
 }
 
+// Messages can be browsed without affecting offsets committed by groups.
+func (s *ServiceGRPCSuite) TestBrowseMessages(c *C) {
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+	s.waitSvcUp(c, 5*time.Second)
+
+	s.kh.ResetOffsets("foo", "test.1")
+	produced := s.kh.PutMessages("browse", "test.1", map[string]int{"A": 3})
+	offsetsBefore := s.kh.GetCommittedOffsets("foo", "test.1")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// When
+	rs, err := s.clt.BrowseMessages(ctx, &pb.BrowseMessagesRq{
+		Topic: "test.1",
+		From:  produced["A"][0].Offset,
+		Count: 2,
+	})
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Assert(len(rs.Messages), Equals, 2)
+	for i, msg := range rs.Messages {
+		c.Check(msg.Offset, Equals, produced["A"][i].Offset)
+		c.Check(string(msg.KeyValue), Equals, "A")
+		c.Check(string(msg.Message), Equals, fmt.Sprintf("browse:A:%d", i))
+		c.Check(msg.Preview, Equals, fmt.Sprintf("browse:A:%d", i))
+	}
+	c.Check(rs.NextOffset, Equals, produced["A"][1].Offset+1)
+	c.Check(rs.End, Equals, produced["A"][2].Offset+1)
+	offsetsAfter := s.kh.GetCommittedOffsets("foo", "test.1")
+	c.Check(offsetsAfter[0].Val, Equals, offsetsBefore[0].Val)
+}
+
+// Browsing from an offset that is out of the partition range is rejected.
+func (s *ServiceGRPCSuite) TestBrowseMessagesOutOfRange(c *C) {
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+	s.waitSvcUp(c, 5*time.Second)
+
+	offsets := s.kh.GetNewestOffsets("test.1")
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// When
+	_, err = s.clt.BrowseMessages(ctx, &pb.BrowseMessagesRq{Topic: "test.1", From: offsets[0] + 100})
+
+	// Then
+	c.Check(status.Code(err), Equals, codes.OutOfRange)
+}
+
+// dialTLS replaces the suite client with one that authenticates with a TLS
+// certificate issued for `commonName`.
+func (s *ServiceGRPCSuite) dialTLS(c *C, testTLS *testhelpers.TestTLS, commonName string) {
+	tlsCfg, err := testTLS.ClientConfig(commonName)
+	c.Assert(err, IsNil)
+	s.cltConn.Close()
+	s.cltConn, err = grpc.Dial(s.cfg.GRPCAddr, grpc.WithTransportCredentials(credentials.NewTLS(tlsCfg)))
+	c.Assert(err, IsNil)
+	s.clt = pb.NewKafkaPixyClient(s.cltConn)
+}
+
 func (s *ServiceGRPCSuite) waitSvcUp(c *C, timeout time.Duration) {
 	start := time.Now()
 	for {
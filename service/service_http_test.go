@@ -70,6 +70,18 @@ func (s *ServiceHTTPSuite) TearDownTest(c *C) {
 	s.kh.Close()
 }
 
+// tlsClient configures the TCP server with TLS and verification of client
+// certificates. It returns a client that authenticates with a certificate
+// issued for `commonName`, along with the base URL of the TCP server.
+func (s *ServiceHTTPSuite) tlsClient(c *C, commonName string) (*http.Client, string) {
+	testTLS, err := testhelpers.NewTestTLS(c.MkDir())
+	c.Assert(err, IsNil)
+	testTLS.Apply(s.cfg)
+	tlsCfg, err := testTLS.ClientConfig(commonName)
+	c.Assert(err, IsNil)
+	return &http.Client{Transport: &http.Transport{TLSClientConfig: tlsCfg}}, "https://" + s.cfg.TCPAddr
+}
+
 func (s *ServiceHTTPSuite) TestInvalidUnixAddr(c *C) {
 	// Server TCP socket will be left hanging in this case. In real life it is
 	// kind of ok, because application would soon terminate anyway releasing
@@ -277,6 +289,42 @@ func (s *ServiceHTTPSuite) TestProduceHeadersUnsupported(c *C) {
 
 }
 
+// Produce requests that exceed a client rate limit are rejected with 429 and
+// a retry after hint.
+func (s *ServiceHTTPSuite) TestProduceThrottled(c *C) {
+	s.cfg.ClientIDHeader = "X-Client-Id"
+	s.proxyCfg.ProduceLimits.Clients = map[string]config.RateLimit{
+		"foo": {MessagesPerSecond: 0.1, MessagesBurst: 1},
+	}
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+
+	produce := func(clientID string) *http.Response {
+		req, err := http.NewRequest("POST", "http://_/topics/test.1/messages", strings.NewReader("bar"))
+		c.Assert(err, IsNil)
+		req.Header.Add("Content-Type", "text/plain")
+		req.Header.Add("X-Client-Id", clientID)
+		rs, err := s.unixClient.Do(req)
+		c.Assert(err, IsNil)
+		return rs
+	}
+	c.Check(produce("foo").StatusCode, Equals, http.StatusOK)
+
+	// When
+	rs := produce("foo")
+
+	// Then
+	c.Check(rs.StatusCode, Equals, http.StatusTooManyRequests)
+	c.Check(rs.Header.Get("Retry-After"), Equals, "10")
+	body := ParseJSONBody(c, rs).(map[string]interface{})
+	c.Check(body["error"], Matches, `produce rate limit exceeded: client "foo" messages, .*`)
+	c.Check(body["retry_after_ms"].(float64) > 9000, Equals, true)
+
+	// Other clients are not limited.
+	c.Check(produce("bar").StatusCode, Equals, http.StatusOK)
+}
+
 func (s *ServiceHTTPSuite) TestProduceXWWWFormUrlencoded(c *C) {
 	svc, err := Spawn(s.cfg)
 	c.Assert(err, IsNil)
@@ -368,7 +416,7 @@ func (s *ServiceHTTPSuite) TestLargestMessage(c *C) {
 
 	// Then
 	c.Check(r.StatusCode, Equals, http.StatusOK)
-	c.Check(ParseJSONBody(c, r), DeepEquals, map[string]interface{}{})
+	c.Check(ParseJSONBody(c, r), DeepEquals, map[string]interface{}{"cluster": "pxyH"})
 	offsetsAfter := s.kh.GetNewestOffsets("test.4")
 	messages := s.kh.GetMessages("test.4", offsetsBefore, offsetsAfter)
 	readMsg := messages[1][0]
@@ -392,7 +440,7 @@ func (s *ServiceHTTPSuite) TestMessageTooLarge(c *C) {
 
 	// Then
 	c.Check(r.StatusCode, Equals, http.StatusOK)
-	c.Check(ParseJSONBody(c, r), DeepEquals, map[string]interface{}{})
+	c.Check(ParseJSONBody(c, r), DeepEquals, map[string]interface{}{"cluster": "pxyH"})
 	offsetsAfter := s.kh.GetNewestOffsets("test.4")
 	c.Check(offsetsAfter, DeepEquals, offsetsBefore)
 }
@@ -414,9 +462,193 @@ func (s *ServiceHTTPSuite) TestSyncProduce(c *C) {
 	body := ParseJSONBody(c, r).(map[string]interface{})
 	c.Check(int(body["partition"].(float64)), Equals, 0)
 	c.Check(int64(body["offset"].(float64)), Equals, offsetsBefore[0])
+	c.Check(body["cluster"], Equals, "pxyH")
+	c.Check(offsetsAfter[0], Equals, offsetsBefore[0]+1)
+}
+
+// If a cluster fails to write a message, then it is written to a failover
+// cluster of the topic produce route, until the failover budget is spent.
+func (s *ServiceHTTPSuite) TestSyncProduceFailover(c *C) {
+	// Produce to pxyH fails, because its schema registry is not available.
+	s.proxyCfg.SchemaRegistry.URL = "http://127.0.0.1:1"
+	s.proxyCfg.SchemaRegistry.Topics = map[string]config.TopicSchema{"test.4": {}}
+	s.proxyCfg.ProduceRoutes = map[string]config.ProduceRoute{
+		"test.4": {FailoverTo: []string{"pxyF"}, FailoverBudget: 1, FailoverWindow: time.Hour},
+	}
+	s.cfg.Proxies["pxyF"] = testhelpers.NewTestProxyCfg("pxyF_client_id")
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	offsetsBefore := s.kh.GetNewestOffsets("test.4")
+
+	// When
+	r1, err1 := s.unixClient.Post("http://_/topics/test.4/messages?key=1&sync",
+		"text/plain", strings.NewReader("Foo"))
+	r2, err2 := s.unixClient.Post("http://_/topics/test.4/messages?key=1&sync",
+		"text/plain", strings.NewReader("Bar"))
+	svc.Stop() // Have to stop before getOffsets
+	offsetsAfter := s.kh.GetNewestOffsets("test.4")
+
+	// Then
+	c.Check(err1, IsNil)
+	c.Check(r1.StatusCode, Equals, http.StatusOK)
+	body := ParseJSONBody(c, r1).(map[string]interface{})
+	c.Check(int64(body["offset"].(float64)), Equals, offsetsBefore[0])
+	c.Check(body["cluster"], Equals, "pxyF")
+
+	c.Check(err2, IsNil)
+	c.Check(r2.StatusCode, Equals, http.StatusServiceUnavailable)
+
+	c.Check(offsetsAfter[0], Equals, offsetsBefore[0]+1)
+}
+
+// Messages produced to a topic are mirrored to clusters of the topic produce
+// route.
+func (s *ServiceHTTPSuite) TestSyncProduceMirror(c *C) {
+	s.proxyCfg.ProduceRoutes = map[string]config.ProduceRoute{
+		"test.4": {MirrorTo: []string{"pxyM"}},
+	}
+	s.cfg.Proxies["pxyM"] = testhelpers.NewTestProxyCfg("pxyM_client_id")
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	offsetsBefore := s.kh.GetNewestOffsets("test.4")
+
+	// When
+	r, err := s.unixClient.Post("http://_/topics/test.4/messages?key=1&sync",
+		"text/plain", strings.NewReader("Foo"))
+	svc.Stop() // Have to stop before getOffsets
+	offsetsAfter := s.kh.GetNewestOffsets("test.4")
+
+	// Then: both clusters are backed by the same Kafka in tests, so the
+	// message is written twice.
+	c.Check(err, IsNil)
+	c.Check(r.StatusCode, Equals, http.StatusOK)
+	body := ParseJSONBody(c, r).(map[string]interface{})
+	c.Check(body["cluster"], Equals, "pxyH")
+	c.Check(offsetsAfter[0], Equals, offsetsBefore[0]+2)
+}
+
+// Messages produced to a topic alias are written to the real topic.
+func (s *ServiceHTTPSuite) TestSyncProduceAlias(c *C) {
+	s.proxyCfg.TopicMapping.Aliases = map[string]config.TopicAlias{
+		"orders": {Topic: "test.4"},
+	}
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	offsetsBefore := s.kh.GetNewestOffsets("test.4")
+
+	// When
+	r, err := s.unixClient.Post("http://_/topics/orders/messages?key=1&sync",
+		"text/plain", strings.NewReader("Foo"))
+	svc.Stop() // Have to stop before getOffsets
+	offsetsAfter := s.kh.GetNewestOffsets("test.4")
+
+	// Then
+	c.Check(err, IsNil)
+	c.Check(r.StatusCode, Equals, http.StatusOK)
 	c.Check(offsetsAfter[0], Equals, offsetsBefore[0]+1)
 }
 
+// Resolution of a topic name is reported for the client making the request.
+func (s *ServiceHTTPSuite) TestGetTopicRouting(c *C) {
+	s.cfg.Proxies["pxyW"] = testhelpers.NewTestProxyCfg("pxyW_client_id")
+	s.proxyCfg.TopicMapping.Aliases = map[string]config.TopicAlias{
+		"audit": {Topic: "audit-log", Cluster: "pxyW"},
+	}
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+
+	getRouting := func(url string) map[string]interface{} {
+		r, err := s.unixClient.Get(url)
+		c.Assert(err, IsNil)
+		c.Assert(r.StatusCode, Equals, http.StatusOK)
+		return ParseJSONBody(c, r).(map[string]interface{})
+	}
+
+	// When/Then
+	c.Check(getRouting("http://_/topics/audit/routing"), DeepEquals, map[string]interface{}{
+		"name":    "audit",
+		"tenant":  "",
+		"cluster": "pxyW",
+		"topic":   "audit-log",
+		"aliased": true,
+		"prefix":  "",
+	})
+	c.Check(getRouting("http://_/clusters/pxyH/topics/test.1/routing"), DeepEquals, map[string]interface{}{
+		"name":    "test.1",
+		"tenant":  "",
+		"cluster": "pxyH",
+		"topic":   "test.1",
+		"aliased": false,
+		"prefix":  "",
+	})
+}
+
+// If an alias refers to a topic of another cluster, then the tenant prefixes
+// of that cluster apply to the topic.
+func (s *ServiceHTTPSuite) TestGetTopicRoutingCrossClusterTenant(c *C) {
+	s.cfg.Proxies["pxyW"] = testhelpers.NewTestProxyCfg("pxyW_client_id")
+	s.cfg.Proxies["pxyW"].TopicMapping.TenantPrefixes = map[string]string{"foo": "foo."}
+	s.proxyCfg.TopicMapping.Aliases = map[string]config.TopicAlias{
+		"audit": {Topic: "audit-log", Cluster: "pxyW"},
+	}
+	tlsClient, baseURL := s.tlsClient(c, "foo")
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+
+	// When
+	tenantRs, err := tlsClient.Get(baseURL + "/topics/audit/routing")
+	c.Assert(err, IsNil)
+	anonymousRs, err := s.unixClient.Get("http://_/topics/audit/routing")
+	c.Assert(err, IsNil)
+	localRs, err := s.unixClient.Get("http://_/topics/test.1/routing")
+	c.Assert(err, IsNil)
+
+	// Then
+	c.Check(tenantRs.StatusCode, Equals, http.StatusOK)
+	c.Check(ParseJSONBody(c, tenantRs), DeepEquals, map[string]interface{}{
+		"name":    "audit",
+		"tenant":  "foo",
+		"cluster": "pxyW",
+		"topic":   "foo.audit-log",
+		"aliased": true,
+		"prefix":  "foo.",
+	})
+	c.Check(anonymousRs.StatusCode, Equals, http.StatusForbidden)
+	c.Check(ParseJSONBody(c, anonymousRs), DeepEquals,
+		map[string]interface{}{"error": `tenant="": client is not a known tenant`})
+	c.Check(localRs.StatusCode, Equals, http.StatusOK)
+}
+
+// When tenant prefixes are configured, a client cannot claim to be a tenant
+// with the client ID header, for only verified TLS identities are trusted.
+func (s *ServiceHTTPSuite) TestTenantHeaderSpoofed(c *C) {
+	s.cfg.ClientIDHeader = "X-Client-Id"
+	s.proxyCfg.TopicMapping.TenantPrefixes = map[string]string{"foo": "foo."}
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+
+	// When
+	req, err := http.NewRequest("GET", "http://_/topics/test.1/routing", nil)
+	c.Assert(err, IsNil)
+	req.Header.Add("X-Client-Id", "foo")
+	routingRs, err := s.unixClient.Do(req)
+	c.Assert(err, IsNil)
+	req, err = http.NewRequest("POST", "http://_/topics/test.1/messages?sync", strings.NewReader("Foo"))
+	c.Assert(err, IsNil)
+	req.Header.Add("X-Client-Id", "foo")
+	produceRs, err := s.unixClient.Do(req)
+	c.Assert(err, IsNil)
+
+	// Then
+	c.Check(routingRs.StatusCode, Equals, http.StatusForbidden)
+	c.Check(ParseJSONBody(c, routingRs), DeepEquals,
+		map[string]interface{}{"error": `tenant="": client is not a known tenant`})
+	c.Check(produceRs.StatusCode, Equals, http.StatusForbidden)
+}
+
 func (s *ServiceHTTPSuite) TestSyncProduceInvalidTopic(c *C) {
 	svc, err := Spawn(s.cfg)
 	c.Assert(err, IsNil)
@@ -433,6 +665,160 @@ func (s *ServiceHTTPSuite) TestSyncProduceInvalidTopic(c *C) {
 	c.Check(body["error"], Equals, sarama.ErrUnknownTopicOrPartition.Error())
 }
 
+// Results of a batch produce are reported for every message in the order of
+// messages in the request, regardless of whether they succeeded or failed.
+func (s *ServiceHTTPSuite) TestProduceBatch(c *C) {
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	offsetsBefore := s.kh.GetNewestOffsets("test.4")
+
+	// When
+	r, err := s.unixClient.Post("http://_/messages", "application/x-ndjson", strings.NewReader(""+
+		`{"topic": "test.4", "key": "MQ==", "value": "Zm9v"}`+"\n"+
+		`{"topic": "no-such-topic", "value": "YmFy"}`+"\n"+
+		`{"topic": "test.4", "key": "MQ==", "value": "YmF6"}`+"\n"))
+	svc.Stop() // Have to stop before getOffsets
+	offsetsAfter := s.kh.GetNewestOffsets("test.4")
+
+	// Then
+	c.Check(err, IsNil)
+	c.Check(r.StatusCode, Equals, http.StatusOK)
+	body := ParseJSONBody(c, r).(map[string]interface{})
+	results := body["results"].([]interface{})
+	c.Assert(len(results), Equals, 3)
+	c.Check(results[0], DeepEquals, map[string]interface{}{
+		"partition": float64(0), "offset": float64(offsetsBefore[0]), "cluster": "pxyH", "status": float64(200)})
+	c.Check(results[1], DeepEquals, map[string]interface{}{
+		"partition": float64(-1), "offset": float64(-1), "status": float64(404),
+		"error": sarama.ErrUnknownTopicOrPartition.Error()})
+	c.Check(results[2], DeepEquals, map[string]interface{}{
+		"partition": float64(0), "offset": float64(offsetsBefore[0] + 1), "cluster": "pxyH", "status": float64(200)})
+	c.Check(offsetsAfter[0], Equals, offsetsBefore[0]+2)
+}
+
+// Messages of a batch obey produce routes of their topics, and results tell
+// which cluster accepted each message.
+func (s *ServiceHTTPSuite) TestProduceBatchFailover(c *C) {
+	// Produce to pxyH fails, because its schema registry is not available.
+	s.proxyCfg.SchemaRegistry.URL = "http://127.0.0.1:1"
+	s.proxyCfg.SchemaRegistry.Topics = map[string]config.TopicSchema{"test.4": {}}
+	s.proxyCfg.ProduceRoutes = map[string]config.ProduceRoute{
+		"test.4": {FailoverTo: []string{"pxyF"}, FailoverBudget: 1, FailoverWindow: time.Hour},
+	}
+	s.cfg.Proxies["pxyF"] = testhelpers.NewTestProxyCfg("pxyF_client_id")
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	offsetsBefore := s.kh.GetNewestOffsets("test.4")
+
+	// When
+	r, err := s.unixClient.Post("http://_/messages", "application/x-ndjson", strings.NewReader(""+
+		`{"topic": "test.4", "key": "MQ==", "value": "Zm9v"}`+"\n"+
+		`{"topic": "test.4", "key": "MQ==", "value": "YmFy"}`+"\n"))
+	svc.Stop() // Have to stop before getOffsets
+	offsetsAfter := s.kh.GetNewestOffsets("test.4")
+
+	// Then: the failover budget allows only one message to be failed over.
+	c.Check(err, IsNil)
+	c.Check(r.StatusCode, Equals, http.StatusOK)
+	body := ParseJSONBody(c, r).(map[string]interface{})
+	results := body["results"].([]interface{})
+	c.Assert(len(results), Equals, 2)
+	result0 := results[0].(map[string]interface{})
+	c.Check(result0["status"], Equals, float64(200))
+	c.Check(result0["offset"], Equals, float64(offsetsBefore[0]))
+	c.Check(result0["cluster"], Equals, "pxyF")
+	result1 := results[1].(map[string]interface{})
+	c.Check(result1["status"], Equals, float64(http.StatusServiceUnavailable))
+	c.Check(result1["cluster"], IsNil)
+	c.Check(offsetsAfter[0], Equals, offsetsBefore[0]+1)
+}
+
+// Parameters of the NDJSON content type, e.g. charset, are allowed.
+func (s *ServiceHTTPSuite) TestProduceBatchContentTypeParams(c *C) {
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	offsetsBefore := s.kh.GetNewestOffsets("test.4")
+
+	// When
+	r, err := s.unixClient.Post("http://_/messages", "application/x-ndjson; charset=utf-8", strings.NewReader(""+
+		`{"topic": "test.4", "key": "MQ==", "value": "Zm9v"}`+"\n"))
+	svc.Stop() // Have to stop before getOffsets
+	offsetsAfter := s.kh.GetNewestOffsets("test.4")
+
+	// Then
+	c.Check(err, IsNil)
+	c.Check(r.StatusCode, Equals, http.StatusOK)
+	c.Check(offsetsAfter[0], Equals, offsetsBefore[0]+1)
+}
+
+func (s *ServiceHTTPSuite) TestProduceBatchContentTypeInvalid(c *C) {
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+
+	// When
+	r, err := s.unixClient.Post("http://_/messages", "application/json", strings.NewReader(""+
+		`{"topic": "test.4", "value": "Zm9v"}`+"\n"))
+
+	// Then
+	c.Check(err, IsNil)
+	c.Check(r.StatusCode, Equals, http.StatusBadRequest)
+	body := ParseJSONBody(c, r).(map[string]interface{})
+	c.Check(body["error"], Equals, "unsupported content type application/json")
+}
+
+func (s *ServiceHTTPSuite) TestProduceBatchInvalidTimestamp(c *C) {
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+
+	// When
+	r, err := s.unixClient.Post("http://_/messages", "application/x-ndjson", strings.NewReader(""+
+		`{"topic": "test.4", "value": "Zm9v"}`+"\n"+
+		`{"topic": "test.4", "value": "Zm9v", "timestamp": -1}`+"\n"))
+
+	// Then
+	c.Check(err, IsNil)
+	c.Check(r.StatusCode, Equals, http.StatusBadRequest)
+	body := ParseJSONBody(c, r).(map[string]interface{})
+	c.Check(body["error"], Equals, "invalid message #1: invalid timestamp: -1")
+}
+
+func (s *ServiceHTTPSuite) TestProduceBatchInvalidJSON(c *C) {
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+
+	// When
+	r, err := s.unixClient.Post("http://_/messages", "application/x-ndjson", strings.NewReader(""+
+		`{"topic": "test.4", "value": "Zm9v"}`+"\n"+
+		`{"topic": "test.4", "value": "Zm9v"`+"\n"))
+
+	// Then
+	c.Check(err, IsNil)
+	c.Check(r.StatusCode, Equals, http.StatusBadRequest)
+	body := ParseJSONBody(c, r).(map[string]interface{})
+	c.Check(body["error"], Equals, "invalid message #1: unexpected EOF")
+}
+
+func (s *ServiceHTTPSuite) TestProduceBatchTooLarge(c *C) {
+	s.cfg.Proxies[s.cfg.DefaultCluster].Producer.MaxBatchMessages = 1
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+
+	// When
+	r, err := s.unixClient.Post("http://_/messages", "application/x-ndjson", strings.NewReader(""+
+		`{"topic": "test.4", "value": "Zm9v"}`+"\n"+
+		`{"topic": "test.4", "value": "YmFy"}`+"\n"))
+
+	// Then
+	c.Check(err, IsNil)
+	c.Check(r.StatusCode, Equals, http.StatusBadRequest)
+	body := ParseJSONBody(c, r).(map[string]interface{})
+	c.Check(body["error"], Equals, "2 > 1: too many messages in batch")
+}
+
 func (s *ServiceHTTPSuite) TestConsumeNoGroup(c *C) {
 	svc, err := Spawn(s.cfg)
 	c.Assert(err, IsNil)
@@ -625,6 +1011,81 @@ func (s *ServiceHTTPSuite) TestConsumeHeaders(c *C) {
 	c.Check(consRes.Headers[0], DeepEquals, &pb.RecordHeader{Key: "Foo", Value: []byte("bar")})
 }
 
+// A message produced with a timestamp is consumed with the same timestamp.
+func (s *ServiceHTTPSuite) TestConsumeTimestamp(c *C) {
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+
+	s.kh.ResetOffsets("foo", "test.4")
+	timestamp := time.Now().Add(-time.Minute).UnixNano() / int64(time.Millisecond)
+	url := fmt.Sprintf("http://_/topics/test.4/messages?key=foo&sync&timestamp=%d", timestamp)
+	rs, err := s.unixClient.Post(url, "text/plain", strings.NewReader("test"))
+	c.Assert(err, IsNil)
+	c.Assert(rs.StatusCode, Equals, http.StatusOK)
+
+	// When
+	res, err := s.unixClient.Get("http://_/topics/test.4/messages?group=foo")
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Assert(res.StatusCode, Equals, http.StatusOK)
+	consRes := ParseConsRes(c, res)
+	c.Check(consRes.Timestamp, Equals, timestamp)
+	c.Check(consRes.TimestampType, Equals, "create_time")
+}
+
+// A message larger than the chunk size is produced in chunks to one partition,
+// and consumed reassembled. One ack covers all chunks.
+func (s *ServiceHTTPSuite) TestConsumeChunked(c *C) {
+	if !s.proxyCfg.Kafka.Version.IsAtLeast(sarama.V0_11_0_0) {
+		c.Skip("Headers not supported before Kafka v0.11")
+	}
+	s.proxyCfg.Producer.Chunking.Enabled = true
+	s.proxyCfg.Producer.Chunking.ChunkSize = 10
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+
+	s.kh.ResetOffsets("foo", "test.4")
+	offsetsBefore := s.kh.GetNewestOffsets("test.4")
+	msg := strings.Repeat("0123456789", 4) + "!"
+	rs, err := s.unixClient.Post("http://_/topics/test.4/messages?key=foo&sync", "text/plain", strings.NewReader(msg))
+	c.Assert(err, IsNil)
+	c.Assert(rs.StatusCode, Equals, http.StatusOK)
+	prodRes := ParseJSONBody(c, rs).(map[string]interface{})
+	partition := int(prodRes["partition"].(float64))
+	c.Check(s.kh.GetNewestOffsets("test.4")[partition], Equals, offsetsBefore[partition]+5)
+
+	// When
+	res, err := s.unixClient.Get("http://_/topics/test.4/messages?group=foo")
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Assert(res.StatusCode, Equals, http.StatusOK)
+	consRes := ParseConsRes(c, res)
+	c.Check(string(consRes.Message), Equals, msg)
+	c.Check(consRes.Partition, Equals, int32(partition))
+	c.Check(consRes.Offset, Equals, offsetsBefore[partition]+4)
+	c.Check(consRes.Headers, HasLen, 0)
+}
+
+func (s *ServiceHTTPSuite) TestProduceInvalidTimestamp(c *C) {
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+
+	// When
+	rs, err := s.unixClient.Post("http://_/topics/test.4/messages?sync&timestamp=yesterday",
+		"text/plain", strings.NewReader("test"))
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Check(rs.StatusCode, Equals, http.StatusBadRequest)
+	body := ParseJSONBody(c, rs).(map[string]interface{})
+	c.Check(body["error"], Equals, "bad timestamp: yesterday")
+}
+
 // If offsets for a group that does not exist are requested then -1 is returned
 // as the next offset to be consumed for all topic partitions.
 func (s *ServiceHTTPSuite) TestGetOffsetsNoSuchGroup(c *C) {
@@ -1096,6 +1557,289 @@ func (s *ServiceHTTPSuite) TestGetTopicsWithPartitionsAndWithConfig(c *C) {
 	}
 }
 
+// Only clients listed as topic managers are allowed to manage topics.
+func (s *ServiceHTTPSuite) TestCreateTopicForbidden(c *C) {
+	s.proxyCfg.Admin.TopicManagers = []string{"ops"}
+	tlsClient, baseURL := s.tlsClient(c, "foo")
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+
+	// When
+	rs, err := tlsClient.Post(baseURL+"/topics/test.new?validateOnly", "application/json",
+		strings.NewReader(`{"partitions": 4, "replication_factor": 1}`))
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Check(rs.StatusCode, Equals, http.StatusForbidden)
+	c.Check(ParseJSONBody(c, rs), DeepEquals, map[string]interface{}{
+		"error": "client is not allowed to manage topics",
+	})
+}
+
+// A client cannot claim to be a topic manager with the client ID header, for
+// only verified TLS identities are trusted.
+func (s *ServiceHTTPSuite) TestCreateTopicHeaderSpoofed(c *C) {
+	s.cfg.ClientIDHeader = "X-Client-Id"
+	s.proxyCfg.Admin.TopicManagers = []string{"ops"}
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+
+	// When
+	req, err := http.NewRequest("POST", "http://_/topics/test.new?validateOnly",
+		strings.NewReader(`{"partitions": 4, "replication_factor": 1}`))
+	c.Assert(err, IsNil)
+	req.Header.Add("X-Client-Id", "ops")
+	rs, err := s.unixClient.Do(req)
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Check(rs.StatusCode, Equals, http.StatusForbidden)
+	c.Check(ParseJSONBody(c, rs), DeepEquals, map[string]interface{}{
+		"error": "client is not allowed to manage topics",
+	})
+}
+
+// In validate only mode a topic is checked but not created.
+func (s *ServiceHTTPSuite) TestCreateTopicValidateOnly(c *C) {
+	s.proxyCfg.Admin.TopicManagers = []string{"ops"}
+	tlsClient, baseURL := s.tlsClient(c, "ops")
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+
+	createTopic := func(topic, body string) *http.Response {
+		rs, err := tlsClient.Post(baseURL+"/topics/"+topic+"?validateOnly", "application/json", strings.NewReader(body))
+		c.Assert(err, IsNil)
+		return rs
+	}
+
+	// When/Then
+	rs := createTopic("test.new", `{"partitions": 4, "replication_factor": 1, "config": {"retention.ms": "60000"}}`)
+	c.Check(rs.StatusCode, Equals, http.StatusOK)
+	rs = createTopic("test.4", `{"partitions": 4, "replication_factor": 1}`)
+	c.Check(rs.StatusCode, Equals, http.StatusConflict)
+	rs = createTopic("test.new", `{"partitions": 4, "replication_factor": 1, "config": {"no.such.config": "1"}}`)
+	c.Check(rs.StatusCode, Equals, http.StatusBadRequest)
+
+	rs, err = s.unixClient.Get("http://_/topics")
+	c.Assert(err, IsNil)
+	c.Check(ParseJSONBody(c, rs), DeepEquals, []interface{}{"__consumer_offsets", "test.1", "test.4", "test.64"})
+}
+
+// Changes of a topic config can be validated without being applied.
+func (s *ServiceHTTPSuite) TestAlterTopicConfigValidateOnly(c *C) {
+	s.proxyCfg.Admin.TopicManagers = []string{"ops"}
+	tlsClient, baseURL := s.tlsClient(c, "ops")
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+
+	// When
+	rs, err := tlsClient.Post(baseURL+"/topics/test.4/config?validateOnly", "application/json",
+		strings.NewReader(`{"set": {"retention.ms": "60000"}}`))
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Check(rs.StatusCode, Equals, http.StatusOK)
+	rs, err = s.unixClient.Get("http://_/topics/test.4")
+	c.Assert(err, IsNil)
+	body := ParseJSONBody(c, rs).(map[string]interface{})
+	c.Check(body["config"].(map[string]interface{})["config"], DeepEquals, map[string]interface{}{})
+}
+
+// Partitions are not added in the validate only mode, and decreasing the
+// number of partitions is rejected.
+func (s *ServiceHTTPSuite) TestAddPartitionsValidateOnly(c *C) {
+	s.proxyCfg.Admin.TopicManagers = []string{"ops"}
+	tlsClient, baseURL := s.tlsClient(c, "ops")
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+
+	// When
+	rs, err := tlsClient.Post(baseURL+"/topics/test.4/partitions?validateOnly", "application/json",
+		strings.NewReader(`{"count": 8}`))
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Check(rs.StatusCode, Equals, http.StatusOK)
+	rs, err = s.unixClient.Get("http://_/topics/test.4?withPartitions")
+	c.Assert(err, IsNil)
+	body := ParseJSONBody(c, rs).(map[string]interface{})
+	c.Check(len(body["partitions"].([]interface{})), Equals, 4)
+
+	// When
+	rs, err = tlsClient.Post(baseURL+"/topics/test.4/partitions?validateOnly", "application/json",
+		strings.NewReader(`{"count": 2}`))
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Check(rs.StatusCode, Equals, http.StatusBadRequest)
+}
+
+// If there are no partition reassignments in progress, then an empty list is
+// returned.
+func (s *ServiceHTTPSuite) TestGetReassignmentsNone(c *C) {
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+
+	// When
+	rs, err := s.unixClient.Get("http://_/reassignments")
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Check(rs.StatusCode, Equals, http.StatusOK)
+	c.Check(ParseJSONBody(c, rs), DeepEquals, map[string]interface{}{"partitions": []interface{}{}})
+}
+
+// Preferred leader election of a partition that does not exist is rejected.
+func (s *ServiceHTTPSuite) TestElectPreferredLeadersUnknownPartition(c *C) {
+	s.proxyCfg.Admin.TopicManagers = []string{"ops"}
+	tlsClient, baseURL := s.tlsClient(c, "ops")
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+
+	// When
+	rs, err := tlsClient.Post(baseURL+"/preferred-leaders", "application/json",
+		strings.NewReader(`{"partitions": [{"topic": "test.4", "partition": 4}]}`))
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Check(rs.StatusCode, Equals, http.StatusNotFound)
+}
+
+// Partitions cannot be reassigned to brokers that do not exist.
+func (s *ServiceHTTPSuite) TestReassignPartitionsUnknownBroker(c *C) {
+	s.proxyCfg.Admin.TopicManagers = []string{"ops"}
+	tlsClient, baseURL := s.tlsClient(c, "ops")
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+
+	// When
+	rs, err := tlsClient.Post(baseURL+"/reassignments", "application/json",
+		strings.NewReader(`{"reassignments": [{"topic": "test.4", "partition": 0, "replicas": [1, 100]}]}`))
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Check(rs.StatusCode, Equals, http.StatusBadRequest)
+}
+
+// All brokers of the cluster are reported as connected, one of them is the
+// controller, and no partition is offline.
+func (s *ServiceHTTPSuite) TestDescribeCluster(c *C) {
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+
+	// When
+	rs, err := s.unixClient.Get("http://_/clusters/pxyH")
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Check(rs.StatusCode, Equals, http.StatusOK)
+	body := ParseJSONBody(c, rs).(map[string]interface{})
+	c.Check(body["cluster"], Equals, "pxyH")
+	c.Check(body["offline_partitions"], Equals, float64(0))
+	brokers := body["brokers"].([]interface{})
+	c.Assert(len(brokers) > 0, Equals, true)
+	controllerFound := false
+	for _, broker := range brokers {
+		broker := broker.(map[string]interface{})
+		c.Check(broker["connected"], Equals, true)
+		if broker["id"] == body["controller_id"] {
+			controllerFound = true
+		}
+	}
+	c.Check(controllerFound, Equals, true)
+}
+
+// Describing an unknown cluster is rejected.
+func (s *ServiceHTTPSuite) TestDescribeClusterUnknown(c *C) {
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+
+	// When
+	rs, err := s.unixClient.Get("http://_/clusters/unknown")
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Check(rs.StatusCode, Equals, http.StatusBadRequest)
+}
+
+// Messages can be browsed without affecting offsets committed by groups.
+func (s *ServiceHTTPSuite) TestBrowseMessages(c *C) {
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+
+	s.kh.ResetOffsets("foo", "test.1")
+	produced := s.kh.PutMessages("browse", "test.1", map[string]int{"A": 3})
+	offsetsBefore := s.kh.GetCommittedOffsets("foo", "test.1")
+
+	// When
+	rs, err := s.unixClient.Get(fmt.Sprintf("http://_/topics/test.1/partitions/0/messages?from=%d&count=2",
+		produced["A"][0].Offset))
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Check(rs.StatusCode, Equals, http.StatusOK)
+	body := ParseJSONBody(c, rs).(map[string]interface{})
+	messages := body["messages"].([]interface{})
+	c.Assert(len(messages), Equals, 2)
+	for i, msg := range messages {
+		msg := msg.(map[string]interface{})
+		c.Check(msg["offset"], Equals, float64(produced["A"][i].Offset))
+		c.Check(msg["key"], Equals, base64.StdEncoding.EncodeToString([]byte("A")))
+		c.Check(msg["preview"], Equals, fmt.Sprintf("browse:A:%d", i))
+	}
+	c.Check(body["next_offset"], Equals, float64(produced["A"][1].Offset+1))
+	offsetsAfter := s.kh.GetCommittedOffsets("foo", "test.1")
+	c.Check(offsetsAfter[0].Val, Equals, offsetsBefore[0].Val)
+}
+
+// Browsing a partition that does not exist is rejected.
+func (s *ServiceHTTPSuite) TestBrowseMessagesUnknownPartition(c *C) {
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+
+	// When
+	rs, err := s.unixClient.Get("http://_/topics/test.1/partitions/7/messages")
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Check(rs.StatusCode, Equals, http.StatusNotFound)
+}
+
+// Clients that browse too much are throttled.
+func (s *ServiceHTTPSuite) TestBrowseMessagesThrottled(c *C) {
+	s.proxyCfg.Browse.ClientLimit = config.RateLimit{MessagesPerSecond: 0.1, MessagesBurst: 10}
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+
+	s.kh.PutMessages("browse", "test.1", map[string]int{"A": 1})
+	rs, err := s.unixClient.Get("http://_/topics/test.1/partitions/0/messages?count=10")
+	c.Assert(err, IsNil)
+	c.Check(rs.StatusCode, Equals, http.StatusOK)
+
+	// When
+	rs, err = s.unixClient.Get("http://_/topics/test.1/partitions/0/messages?count=1")
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Check(rs.StatusCode, Equals, http.StatusTooManyRequests)
+	c.Check(rs.Header.Get("Retry-After"), Equals, "10")
+	body := ParseJSONBody(c, rs).(map[string]interface{})
+	c.Check(body["error"], Matches, `browse rate limit exceeded: client "" messages, .*`)
+}
+
 // Reported partition lags are correct, including those corresponding to -1 and
 // -2 special case offset values.
 func (s *ServiceHTTPSuite) TestHealthCheck(c *C) {
@@ -1114,6 +1858,24 @@ func (s *ServiceHTTPSuite) TestHealthCheck(c *C) {
 	c.Check(string(body), Equals, "pong")
 }
 
+// Producer buffer occupancy is reported.
+func (s *ServiceHTTPSuite) TestGetProducer(c *C) {
+	s.proxyCfg.Producer.ChannelBufferSize = 100
+	svc, err := Spawn(s.cfg)
+	c.Assert(err, IsNil)
+	defer svc.Stop()
+
+	// When
+	r, err := s.unixClient.Get("http://_/clusters/pxyH/producer")
+
+	// Then
+	c.Assert(err, IsNil)
+	c.Check(r.StatusCode, Equals, http.StatusOK)
+	body := ParseJSONBody(c, r).(map[string]interface{})
+	c.Check(body["capacity"], Equals, float64(100))
+	c.Check(body["buffer_fill"].(float64) < 1, Equals, true)
+}
+
 // Ensure that API endpoints that explicitly select a proxy to operate on work.
 func (s *ServiceHTTPSuite) TestExplicitProxyAPIEndpoints(c *C) {
 	s.kh.ResetOffsets("foo", "test.1")
@@ -1270,13 +2032,20 @@ func ParseConsRes(c *C, res *http.Response) *pb.ConsRs {
 		})
 	}
 
-	return &pb.ConsRs{
+	consRes := &pb.ConsRs{
 		KeyValue:  []byte(ParseBase64(c, body["key"].(string))),
 		Message:   []byte(ParseBase64(c, body["value"].(string))),
 		Partition: int32(body["partition"].(float64)),
 		Offset:    int64(body["offset"].(float64)),
 		Headers:   headers,
 	}
+	if timestamp, ok := body["timestamp"].(float64); ok {
+		consRes.Timestamp = int64(timestamp)
+	}
+	if timestampType, ok := body["timestamp_type"].(string); ok {
+		consRes.TimestampType = timestampType
+	}
+	return consRes
 }
 
 func ParseBase64(c *C, encoded string) string {
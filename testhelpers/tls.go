@@ -0,0 +1,135 @@
+package testhelpers
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"path/filepath"
+	"time"
+
+	"github.com/mailgun/kafka-pixy/config"
+)
+
+// TestTLS is a certificate authority that issues a server certificate for the
+// loopback interface and client certificates, for testing authentication of
+// clients with TLS certificates.
+type TestTLS struct {
+	CAPath   string
+	CertPath string
+	KeyPath  string
+
+	ca     *x509.Certificate
+	caKey  *ecdsa.PrivateKey
+	serial int64
+}
+
+// NewTestTLS creates a certificate authority and a server certificate issued
+// by it, and writes them to PEM files in `dir`.
+func NewTestTLS(dir string) (*TestTLS, error) {
+	t := &TestTLS{
+		CAPath:   filepath.Join(dir, "ca.pem"),
+		CertPath: filepath.Join(dir, "server.pem"),
+		KeyPath:  filepath.Join(dir, "server-key.pem"),
+	}
+	var err error
+	if t.caKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader); err != nil {
+		return nil, err
+	}
+	caTemplate := t.template("kafka-pixy test CA")
+	caTemplate.IsCA = true
+	caTemplate.BasicConstraintsValid = true
+	caTemplate.KeyUsage = x509.KeyUsageCertSign
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &t.caKey.PublicKey, t.caKey)
+	if err != nil {
+		return nil, err
+	}
+	if t.ca, err = x509.ParseCertificate(caDER); err != nil {
+		return nil, err
+	}
+	if err := writePEM(t.CAPath, "CERTIFICATE", caDER); err != nil {
+		return nil, err
+	}
+
+	serverTemplate := t.template("")
+	serverTemplate.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	serverTemplate.IPAddresses = []net.IP{net.IPv4(127, 0, 0, 1)}
+	serverTemplate.DNSNames = []string{"localhost"}
+	cert, err := t.issue(serverTemplate)
+	if err != nil {
+		return nil, err
+	}
+	if err := writePEM(t.CertPath, "CERTIFICATE", cert.Certificate[0]); err != nil {
+		return nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(cert.PrivateKey.(*ecdsa.PrivateKey))
+	if err != nil {
+		return nil, err
+	}
+	if err := writePEM(t.KeyPath, "EC PRIVATE KEY", keyDER); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Apply configures the server of an application to use the server
+// certificate and to verify client certificates issued by the authority.
+func (t *TestTLS) Apply(cfg *config.App) {
+	cfg.TLS.CertPath = t.CertPath
+	cfg.TLS.KeyPath = t.KeyPath
+	cfg.TLS.ClientCAPath = t.CAPath
+}
+
+// ClientConfig returns a TLS config of a client that trusts the server
+// certificate and authenticates with a certificate issued for `commonName`.
+// If `commonName` is empty, then the client does not present a certificate.
+func (t *TestTLS) ClientConfig(commonName string) (*tls.Config, error) {
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(t.ca)
+	tlsCfg := &tls.Config{RootCAs: rootCAs}
+	if commonName == "" {
+		return tlsCfg, nil
+	}
+	clientTemplate := t.template(commonName)
+	clientTemplate.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	cert, err := t.issue(clientTemplate)
+	if err != nil {
+		return nil, err
+	}
+	tlsCfg.Certificates = []tls.Certificate{cert}
+	return tlsCfg, nil
+}
+
+func (t *TestTLS) template(commonName string) *x509.Certificate {
+	t.serial++
+	return &x509.Certificate{
+		SerialNumber: big.NewInt(t.serial),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+}
+
+// issue creates a key pair and a certificate for it signed by the authority.
+func (t *TestTLS) issue(template *x509.Certificate) (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, t.ca, &key.PublicKey, t.caKey)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, nil
+}
+
+func writePEM(path, blockType string, der []byte) error {
+	return ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600)
+}